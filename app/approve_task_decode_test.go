@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	tasktypes "taskbounty/x/task/types"
+)
+
+func TestApproveTaskRejectsTxHash(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	approver := sdk.AccAddress([]byte("approverAddr________"))
+
+	msgBytes, err := tasktypes.NewMsgApproveTask(approver.String(), 1).Marshal()
+	require.NoError(t, err)
+	encodeTx := func(msgBytes []byte) []byte {
+		body := txtypes.TxBody{Messages: []*codectypes.Any{{TypeUrl: sdk.MsgTypeURL(&tasktypes.MsgApproveTask{}), Value: msgBytes}}}
+		bodyBytes, err := body.Marshal()
+		require.NoError(t, err)
+		authInfoBytes, err := (&txtypes.AuthInfo{Fee: &txtypes.Fee{}}).Marshal()
+		require.NoError(t, err)
+		txBytes, err := (&txtypes.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes}).Marshal()
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	_, err = app.TxConfig().TxDecoder()(encodeTx(msgBytes))
+	require.NoError(t, err)
+
+	// field 3 held the payout tx hash, which is now recorded by the chain
+	withTxHash := protowire.AppendTag(msgBytes, 3, protowire.BytesType)
+	withTxHash = protowire.AppendString(withTxHash, "ABCD")
+	_, err = app.TxConfig().TxDecoder()(encodeTx(withTxHash))
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	require.ErrorContains(t, err, "TagNum: 3")
+}
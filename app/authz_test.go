@@ -0,0 +1,167 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	taskkeeper "taskbounty/x/task/keeper"
+	tasktypes "taskbounty/x/task/types"
+)
+
+// authzTestTasks funds the creator and creates tasks with the given bounties.
+func authzTestTasks(t *testing.T, app *App, ctx sdk.Context, creator sdk.AccAddress, bounties ...sdk.Coin) []uint64 {
+	t.Helper()
+
+	total := sdk.NewCoins()
+	for _, bounty := range bounties {
+		total = total.Add(bounty)
+	}
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, total))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, total))
+
+	srv := taskkeeper.NewMsgServerImpl(app.TaskKeeper)
+	ids := make([]uint64, len(bounties))
+	for i, bounty := range bounties {
+		resp, err := srv.CreateTask(ctx, tasktypes.NewMsgCreateTask(creator.String(), "Audit the escrow", "Review the task module escrow flows", sdk.NewCoins(bounty)))
+		require.NoError(t, err)
+		ids[i] = resp.Id
+	}
+	return ids
+}
+
+func execAs(ctx sdk.Context, app *App, grantee sdk.AccAddress, msg sdk.Msg) error {
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+	_, err := app.AuthzKeeper.Exec(ctx, &exec)
+	return err
+}
+
+func TestTaskApprovalAuthorization(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: now})
+	require.NoError(t, app.TaskKeeper.Params.Set(ctx, tasktypes.DefaultParams()))
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________"))
+	claimant := sdk.AccAddress([]byte("claimantAddr________"))
+	lead := sdk.AccAddress([]byte("leadAddr____________"))
+
+	small, large := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 8000)
+	ids := authzTestTasks(t, app, ctx, creator, small, large, small)
+
+	srv := taskkeeper.NewMsgServerImpl(app.TaskKeeper)
+	proof := tasktypes.TaskProof{Hash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", Type: "ipfs", Timestamp: now.Unix()}
+	for _, id := range ids {
+		_, err := srv.ClaimTask(ctx, tasktypes.NewMsgClaimTask(claimant.String(), id))
+		require.NoError(t, err)
+		_, err = srv.SubmitTask(ctx, tasktypes.NewMsgSubmitTask(claimant.String(), id, proof))
+		require.NoError(t, err)
+	}
+
+	maxBounty := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+	expiration := now.Add(time.Hour)
+	approve := tasktypes.NewTaskApprovalAuthorization(tasktypes.TASK_APPROVAL_TYPE_APPROVE, maxBounty, []uint64{ids[0], ids[1]})
+	require.NoError(t, approve.ValidateBasic())
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, lead, creator, approve, &expiration))
+
+	// the lead can neither reject without a reject grant nor act on their own
+	require.Error(t, execAs(ctx, app, lead, tasktypes.NewMsgRejectTask(creator.String(), ids[0], "no")))
+	_, err := srv.ApproveTask(ctx, tasktypes.NewMsgApproveTask(lead.String(), ids[0]))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// messages bound the bounty of the task, which the grant bounds in turn
+	approveMsg := func(id uint64, maxBounty sdk.Coins) *tasktypes.MsgApproveTask {
+		msg := tasktypes.NewMsgApproveTask(creator.String(), id)
+		msg.MaxBounty = maxBounty
+		return msg
+	}
+	require.ErrorIs(t, execAs(ctx, app, lead, approveMsg(ids[0], nil)), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, execAs(ctx, app, lead, approveMsg(ids[1], sdk.NewCoins(large))), sdkerrors.ErrUnauthorized)
+
+	// the bounty of the second task is above the max bounty, the third task is not listed
+	require.ErrorIs(t, execAs(ctx, app, lead, approveMsg(ids[1], maxBounty)), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, execAs(ctx, app, lead, approveMsg(ids[2], maxBounty)), sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, execAs(ctx, app, lead, approveMsg(ids[0], maxBounty)))
+	task, err := app.TaskKeeper.Task.Get(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_APPROVED, task.Status)
+	require.Equal(t, creator.String(), task.Approver)
+	require.Equal(t, small, app.BankKeeper.GetBalance(ctx, claimant, small.Denom))
+
+	// a reject grant on any task stops at its expiration
+	reject := tasktypes.NewTaskApprovalAuthorization(tasktypes.TASK_APPROVAL_TYPE_REJECT, nil, nil)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, lead, creator, reject, &expiration))
+
+	require.NoError(t, execAs(ctx, app, lead, tasktypes.NewMsgRejectTask(creator.String(), ids[1], "missing tests")))
+	task, err = app.TaskKeeper.Task.Get(ctx, ids[1])
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_REJECTED, task.Status)
+
+	expired := ctx.WithBlockTime(expiration.Add(time.Second))
+	require.Error(t, execAs(expired, app, lead, tasktypes.NewMsgRejectTask(creator.String(), ids[2], "missing tests")))
+}
+
+func TestTaskClaimAuthorization(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: time.Unix(1_700_000_000, 0).UTC()})
+	require.NoError(t, app.TaskKeeper.Params.Set(ctx, tasktypes.DefaultParams()))
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________"))
+	contributor := sdk.AccAddress([]byte("contributorAddr_____"))
+	bot := sdk.AccAddress([]byte("botAddr_____________"))
+
+	small, large := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 8000)
+	ids := authzTestTasks(t, app, ctx, creator, small, large)
+
+	maxBounty := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+	claim := tasktypes.NewTaskClaimAuthorization(maxBounty, nil)
+	require.NoError(t, claim.ValidateBasic())
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, bot, contributor, claim, nil))
+
+	// another app in the same process does not change what the grant checks
+	_ = New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+
+	claimMsg := func(id uint64, maxBounty sdk.Coins) *tasktypes.MsgClaimTask {
+		msg := tasktypes.NewMsgClaimTask(contributor.String(), id)
+		msg.MaxBounty = maxBounty
+		return msg
+	}
+	require.ErrorIs(t, execAs(ctx, app, bot, claimMsg(ids[0], nil)), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, execAs(ctx, app, bot, claimMsg(ids[1], maxBounty)), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, execAs(ctx, app, bot, claimMsg(ids[0], maxBounty)))
+
+	// the contributor, not the bot, is the claimant
+	task, err := app.TaskKeeper.Task.Get(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_CLAIMED, task.Status)
+	require.Equal(t, contributor.String(), task.Claimant)
+
+	// a bounty in another denom is never within the max bounty
+	otherDenom := tasktypes.NewTaskClaimAuthorization(sdk.NewCoins(sdk.NewCoin("uatom", large.Amount)), nil)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, bot, contributor, otherDenom, nil))
+	require.ErrorIs(t, execAs(ctx, app, bot, claimMsg(ids[1], sdk.NewCoins(large))), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, execAs(ctx, app, bot, claimMsg(ids[1], otherDenom.MaxBounty)), sdkerrors.ErrInvalidRequest)
+
+	// a max bounty over several denoms only bounds the denoms of the bounty
+	multiDenom := tasktypes.NewTaskClaimAuthorization(sdk.NewCoins(sdk.NewCoin("uatom", large.Amount), large), nil)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, bot, contributor, multiDenom, nil))
+	require.NoError(t, execAs(ctx, app, bot, claimMsg(ids[1], multiDenom.MaxBounty)))
+
+	for _, invalid := range []*tasktypes.TaskClaimAuthorization{
+		tasktypes.NewTaskClaimAuthorization(sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: large.Amount.Neg()}}, nil),
+		tasktypes.NewTaskClaimAuthorization(nil, []uint64{ids[0], ids[0]}),
+	} {
+		require.Error(t, invalid.ValidateBasic())
+	}
+	require.Error(t, tasktypes.NewTaskApprovalAuthorization(tasktypes.TASK_APPROVAL_TYPE_UNSPECIFIED, nil, nil).ValidateBasic())
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	taskkeeper "taskbounty/x/task/keeper"
+	tasktypes "taskbounty/x/task/types"
+)
+
+func TestTaskFeeAllowance(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: now})
+	require.NoError(t, app.TaskKeeper.Params.Set(ctx, tasktypes.DefaultParams()))
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________"))
+	claimant := sdk.AccAddress([]byte("claimantAddr________"))
+
+	bounty := sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(bounty)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, sdk.NewCoins(bounty)))
+
+	srv := taskkeeper.NewMsgServerImpl(app.TaskKeeper)
+	msg := tasktypes.NewMsgCreateTask(creator.String(), "Audit the escrow", "Review the task module escrow flows", sdk.NewCoins(bounty))
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	resp, err := srv.CreateTask(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, creator, claimant)
+	require.Error(t, err)
+
+	_, err = srv.ClaimTask(ctx, tasktypes.NewMsgClaimTask(claimant.String(), resp.Id))
+	require.NoError(t, err)
+
+	// the claimant's submission is paid for by the creator, anything else is not
+	proof := tasktypes.TaskProof{Hash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", Type: "ipfs", Timestamp: now.Unix()}
+	submit := tasktypes.NewMsgSubmitTask(claimant.String(), resp.Id, proof)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	require.ErrorIs(t, app.FeeGrantKeeper.UseGrantedFees(ctx, creator, claimant, fee, []sdk.Msg{tasktypes.NewMsgUnclaimTask(claimant.String(), resp.Id, "")}), feegrant.ErrMessageNotAllowed)
+	require.ErrorIs(t, app.FeeGrantKeeper.UseGrantedFees(ctx, creator, claimant, fee, []sdk.Msg{tasktypes.NewMsgClaimTask(claimant.String(), resp.Id)}), feegrant.ErrMessageNotAllowed)
+	require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, creator, claimant, fee, []sdk.Msg{submit}))
+	require.ErrorIs(t, app.FeeGrantKeeper.UseGrantedFees(ctx, creator, claimant, fee, []sdk.Msg{submit}), feegrant.ErrFeeLimitExceeded)
+
+	allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, creator, claimant)
+	require.NoError(t, err)
+	require.Equal(t, []tasktypes.TaskSpendLimit{{
+		TaskId:     resp.Id,
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}}, allowance.(*tasktypes.TaskFeeAllowance).Limits)
+
+	_, err = srv.SubmitTask(ctx, submit)
+	require.NoError(t, err)
+	_, err = srv.ApproveTask(ctx, tasktypes.NewMsgApproveTask(creator.String(), resp.Id))
+	require.NoError(t, err)
+
+	// approval revokes the grant
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, creator, claimant)
+	require.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	taskkeeper "taskbounty/x/task/keeper"
+	tasktypes "taskbounty/x/task/types"
+)
+
+// TestGroupApprovedPayout nominates an x/group policy as the approver of a
+// task and pays the bounty out through a group proposal that two of its three
+// members vote for.
+func TestGroupApprovedPayout(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: time.Unix(1_700_000_000, 0).UTC()})
+	require.NoError(t, app.TaskKeeper.Params.Set(ctx, tasktypes.DefaultParams()))
+
+	addr := func(name string) string {
+		return sdk.AccAddress([]byte(name + "Addr____________________")[:20]).String()
+	}
+	creator, claimant := addr("creator"), addr("claimant")
+	alice, bob, carol := addr("alice"), addr("bob"), addr("carol")
+
+	bounty := sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(bounty)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(bounty)))
+
+	// the grants committee: any two of its three members decide
+	createGroup, err := group.NewMsgCreateGroupWithPolicy(
+		creator,
+		[]group.MemberRequest{{Address: alice, Weight: "1"}, {Address: bob, Weight: "1"}, {Address: carol, Weight: "1"}},
+		"grants committee",
+		"",
+		false,
+		group.NewThresholdDecisionPolicy("2", time.Hour, 0),
+	)
+	require.NoError(t, err)
+	groupRes, err := app.GroupKeeper.CreateGroupWithPolicy(ctx, createGroup)
+	require.NoError(t, err)
+	committee := groupRes.GroupPolicyAddress
+
+	srv := taskkeeper.NewMsgServerImpl(app.TaskKeeper)
+	msg := tasktypes.NewMsgCreateTask(creator, "Audit the escrow", "Review the task module escrow flows", sdk.NewCoins(bounty))
+	msg.Approvers = []string{committee}
+	created, err := srv.CreateTask(ctx, msg)
+	require.NoError(t, err)
+	id := created.Id
+
+	_, err = srv.ClaimTask(ctx, tasktypes.NewMsgClaimTask(claimant, id))
+	require.NoError(t, err)
+	proof := tasktypes.TaskProof{Hash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", Type: "ipfs", Timestamp: ctx.BlockTime().Unix()}
+	_, err = srv.SubmitTask(ctx, tasktypes.NewMsgSubmitTask(claimant, id, proof))
+	require.NoError(t, err)
+
+	// members cannot approve on their own
+	_, err = srv.ApproveTask(ctx, tasktypes.NewMsgApproveTask(alice, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a rejection that only one member supports is not executed
+	reject, err := tasktypes.NewMsgProposeRejectTask(committee, []string{carol}, id, "incomplete", group.Exec_EXEC_UNSPECIFIED, "Reject", "incomplete")
+	require.NoError(t, err)
+	rejectRes, err := app.GroupKeeper.SubmitProposal(ctx, reject)
+	require.NoError(t, err)
+	vote(t, app, ctx, rejectRes.ProposalId, carol, group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED)
+	vote(t, app, ctx, rejectRes.ProposalId, alice, group.VOTE_OPTION_NO, group.Exec_EXEC_UNSPECIFIED)
+	vote(t, app, ctx, rejectRes.ProposalId, bob, group.VOTE_OPTION_NO, group.Exec_EXEC_TRY)
+
+	proposal, err := app.GroupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: rejectRes.ProposalId})
+	require.NoError(t, err)
+	require.Equal(t, group.PROPOSAL_STATUS_REJECTED, proposal.Proposal.Status)
+
+	task, err := app.TaskKeeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_SUBMITTED, task.Status)
+
+	// the approval is executed once the second member votes for it
+	approve, err := tasktypes.NewMsgProposeApproveTask(committee, []string{alice}, id, group.Exec_EXEC_UNSPECIFIED, "Approve", "looks good")
+	require.NoError(t, err)
+	approveRes, err := app.GroupKeeper.SubmitProposal(ctx, approve)
+	require.NoError(t, err)
+	vote(t, app, ctx, approveRes.ProposalId, alice, group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED)
+
+	task, err = app.TaskKeeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_SUBMITTED, task.Status)
+
+	vote(t, app, ctx, approveRes.ProposalId, bob, group.VOTE_OPTION_YES, group.Exec_EXEC_TRY)
+
+	// successfully executed proposals are pruned right away
+	_, err = app.GroupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: approveRes.ProposalId})
+	require.Error(t, err)
+
+	task, err = app.TaskKeeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_APPROVED, task.Status)
+	require.Equal(t, committee, task.Approver)
+	require.Equal(t, bounty, app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(claimant), bounty.Denom))
+}
+
+func vote(t *testing.T, app *App, ctx sdk.Context, proposalID uint64, voter string, option group.VoteOption, exec group.Exec) {
+	t.Helper()
+
+	_, err := app.GroupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter, Option: option, Exec: exec})
+	require.NoError(t, err)
+}
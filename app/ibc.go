@@ -30,6 +30,10 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	taskante "taskbounty/x/task/ante"
+	taskmodule "taskbounty/x/task/module"
+	tasktypes "taskbounty/x/task/types"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -84,7 +88,9 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		app.IBCKeeper.ChannelKeeper,
 		app.AuthKeeper,
-		app.MsgServiceRouter(),
+		// interchain account transactions skip the ante handler, so task
+		// certificates are kept from being sent where their messages execute
+		taskante.WrapMessageRouter(app.MsgServiceRouter()),
 		app.GRPCQueryRouter(),
 		govModuleAddr,
 	)
@@ -105,13 +111,15 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
 		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
+		taskStack          porttypes.IBCModule = taskmodule.NewIBCModule(app.appCodec, app.TaskKeeper)
 	)
 
 	// create IBC v1 router, add transfer route, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(tasktypes.PortID, taskStack)
 
 	// create IBC v2 router, add transfer route, then set it on the keeper
 	ibcv2Router := ibcapi.NewRouter().
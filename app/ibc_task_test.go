@@ -0,0 +1,220 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	tasktypes "taskbounty/x/task/types"
+)
+
+// setupTaskTestingApp creates the app of every chain of the ibc-go testing
+// coordinator.
+func setupTaskTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	return app, app.DefaultGenesis()
+}
+
+// newTaskPath returns a task channel between the two chains.
+func newTaskPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = tasktypes.PortID
+	path.EndpointB.ChannelConfig.PortID = tasktypes.PortID
+	path.EndpointA.ChannelConfig.Version = tasktypes.Version
+	path.EndpointB.ChannelConfig.Version = tasktypes.Version
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	return path
+}
+
+// sendTaskPacket delivers msgs on chain A of path and relays the packet
+// they send, returning the acknowledgement written by the counterparty and
+// the events of receiving it.
+func sendTaskPacket(t *testing.T, path *ibctesting.Path, msgs ...sdk.Msg) (channeltypes.Acknowledgement, []abci.Event) {
+	t.Helper()
+
+	res, err := path.EndpointA.Chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	recvRes, bz, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack, recvRes.Events
+}
+
+// relaySentPackets relays the packets sent over the port of path in events
+// and returns how many were relayed.
+func relaySentPackets(t *testing.T, path *ibctesting.Path, portID string, events []abci.Event) int {
+	t.Helper()
+
+	packets, err := ibctesting.ParsePacketsFromEvents(channeltypes.EventTypeSendPacket, events)
+	require.NoError(t, err)
+
+	relayed := 0
+	for _, packet := range packets {
+		if packet.SourcePort != portID {
+			continue
+		}
+		require.NoError(t, path.RelayPacket(packet))
+		relayed++
+	}
+	return relayed
+}
+
+func TestTaskIBCApp(t *testing.T) {
+	defaultAppInit := ibctesting.DefaultTestingAppInit
+	ibctesting.DefaultTestingAppInit = setupTaskTestingApp
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = defaultAppInit })
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	appA := chainA.App.(*App)
+	appB := chainB.App.(*App)
+
+	transferPath := ibctesting.NewTransferPath(chainA, chainB)
+	transferPath.Setup()
+	taskPath := newTaskPath(chainA, chainB)
+	taskPath.Setup()
+
+	creator := chainA.SenderAccount.GetAddress().String()
+	taskChannelA := taskPath.EndpointA.ChannelID
+	taskChannelB := taskPath.EndpointB.ChannelID
+
+	// the bounty is paid in the voucher of the native token of chain A, which
+	// governance of chain B allows
+	voucher := ibctransfertypes.NewDenom(sdk.DefaultBondDenom, ibctransfertypes.NewHop(ibctransfertypes.PortID, transferPath.EndpointB.ChannelID)).IBCDenom()
+	params, err := appB.TaskKeeper.Params.Get(chainB.GetContext())
+	require.NoError(t, err)
+	params.BountyDenoms = []tasktypes.BountyDenom{{
+		MinBounty: sdk.NewInt64Coin(voucher, 1000),
+		MaxBounty: sdk.NewInt64Coin(voucher, 1000000),
+	}}
+	require.NoError(t, appB.TaskKeeper.Params.Set(chainB.GetContext(), params))
+
+	// without funds on chain B the task cannot be created
+	bounty := sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)
+	createMsg := tasktypes.NewMsgSendCreateTask(creator, taskChannelA, 0, "Relay audit", "Review the task channel handshake", sdk.NewCoins(sdk.NewInt64Coin(voucher, 5000)))
+	ack, _ := sendTaskPacket(t, taskPath, createMsg)
+	require.False(t, ack.Success())
+	_, err = appA.TaskKeeper.RemoteTask.Get(chainA.GetContext(), collections.Join(taskChannelA, uint64(0)))
+	require.Error(t, err)
+
+	// the creator funds its remote account on chain B over ICS-20
+	account := tasktypes.RemoteAccount(taskChannelB, creator)
+	transfer := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, transferPath.EndpointA.ChannelID, bounty,
+		creator, sdk.AccAddress(account).String(),
+		chainB.GetTimeoutHeight(), 0, "",
+	)
+	res, err := chainA.SendMsgs(transfer)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, transferPath.RelayPacket(packet))
+	require.Equal(t, sdk.NewInt64Coin(voucher, 5000), appB.BankKeeper.GetBalance(chainB.GetContext(), account, voucher))
+
+	ack, _ = sendTaskPacket(t, taskPath, createMsg)
+	require.True(t, ack.Success(), ack.GetError())
+
+	var packetAck tasktypes.TaskPacketAck
+	require.NoError(t, appB.AppCodec().UnmarshalJSON(ack.GetResult(), &packetAck))
+	require.Equal(t, tasktypes.TASK_STATUS_OPEN, packetAck.NewStatus)
+	require.Equal(t, sdk.AccAddress(account).String(), packetAck.Account)
+
+	remoteID := packetAck.TaskId
+	task, err := appB.TaskKeeper.Task.Get(chainB.GetContext(), remoteID)
+	require.NoError(t, err)
+	require.Equal(t, packetAck.Account, task.Creator)
+	require.Equal(t, createMsg.Bounty, task.Bounty)
+	require.True(t, appB.BankKeeper.GetBalance(chainB.GetContext(), account, voucher).IsZero())
+
+	// the status change is recorded on chain A
+	remoteTask, err := appA.TaskKeeper.RemoteTask.Get(chainA.GetContext(), collections.Join(taskChannelA, remoteID))
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.RemoteTask{
+		ChannelId:      taskChannelA,
+		TaskId:         remoteID,
+		Status:         tasktypes.TASK_STATUS_OPEN,
+		Creator:        creator,
+		CreatorAccount: packetAck.Account,
+	}, remoteTask)
+
+	// a task of chain B is claimed from chain A
+	localCreator := chainB.SenderAccount.GetAddress().String()
+	res, err = chainB.SendMsgs(tasktypes.NewMsgCreateTask(localCreator, "Write docs", "Document the task channel", sdk.NewCoins(bounty)))
+	require.NoError(t, err)
+	var createResp tasktypes.MsgCreateTaskResponse
+	require.NoError(t, unpackMsgResponse(appB, res.Data, &createResp))
+
+	claimMsg := tasktypes.NewMsgSendClaimTask(creator, taskChannelA, 0, createResp.Id)
+	ack, _ = sendTaskPacket(t, taskPath, claimMsg)
+	require.True(t, ack.Success(), ack.GetError())
+
+	claimant := sdk.AccAddress(tasktypes.RemoteAccount(taskChannelB, creator)).String()
+	task, err = appB.TaskKeeper.Task.Get(chainB.GetContext(), createResp.Id)
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_CLAIMED, task.Status)
+	require.Equal(t, claimant, task.Claimant)
+
+	remoteTask, err = appA.TaskKeeper.RemoteTask.Get(chainA.GetContext(), collections.Join(taskChannelA, createResp.Id))
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.RemoteTask{
+		ChannelId:       taskChannelA,
+		TaskId:          createResp.Id,
+		Status:          tasktypes.TASK_STATUS_CLAIMED,
+		Claimant:        creator,
+		ClaimantAccount: claimant,
+		Seq:             1,
+	}, remoteTask)
+
+	// claiming it again fails on chain B and leaves the record untouched
+	ack, _ = sendTaskPacket(t, taskPath, claimMsg)
+	require.False(t, ack.Success())
+	got, err := appA.TaskKeeper.RemoteTask.Get(chainA.GetContext(), collections.Join(taskChannelA, createResp.Id))
+	require.NoError(t, err)
+	require.Equal(t, remoteTask, got)
+
+	// the task created from chain A is claimed and submitted on chain B, which
+	// reports each status change back to chain A
+	res, err = chainB.SendMsgs(tasktypes.NewMsgClaimTask(localCreator, remoteID))
+	require.NoError(t, err)
+	require.Equal(t, 1, relaySentPackets(t, taskPath, tasktypes.PortID, res.Events))
+	proof := tasktypes.TaskProof{
+		Hash:      "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		Type:      "ipfs",
+		Timestamp: chainB.GetContext().BlockTime().Unix(),
+	}
+	res, err = chainB.SendMsgs(tasktypes.NewMsgSubmitTask(localCreator, remoteID, proof))
+	require.NoError(t, err)
+	require.Equal(t, 1, relaySentPackets(t, taskPath, tasktypes.PortID, res.Events))
+
+	remoteTask, err = appA.TaskKeeper.RemoteTask.Get(chainA.GetContext(), collections.Join(taskChannelA, remoteID))
+	require.NoError(t, err)
+	require.Equal(t, tasktypes.TASK_STATUS_SUBMITTED, remoteTask.Status)
+	require.Equal(t, uint64(2), remoteTask.Seq)
+	require.Equal(t, creator, remoteTask.Creator)
+}
+
+// unpackMsgResponse decodes the response of the single msg of a delivered tx.
+func unpackMsgResponse(app *App, data []byte, resp sdk.Msg) error {
+	var txMsgData sdk.TxMsgData
+	if err := app.AppCodec().Unmarshal(data, &txMsgData); err != nil {
+		return err
+	}
+	return app.AppCodec().Unmarshal(txMsgData.MsgResponses[0].Value, resp)
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/nft"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	taskante "taskbounty/x/task/ante"
+	taskkeeper "taskbounty/x/task/keeper"
+	tasktypes "taskbounty/x/task/types"
+)
+
+func TestTaskCertificate(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, Time: now})
+	require.NoError(t, app.TaskKeeper.Params.Set(ctx, tasktypes.DefaultParams()))
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________"))
+	claimant := sdk.AccAddress([]byte("claimantAddr________"))
+	other := sdk.AccAddress([]byte("otherAddr___________"))
+
+	id := authzTestTasks(t, app, ctx, creator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))[0]
+	srv := taskkeeper.NewMsgServerImpl(app.TaskKeeper)
+	proof := tasktypes.TaskProof{Hash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", Type: "ipfs", Timestamp: now.Unix()}
+	_, err := srv.ClaimTask(ctx, tasktypes.NewMsgClaimTask(claimant.String(), id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(ctx, tasktypes.NewMsgSubmitTask(claimant.String(), id, proof))
+	require.NoError(t, err)
+	_, err = srv.ApproveTask(ctx, tasktypes.NewMsgApproveTask(creator.String(), id))
+	require.NoError(t, err)
+
+	// the certificate is an x/nft token owned by the claimant
+	certificateID := tasktypes.CertificateID(id)
+	require.Equal(t, claimant, app.NFTKeeper.GetOwner(ctx, tasktypes.CertificateClassID, certificateID))
+	token, found := app.NFTKeeper.GetNFT(ctx, tasktypes.CertificateClassID, certificateID)
+	require.True(t, found)
+	var data proto.Message
+	require.NoError(t, app.InterfaceRegistry().UnpackAny(token.Data, &data))
+	certificate, ok := data.(*tasktypes.TaskCertificate)
+	require.True(t, ok)
+	require.Equal(t, id, certificate.TaskId)
+	require.Equal(t, proof.Hash, certificate.ProofHash)
+
+	// it cannot be sent, directly or through an authz grant
+	send := &nft.MsgSend{ClassId: tasktypes.CertificateClassID, Id: certificateID, Sender: claimant.String(), Receiver: other.String()}
+	exec := authz.NewMsgExec(other, []sdk.Msg{send})
+	for _, msgs := range [][]sdk.Msg{{send}, {&exec}} {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		_, err = app.AnteHandler()(ctx, builder.GetTx(), false)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+
+	// nor through the router interchain account transactions execute through,
+	// which skips the ante handler
+	router := taskante.WrapMessageRouter(app.MsgServiceRouter())
+	for _, msg := range []sdk.Msg{send, &exec} {
+		_, err = router.Handler(msg)(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+	require.Equal(t, claimant, app.NFTKeeper.GetOwner(ctx, tasktypes.CertificateClassID, certificateID))
+}
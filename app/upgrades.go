@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade describes an on-chain software upgrade handled by the app.
+type Upgrade struct {
+	// Name of the upgrade plan, as proposed through x/upgrade.
+	Name string
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// V2UpgradeName is the upgrade plan that moves x/task to consensus version 2.
+const V2UpgradeName = "v2"
+
+// Upgrades lists every upgrade the app knows how to apply.
+var Upgrades = []Upgrade{
+	{Name: V2UpgradeName},
+}
+
+// registerUpgradeHandlers registers a handler running the module migrations
+// for every upgrade, and sets the store loader of the upgrade scheduled on
+// disk, if any. It must be called before the app is loaded.
+func (app *App) registerUpgradeHandlers() error {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.Name,
+			func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			},
+		)
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.Name == upgradeInfo.Name {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &upgrade.StoreUpgrades))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v1 "taskbounty/x/task/migrations/v1"
+	tasktypes "taskbounty/x/task/types"
+)
+
+func TestV2Upgrade(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10})
+
+	// pretend x/task still runs consensus version 1
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[tasktypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	// version 1 state, encoded as version 1 stored it
+	claimant := sdk.AccAddress([]byte("claimantAddr________________")).String()
+	defaults := tasktypes.DefaultParams()
+	v1Params := v1.Params{
+		MinBounty:            v1.NewCoin(defaults.MinBounty.Denom, defaults.MinBounty.Amount.Int64()),
+		MaxBounty:            v1.NewCoin(defaults.MaxBounty.Denom, defaults.MaxBounty.Amount.Int64()),
+		MaxTitleLength:       defaults.MaxTitleLength,
+		MaxDescriptionLength: defaults.MaxDescriptionLength,
+		ProofTypes:           defaults.ProofTypes,
+		AutoApproveThreshold: defaults.AutoApproveThreshold,
+		TaskExpiry:           defaults.TaskExpiry,
+		ClaimDeadline:        defaults.ClaimDeadline,
+		SubmissionDeadline:   defaults.SubmissionDeadline,
+	}
+	store := ctx.KVStore(app.GetKey(tasktypes.StoreKey))
+	store.Set(v1.ParamsKey, v1Params.Marshal())
+	store.Set(v1.TaskKey(0), v1.Task{
+		Id:        0,
+		Bounty:    v1.NewCoin(sdk.DefaultBondDenom, 5000),
+		Status:    int32(tasktypes.TASK_STATUS_SUBMITTED),
+		Claimant:  claimant,
+		Proof:     "bafy:ipfs:150",
+		CreatedAt: 100,
+		UpdatedAt: 150,
+	}.Marshal())
+
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: V2UpgradeName, Height: ctx.BlockHeight()}))
+
+	toVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap()[tasktypes.ModuleName], toVM[tasktypes.ModuleName])
+
+	params, err := app.TaskKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+
+	task, err := app.TaskKeeper.Task.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)), task.Bounty)
+	require.Equal(t, int64(150), task.SubmittedAt)
+
+	submission, err := app.TaskKeeper.Submission.Get(ctx, collections.Join(uint64(0), uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, claimant, submission.Submitter)
+	require.Equal(t, tasktypes.TaskProof{Hash: "bafy", Type: "ipfs", Timestamp: 150}, submission.Proof)
+
+	has, err := app.TaskKeeper.DeadlineQueue.Has(ctx, collections.Join3(
+		int64(150+v1Params.SubmissionDeadline), uint64(0), int32(tasktypes.DeadlineSubmission),
+	))
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -10,12 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/spf13/cobra"
 
 	"taskbounty/x/task/types"
 )
 
-
 // GetTxCmd returns the transaction commands for the task module
 func GetTaskTxCmd() *cobra.Command {
 	taskTxCmd := &cobra.Command{
@@ -34,6 +35,18 @@ func GetTaskTxCmd() *cobra.Command {
 		GetCmdSubmitTask(),
 		GetCmdApproveTask(),
 		GetCmdRejectTask(),
+		GetCmdReviewTask(),
+		GetCmdDisputeTask(),
+		GetCmdResolveDispute(),
+		GetCmdUnclaimTask(),
+		GetCmdReopenTask(),
+		GetCmdCloseTask(),
+		GetCmdSetTaskApprovers(),
+		GetCmdProposeTaskReview(),
+		GetCmdGrantTaskApproval(),
+		GetCmdGrantTaskClaim(),
+		GetCmdSendCreateTask(),
+		GetCmdSendClaimTask(),
 	)
 
 	return taskTxCmd
@@ -55,6 +68,20 @@ func GetTaskQueryCmd() *cobra.Command {
 		GetCmdQueryTaskReward(),
 		GetCmdQueryTaskRewards(),
 		GetCmdQueryTaskRewardsByClaimant(),
+		GetCmdQueryTasksByCreator(),
+		GetCmdQueryTasksByClaimant(),
+		GetCmdQueryTasksByStatus(),
+		GetCmdQueryTaskRefund(),
+		GetCmdQueryTaskRefunds(),
+		GetCmdQueryTaskReviews(),
+		GetCmdQueryTaskDisputes(),
+		GetCmdQuerySubmission(),
+		GetCmdQuerySubmissions(),
+		GetCmdQueryTaskHistory(),
+		GetCmdQueryArchivedTask(),
+		GetCmdQueryArchivedTasks(),
+		GetCmdQueryRemoteTask(),
+		GetCmdQueryRemoteTasks(),
 	)
 
 	return taskQueryCmd
@@ -65,6 +92,7 @@ func GetCmdCreateTask() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [title] [description] [bounty]",
 		Short: "Create a new task with a bounty",
+		Long:  "Create a new task with a bounty of one or more coins, e.g. 500stake,20uatom. Each coin must lie within the bounds of its denom in the module params.",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -77,7 +105,7 @@ func GetCmdCreateTask() *cobra.Command {
 			bountyStr := args[2]
 
 			// Parse bounty
-			bounty, err := sdk.ParseCoinNormalized(bountyStr)
+			bounty, err := sdk.ParseCoinsNormalized(bountyStr)
 			if err != nil {
 				return fmt.Errorf("invalid bounty format: %v", err)
 			}
@@ -89,14 +117,116 @@ func GetCmdCreateTask() *cobra.Command {
 				bounty,
 			)
 
+			// Optional per-task deadlines, unset ones use the module params
+			if msg.TaskExpiry, err = durationFlagSeconds(cmd, flagTaskExpiry); err != nil {
+				return err
+			}
+			if msg.ClaimDeadline, err = durationFlagSeconds(cmd, flagClaimDeadline); err != nil {
+				return err
+			}
+			if msg.SubmissionDeadline, err = durationFlagSeconds(cmd, flagSubmissionDeadline); err != nil {
+				return err
+			}
+			if msg.Reviewers, err = cmd.Flags().GetStringSlice(flagReviewers); err != nil {
+				return err
+			}
+			if msg.Approvers, err = cmd.Flags().GetStringSlice(flagApprovers); err != nil {
+				return err
+			}
+			feeAllowance, err := cmd.Flags().GetString(flagFeeAllowance)
+			if err != nil {
+				return err
+			}
+			if feeAllowance != "" {
+				if msg.FeeAllowance, err = sdk.ParseCoinsNormalized(feeAllowance); err != nil {
+					return fmt.Errorf("invalid fee allowance format: %v", err)
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Duration(flagTaskExpiry, 0, "How long the task stays open for claiming, e.g. 1h or 336h (defaults to the module param)")
+	cmd.Flags().Duration(flagClaimDeadline, 0, "How long the claimant has to submit a proof (defaults to the module param)")
+	cmd.Flags().Duration(flagSubmissionDeadline, 0, "How long the creator has to review a submission (defaults to the module param)")
+	cmd.Flags().StringSlice(flagReviewers, nil, "Comma separated reviewers whose endorsements auto-approve the task")
+	cmd.Flags().StringSlice(flagApprovers, nil, "Comma separated approvers who can approve or reject submissions alongside you")
+	cmd.Flags().String(flagFeeAllowance, "", "Fees you sponsor for the claimant's submit transactions once the task is claimed, e.g. 500stake")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+const (
+	flagTaskExpiry         = "task-expiry"
+	flagClaimDeadline      = "claim-deadline"
+	flagSubmissionDeadline = "submission-deadline"
+	flagReviewers          = "reviewers"
+	flagApprovers          = "approvers"
+	flagFeeAllowance       = "fee-allowance"
+	flagExecTry            = "exec-try"
+	flagTaskIDs            = "task-ids"
+	flagExpiration         = "expiration"
+	flagClaimantWeight     = "claimant-weight"
+	flagCreatorWeight      = "creator-weight"
+	flagCreator            = "creator"
+	flagClaimant           = "claimant"
+	flagApprover           = "approver"
+	flagStatus             = "status"
+	flagMinBounty          = "min-bounty"
+	flagMaxBounty          = "max-bounty"
+	flagSortBy             = "sort-by"
+	flagSortDirection      = "sort-direction"
+	flagSortDenom          = "sort-denom"
+	flagPacketTimeout      = "packet-timeout"
+)
+
+// taskFilterFromFlags builds the ListTask filter and sort from the list command flags.
+func taskFilterFromFlags(cmd *cobra.Command) (*types.TaskFilter, *types.TaskSort, error) {
+	filter := &types.TaskFilter{}
+	filter.Creator, _ = cmd.Flags().GetString(flagCreator)
+	filter.Claimant, _ = cmd.Flags().GetString(flagClaimant)
+	filter.Approver, _ = cmd.Flags().GetString(flagApprover)
+
+	if status, _ := cmd.Flags().GetString(flagStatus); status != "" {
+		filter.Status = types.StringToTaskStatus(status)
+		if filter.Status == types.TASK_STATUS_UNDEFINED {
+			return nil, nil, fmt.Errorf("invalid task status: %s", status)
+		}
+	}
+	for name, coin := range map[string]*sdk.Coin{flagMinBounty: &filter.MinBounty, flagMaxBounty: &filter.MaxBounty} {
+		value, _ := cmd.Flags().GetString(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := sdk.ParseCoinNormalized(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --%s: %w", name, err)
+		}
+		*coin = parsed
+	}
+
+	by := &types.TaskSort{}
+	by.Field, _ = cmd.Flags().GetString(flagSortBy)
+	by.Direction, _ = cmd.Flags().GetString(flagSortDirection)
+	by.Denom, _ = cmd.Flags().GetString(flagSortDenom)
+
+	return filter, by, nil
+}
+
+// durationFlagSeconds reads a duration flag as whole seconds.
+func durationFlagSeconds(cmd *cobra.Command, name string) (uint64, error) {
+	d, err := cmd.Flags().GetDuration(name)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("--%s cannot be negative", name)
+	}
+
+	return uint64(d / time.Second), nil
+}
+
 // GetCmdUpdateTask implements the update task command handler
 func GetCmdUpdateTask() *cobra.Command {
 	cmd := &cobra.Command{
@@ -119,7 +249,7 @@ func GetCmdUpdateTask() *cobra.Command {
 			bountyStr := args[3]
 
 			// Parse bounty
-			bounty, err := sdk.ParseCoinNormalized(bountyStr)
+			bounty, err := sdk.ParseCoinsNormalized(bountyStr)
 			if err != nil {
 				return fmt.Errorf("invalid bounty format: %v", err)
 			}
@@ -191,11 +321,15 @@ func GetCmdClaimTask() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				id,
 			)
+			if msg.MaxBounty, err = maxBountyFromFlags(cmd); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addMsgMaxBountyFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -242,13 +376,12 @@ func GetCmdSubmitTask() *cobra.Command {
 	return cmd
 }
 
-
 // GetCmdApproveTask implements the approve task command handler
 func GetCmdApproveTask() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "approve [id] [tx-hash]",
-		Short: "Approve a submitted task",
-		Args:  cobra.ExactArgs(2),
+		Use:   "approve [id]",
+		Short: "Approve a submitted task and pay out its escrowed bounty",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -260,18 +393,19 @@ func GetCmdApproveTask() *cobra.Command {
 				return fmt.Errorf("invalid task id: %v", err)
 			}
 
-			txHash := args[1]
-
 			msg := types.NewMsgApproveTask(
 				clientCtx.GetFromAddress().String(),
 				id,
-				txHash,
 			)
+			if msg.MaxBounty, err = maxBountyFromFlags(cmd); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addMsgMaxBountyFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -301,168 +435,1197 @@ func GetCmdRejectTask() *cobra.Command {
 				id,
 				reason,
 			)
+			if msg.MaxBounty, err = maxBountyFromFlags(cmd); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addMsgMaxBountyFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdQueryTask implements the query task command handler
-func GetCmdQueryTask() *cobra.Command {
+// GetCmdReviewTask implements the review task command handler
+func GetCmdReviewTask() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get [id]",
-		Short: "Query a task by ID",
-		Args:  cobra.ExactArgs(1),
+		Use:   "review [id] [endorse|reject] [comment]",
+		Short: "Endorse or reject a submitted task as one of its reviewers",
+		Args:  cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
-
 			id, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return fmt.Errorf("invalid task id: %v", err)
 			}
 
-			res, err := queryClient.GetTask(cmd.Context(), &types.QueryGetTaskRequest{Id: id})
+			decision := types.StringToReviewDecision(args[1])
+			if decision == types.REVIEW_DECISION_UNSPECIFIED {
+				return fmt.Errorf("invalid decision %q: must be endorse or reject", args[1])
+			}
+
+			comment := strings.Join(args[2:], " ")
+
+			msg := types.NewMsgReviewTask(
+				clientCtx.GetFromAddress().String(),
+				id,
+				decision,
+				comment,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDisputeTask implements the dispute task command handler
+func GetCmdDisputeTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [id] [reason]",
+		Short: "Dispute the rejection of your submission",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			reason := strings.Join(args[1:], " ")
+
+			msg := types.NewMsgDisputeTask(
+				clientCtx.GetFromAddress().String(),
+				id,
+				reason,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdQueryTasks implements the query tasks command handler
-func GetCmdQueryTasks() *cobra.Command {
+// GetCmdUnclaimTask implements the unclaim task command handler
+func GetCmdUnclaimTask() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "Query all tasks",
+		Use:   "unclaim [id] [reason]",
+		Short: "Release a task you claimed so that others can claim it",
+		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			reason := strings.Join(args[1:], " ")
+
+			msg := types.NewMsgUnclaimTask(
+				clientCtx.GetFromAddress().String(),
+				id,
+				reason,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReopenTask implements the reopen task command handler
+func GetCmdReopenTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen [id] [reason]",
+		Short: "Reopen your rejected task for new claims",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.ListTask(cmd.Context(), &types.QueryAllTaskRequest{Pagination: pageReq})
+			id, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid task id: %v", err)
 			}
 
-			return clientCtx.PrintProto(res)
+			reason := strings.Join(args[1:], " ")
+
+			msg := types.NewMsgReopenTask(
+				clientCtx.GetFromAddress().String(),
+				id,
+				reason,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "tasks")
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdQueryTaskReward implements the query task reward command handler
-func GetCmdQueryTaskReward() *cobra.Command {
+// GetCmdCloseTask implements the close task command handler
+func GetCmdCloseTask() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "reward [id]",
-		Short: "Query a task reward by ID",
+		Use:   "close [id]",
+		Short: "Cancel your open task with a refund or finalize your approved task",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
-
 			id, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return fmt.Errorf("invalid task id: %v", err)
 			}
 
-			res, err := queryClient.GetTaskReward(cmd.Context(), &types.QueryGetTaskRewardRequest{Id: id})
+			msg := types.NewMsgCloseTask(
+				clientCtx.GetFromAddress().String(),
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTaskApprovers implements the set task approvers command handler
+func GetCmdSetTaskApprovers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approvers [id] [approver]...",
+		Short: "Replace the approvers of your open task, none leaves approval to you only",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			msg := types.NewMsgSetTaskApprovers(
+				clientCtx.GetFromAddress().String(),
+				id,
+				args[1:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdQueryTaskRewards implements the query task rewards command handler
-func GetCmdQueryTaskRewards() *cobra.Command {
+// GetCmdProposeTaskReview implements the propose task review command handler
+func GetCmdProposeTaskReview() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "rewards",
-		Short: "Query all task rewards",
+		Use:   "propose-review [group-policy] [id] [approve|reject] [reason]",
+		Short: "Propose to a group policy approver to approve or reject a submitted task",
+		Long:  "Submits an x/group proposal that approves or rejects the task on behalf of the group policy, which must be one of the task's approvers. The proposal is executed once the group's decision policy accepts it.",
+		Args:  cobra.MinimumNArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			exec := group.Exec_EXEC_UNSPECIFIED
+			if tryExec, _ := cmd.Flags().GetBool(flagExecTry); tryExec {
+				exec = group.Exec_EXEC_TRY
+			}
+
+			proposers := []string{clientCtx.GetFromAddress().String()}
+			reason := strings.Join(args[3:], " ")
+
+			var msg *group.MsgSubmitProposal
+			switch strings.ToLower(args[2]) {
+			case "approve":
+				msg, err = types.NewMsgProposeApproveTask(args[0], proposers, id, exec, fmt.Sprintf("Approve task %d", id), reason)
+			case "reject":
+				msg, err = types.NewMsgProposeRejectTask(args[0], proposers, id, reason, exec, fmt.Sprintf("Reject task %d", id), reason)
+			default:
+				return fmt.Errorf("decision must be approve or reject")
+			}
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.ListTaskReward(cmd.Context(), &types.QueryAllTaskRewardRequest{Pagination: pageReq})
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagExecTry, false, "Try to execute the proposal right away, which succeeds if your vote alone satisfies the decision policy")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGrantTaskApproval implements the grant task approval command handler
+func GetCmdGrantTaskApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-approval [grantee] [approve|reject]",
+		Short: "Allow another account to approve or reject submissions of your tasks",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			var authorizationType types.TaskApprovalType
+			switch strings.ToLower(args[1]) {
+			case "approve":
+				authorizationType = types.TASK_APPROVAL_TYPE_APPROVE
+			case "reject":
+				authorizationType = types.TASK_APPROVAL_TYPE_REJECT
+			default:
+				return fmt.Errorf("authorization type must be approve or reject")
+			}
+
+			maxBounty, taskIDs, expiration, err := taskGrantFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return grantTaskAuthorization(cmd, clientCtx, args[0], types.NewTaskApprovalAuthorization(authorizationType, maxBounty, taskIDs), expiration)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "task-rewards")
+	addTaskGrantFlags(cmd)
 	return cmd
 }
 
-// GetCmdQueryTaskRewardsByClaimant implements the query task rewards by claimant command handler
-func GetCmdQueryTaskRewardsByClaimant() *cobra.Command {
+// GetCmdGrantTaskClaim implements the grant task claim command handler
+func GetCmdGrantTaskClaim() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "rewards-by-claimant [claimant]",
-		Short: "Query all task rewards for a specific claimant",
+		Use:   "grant-claim [grantee]",
+		Short: "Allow another account, such as a bot, to claim tasks on your behalf",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
-
-			claimant := args[0]
-
-			res, err := queryClient.GetTaskRewardsByClaimant(cmd.Context(), &types.QueryGetTaskRewardsByClaimantRequest{Claimant: claimant})
+			maxBounty, taskIDs, expiration, err := taskGrantFromFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return grantTaskAuthorization(cmd, clientCtx, args[0], types.NewTaskClaimAuthorization(maxBounty, taskIDs), expiration)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	addTaskGrantFlags(cmd)
 	return cmd
-}
\ No newline at end of file
+}
+
+func addTaskGrantFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMaxBounty, "", "Only messages whose --max-bounty is at most this in each of its denoms, e.g. 5000stake,100uatom")
+	cmd.Flags().String(flagTaskIDs, "", "Comma separated ids of the only tasks covered by the grant")
+	cmd.Flags().Duration(flagExpiration, 0, "How long the grant lasts, e.g. 720h (no expiration by default)")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+func taskGrantFromFlags(cmd *cobra.Command) (sdk.Coins, []uint64, *time.Time, error) {
+	maxBounty, err := maxBountyFromFlags(cmd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var taskIDs []uint64
+	if value, _ := cmd.Flags().GetString(flagTaskIDs); value != "" {
+		for _, idStr := range strings.Split(value, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("invalid task id: %v", err)
+			}
+			taskIDs = append(taskIDs, id)
+		}
+	}
+
+	var expiration *time.Time
+	if duration, _ := cmd.Flags().GetDuration(flagExpiration); duration > 0 {
+		at := time.Now().Add(duration)
+		expiration = &at
+	}
+
+	return maxBounty, taskIDs, expiration, nil
+}
+
+// addMsgMaxBountyFlag adds the --max-bounty flag of the messages that task
+// authorizations bound.
+func addMsgMaxBountyFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagMaxBounty, "", "Fail unless the task bounty is at most this in each of its denoms, e.g. 5000stake; required when executed through an authz grant with a max bounty")
+}
+
+func maxBountyFromFlags(cmd *cobra.Command) (sdk.Coins, error) {
+	value, _ := cmd.Flags().GetString(flagMaxBounty)
+	maxBounty, err := sdk.ParseCoinsNormalized(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flagMaxBounty, err)
+	}
+
+	return maxBounty, nil
+}
+
+func grantTaskAuthorization(cmd *cobra.Command, clientCtx client.Context, grantee string, authorization authz.Authorization, expiration *time.Time) error {
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+
+	msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), granteeAddr, authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// GetCmdResolveDispute implements the resolve dispute command handler
+func GetCmdResolveDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-dispute [id] [payout|refund|split] [note]",
+		Short: "Resolve a disputed task as an arbiter",
+		Long:  "Resolve a disputed task as an arbiter. A split divides the bounty by --claimant-weight and --creator-weight.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			resolution := types.StringToDisputeResolution(args[1])
+			if resolution == types.DISPUTE_RESOLUTION_UNSPECIFIED {
+				return fmt.Errorf("invalid resolution %q: must be payout, refund or split", args[1])
+			}
+
+			claimantWeight, err := cmd.Flags().GetUint64(flagClaimantWeight)
+			if err != nil {
+				return err
+			}
+			creatorWeight, err := cmd.Flags().GetUint64(flagCreatorWeight)
+			if err != nil {
+				return err
+			}
+
+			note := strings.Join(args[2:], " ")
+
+			msg := types.NewMsgResolveDispute(
+				clientCtx.GetFromAddress().String(),
+				id,
+				resolution,
+				claimantWeight,
+				creatorWeight,
+				note,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagClaimantWeight, 0, "Weight of the claimant's share in a split")
+	cmd.Flags().Uint64(flagCreatorWeight, 0, "Weight of the creator's share in a split")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSendCreateTask implements the send create task command handler
+func GetCmdSendCreateTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-create-task [channel-id] [title] [description] [bounty]",
+		Short: "Create a task on the chain at the other end of a task channel",
+		Long:  "Create a task on the chain at the other end of a task channel. The bounty is escrowed from your remote account on that chain, which must be funded first.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bounty, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid bounty format: %v", err)
+			}
+
+			timeout, err := packetTimeoutFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendCreateTask(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				timeout,
+				args[1],
+				args[2],
+				bounty,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagPacketTimeout, types.DefaultPacketTimeout, "How long the packet can take to be relayed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSendClaimTask implements the send claim task command handler
+func GetCmdSendClaimTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-claim-task [channel-id] [task-id]",
+		Short: "Claim a task on the chain at the other end of a task channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			timeout, err := packetTimeoutFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendClaimTask(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				timeout,
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagPacketTimeout, types.DefaultPacketTimeout, "How long the packet can take to be relayed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// packetTimeoutFromFlags turns the relative packet timeout flag into an
+// absolute timestamp in unix nanoseconds.
+func packetTimeoutFromFlags(cmd *cobra.Command) (uint64, error) {
+	d, err := cmd.Flags().GetDuration(flagPacketTimeout)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("--%s must be positive", flagPacketTimeout)
+	}
+
+	return uint64(time.Now().Add(d).UnixNano()), nil
+}
+
+// GetCmdQueryTask implements the query task command handler
+func GetCmdQueryTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [id]",
+		Short: "Query a task by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			res, err := queryClient.GetTask(cmd.Context(), &types.QueryGetTaskRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTasks implements the query tasks command handler
+func GetCmdQueryTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query all tasks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, by, err := taskFilterFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListTask(cmd.Context(), &types.QueryAllTaskRequest{Pagination: pageReq, Filter: filter, Sort: by})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCreator, "", "Only list tasks created by this address")
+	cmd.Flags().String(flagClaimant, "", "Only list tasks claimed by this address")
+	cmd.Flags().String(flagApprover, "", "Only list tasks this address approves or approved")
+	cmd.Flags().String(flagStatus, "", "Only list tasks in this status (open|claimed|submitted|approved|rejected|closed|disputed)")
+	cmd.Flags().String(flagMinBounty, "", "Only list tasks with at least this bounty amount in its denom, e.g. 1000stake")
+	cmd.Flags().String(flagMaxBounty, "", "Only list tasks with a bounty of at most this amount in its denom, e.g. 5000stake")
+	cmd.Flags().String(flagSortBy, "", "Sort tasks by id, bounty, status or created_at (defaults to id)")
+	cmd.Flags().String(flagSortDirection, "", "Sort direction, asc or desc (defaults to asc)")
+	cmd.Flags().String(flagSortDenom, "", "Denom of the bounty amounts to sort by, only tasks with a bounty in it are listed (defaults to the denom of --min-bounty or --max-bounty, or else the min_bounty denom of params)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tasks")
+	return cmd
+}
+
+// GetCmdQueryTaskReward implements the query task reward command handler
+func GetCmdQueryTaskReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward [id]",
+		Short: "Query a task reward by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			res, err := queryClient.GetTaskReward(cmd.Context(), &types.QueryGetTaskRewardRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaskRewards implements the query task rewards command handler
+func GetCmdQueryTaskRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Query all task rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListTaskReward(cmd.Context(), &types.QueryAllTaskRewardRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "task-rewards")
+	return cmd
+}
+
+// GetCmdQueryTaskRewardsByClaimant implements the query task rewards by claimant command handler
+func GetCmdQueryTaskRewardsByClaimant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-by-claimant [claimant]",
+		Short: "Query all task rewards for a specific claimant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			claimant := args[0]
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardsByClaimant(cmd.Context(), &types.QueryRewardsByClaimantRequest{Claimant: claimant, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rewards-by-claimant")
+	return cmd
+}
+
+// GetCmdQueryTasksByCreator implements the query tasks by creator command handler
+func GetCmdQueryTasksByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tasks-by-creator [creator]",
+		Short: "Query the tasks created by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TasksByCreator(cmd.Context(), &types.QueryTasksByCreatorRequest{Creator: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tasks-by-creator")
+	return cmd
+}
+
+// GetCmdQueryTasksByClaimant implements the query tasks by claimant command handler
+func GetCmdQueryTasksByClaimant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tasks-by-claimant [claimant]",
+		Short: "Query the tasks claimed by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TasksByClaimant(cmd.Context(), &types.QueryTasksByClaimantRequest{Claimant: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tasks-by-claimant")
+	return cmd
+}
+
+// GetCmdQueryTasksByStatus implements the query tasks by status command handler
+func GetCmdQueryTasksByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tasks-by-status [open|claimed|submitted|approved|rejected|closed|disputed]",
+		Short: "Query the tasks in a status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status := types.StringToTaskStatus(args[0])
+			if status == types.TASK_STATUS_UNDEFINED {
+				return fmt.Errorf("invalid task status: %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TasksByStatus(cmd.Context(), &types.QueryTasksByStatusRequest{Status: status, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tasks-by-status")
+	return cmd
+}
+
+// GetCmdQueryTaskRefund implements the query task refund command handler
+func GetCmdQueryTaskRefund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund [id]",
+		Short: "Query the refund of a task's escrowed bounty by task ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			res, err := queryClient.GetTaskRefund(cmd.Context(), &types.QueryGetTaskRefundRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaskRefunds implements the query task refunds command handler
+func GetCmdQueryTaskRefunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refunds",
+		Short: "Query all task refunds",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListTaskRefund(cmd.Context(), &types.QueryAllTaskRefundRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "task-refunds")
+	return cmd
+}
+
+// GetCmdQueryTaskReviews implements the query task reviews command handler
+func GetCmdQueryTaskReviews() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reviews [id]",
+		Short: "Query the reviews of the current submission of a task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListTaskReview(cmd.Context(), &types.QueryAllTaskReviewRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "task-reviews")
+	return cmd
+}
+
+// GetCmdQueryTaskDisputes implements the query task disputes command handler
+func GetCmdQueryTaskDisputes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disputes [id]",
+		Short: "Query the dispute and resolution history of a task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListTaskDispute(cmd.Context(), &types.QueryAllTaskDisputeRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "task-disputes")
+	return cmd
+}
+
+// GetCmdQuerySubmission implements the query submission command handler
+func GetCmdQuerySubmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submission [id] [attempt]",
+		Short: "Query a submission of a task by attempt number",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			attempt, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid attempt: %v", err)
+			}
+
+			res, err := queryClient.GetSubmission(cmd.Context(), &types.QueryGetSubmissionRequest{Id: id, Attempt: attempt})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySubmissions implements the query submissions command handler
+func GetCmdQuerySubmissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submissions [id]",
+		Short: "Query all submissions of a task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListSubmission(cmd.Context(), &types.QueryAllSubmissionRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "submissions")
+	return cmd
+}
+
+// GetCmdQueryTaskHistory implements the query task history command handler
+func GetCmdQueryTaskHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [id]",
+		Short: "Query the status history of a task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TaskHistory(cmd.Context(), &types.QueryTaskHistoryRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
+
+// GetCmdQueryArchivedTask implements the query archived task command handler
+func GetCmdQueryArchivedTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived [id]",
+		Short: "Query an archived task by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			res, err := queryClient.GetArchivedTask(cmd.Context(), &types.QueryGetArchivedTaskRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryArchivedTasks implements the query archived tasks command handler
+func GetCmdQueryArchivedTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-archived",
+		Short: "Query all archived tasks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListArchivedTask(cmd.Context(), &types.QueryAllArchivedTaskRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "archived tasks")
+	return cmd
+}
+
+// GetCmdQueryRemoteTask implements the query remote task command handler
+func GetCmdQueryRemoteTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-task [channel-id] [task-id]",
+		Short: "Query a task of the chain at the other end of a task channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id: %v", err)
+			}
+
+			res, err := queryClient.GetRemoteTask(cmd.Context(), &types.QueryGetRemoteTaskRequest{ChannelId: args[0], TaskId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRemoteTasks implements the query remote tasks command handler
+func GetCmdQueryRemoteTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-tasks",
+		Short: "Query all tasks of other chains created or claimed from this chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListRemoteTask(cmd.Context(), &types.QueryAllRemoteTaskRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "remote tasks")
+	return cmd
+}
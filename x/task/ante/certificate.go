@@ -0,0 +1,107 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"taskbounty/x/task/types"
+)
+
+// CertificateTransferDecorator rejects transactions sending task completion
+// certificates, which stay with the claimant they were minted to. Messages
+// wrapped in authz executions and group proposals are checked as well.
+type CertificateTransferDecorator struct{}
+
+// NewCertificateTransferDecorator returns a CertificateTransferDecorator.
+func NewCertificateTransferDecorator() CertificateTransferDecorator {
+	return CertificateTransferDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d CertificateTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := rejectCertificateTransfer(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// WrapAnteHandler runs the CertificateTransferDecorator ahead of the app's
+// ante handler.
+func WrapAnteHandler(anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	if anteHandler == nil {
+		anteHandler = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		}
+	}
+
+	decorator := NewCertificateTransferDecorator()
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return decorator.AnteHandle(ctx, tx, simulate, anteHandler)
+	}
+}
+
+// MessageRouter routes messages to their handlers.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// WrapMessageRouter rejects certificate transfers in the messages dispatched
+// through router. Modules executing messages without running the ante
+// handler, such as the interchain accounts host, must route them through it.
+func WrapMessageRouter(router MessageRouter) MessageRouter {
+	return certificateTransferRouter{router: router}
+}
+
+type certificateTransferRouter struct {
+	router MessageRouter
+}
+
+// Handler implements MessageRouter.
+func (r certificateTransferRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := rejectCertificateTransfer([]sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, msg)
+	}
+}
+
+func rejectCertificateTransfer(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var (
+			nested []sdk.Msg
+			err    error
+		)
+		switch msg := msg.(type) {
+		case *nft.MsgSend:
+			if msg.ClassId == types.CertificateClassID {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "task certificate %s is not transferable", msg.Id)
+			}
+		case *authz.MsgExec:
+			nested, err = msg.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = msg.GetMsgs()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := rejectCertificateTransfer(nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -10,9 +10,6 @@ import (
 	"net/url"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/types"
-
 	taskTypes "taskbounty/x/task/types"
 )
 
@@ -164,6 +161,50 @@ func (c *TaskClient) GetTaskRewardsByClaimant(ctx context.Context, claimant stri
 	return &result, nil
 }
 
+// GetTaskRefund fetches the refund of a task by task ID
+func (c *TaskClient) GetTaskRefund(ctx context.Context, id uint64) (*taskTypes.QueryGetTaskRefundResponse, error) {
+	endpoint := fmt.Sprintf("%s/taskbounty/task/v1/task_refund/%d", c.BaseURL, id)
+
+	resp, err := c.HTTPClient.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var result taskTypes.QueryGetTaskRefundResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListTaskRefunds fetches all task refunds
+func (c *TaskClient) ListTaskRefunds(ctx context.Context) (*taskTypes.QueryAllTaskRefundResponse, error) {
+	endpoint := fmt.Sprintf("%s/taskbounty/task/v1/task_refund", c.BaseURL)
+
+	resp, err := c.HTTPClient.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var result taskTypes.QueryAllTaskRefundResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // CreateTask creates a new task
 func (c *TaskClient) CreateTask(ctx context.Context, req *taskTypes.MsgCreateTask) (*taskTypes.MsgCreateTaskResponse, error) {
 	endpoint := fmt.Sprintf("%s/taskbounty/task/v1/task", c.BaseURL)
@@ -1,11 +1,8 @@
 package rest
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -139,6 +136,10 @@ func createTaskHandler(clientCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgCreateTask(req.Creator, req.Title, req.Description, req.Bounty)
+		msg.TaskExpiry = req.TaskExpiry
+		msg.ClaimDeadline = req.ClaimDeadline
+		msg.SubmissionDeadline = req.SubmissionDeadline
+		msg.Reviewers = req.Reviewers
 		txWrite := TxResponseGenerator{
 			ClientCtx: clientCtx,
 			TxBuilder: clientCtx.TxConfig.NewTxBuilder(),
@@ -338,7 +339,7 @@ func approveTaskHandler(clientCtx client.Context) http.HandlerFunc {
 		}
 		req.Id = taskID
 
-		msg := types.NewMsgApproveTask(req.Approver, req.Id, req.TxHash)
+		msg := types.NewMsgApproveTask(req.Approver, req.Id)
 		txWrite := TxResponseGenerator{
 			ClientCtx: clientCtx,
 			TxBuilder: clientCtx.TxConfig.NewTxBuilder(),
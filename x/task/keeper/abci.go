@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// deadlineRetryDelay is how long a deadline that failed to apply waits before
+// it is applied again.
+const deadlineRetryDelay = time.Minute
+
+// EndBlocker drains the entries of the deadline queue that are due, at most
+// MaxDeadlinesPerBlock of them, and applies the deadline to its task: expired
+// open tasks are closed and refunded, stale claims are returned to OPEN and
+// unreviewed submissions get the ReviewTimeoutAction param applied. A deadline
+// that fails to apply is re-queued deadlineRetryDelay later, and dropped once
+// it failed MaxDeadlineRetries times after its first attempt.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// entries beyond the cap stay queued and are applied in the next blocks,
+	// oldest first
+	var due []collections.Triple[int64, uint64, int32]
+	rng := collections.NewPrefixUntilTripleRange[int64, uint64, int32](sdkCtx.BlockTime().Unix())
+	if err := k.DeadlineQueue.Walk(ctx, rng, func(key collections.Triple[int64, uint64, int32]) (bool, error) {
+		due = append(due, key)
+		return uint32(len(due)) >= params.MaxDeadlinesPerBlock, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.DeadlineQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		// a task that cannot be processed must not halt the chain, so every
+		// deadline is applied in its own cache and discarded on failure
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.applyDeadline(cacheCtx, params, key.K2(), types.DeadlineKind(key.K3())); err != nil {
+			sdkCtx.Logger().Error(
+				"failed to apply task deadline",
+				"module", "x/"+types.ModuleName,
+				"task_id", key.K2(),
+				"err", err,
+			)
+			if err := k.retryDeadline(ctx, params, key, err); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+
+		if err := k.DeadlineRetry.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retryDeadline re-queues a deadline that failed to apply deadlineRetryDelay
+// later, rather than losing it and leaving the task and its escrow waiting on
+// a user. Once the deadline failed MaxDeadlineRetries times after its first
+// attempt it is dropped instead, so that a task that can never be processed
+// does not occupy the queue forever.
+func (k Keeper) retryDeadline(ctx context.Context, params types.Params, key collections.Triple[int64, uint64, int32], applyErr error) error {
+	retryKey := collections.Join(key.K2(), key.K3())
+	retries, err := k.DeadlineRetry.Get(ctx, retryKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if retries >= params.MaxDeadlineRetries {
+		if err := k.DeadlineRetry.Remove(ctx, retryKey); err != nil {
+			return err
+		}
+		return emitEvent(ctx, &types.EventTaskDeadlineDropped{
+			TaskId:   key.K2(),
+			Kind:     key.K3(),
+			Attempts: retries + 1,
+			Error:    applyErr.Error(),
+		})
+	}
+
+	if err := k.DeadlineRetry.Set(ctx, retryKey, retries+1); err != nil {
+		return err
+	}
+	retryAt := sdk.UnwrapSDKContext(ctx).BlockTime().Add(deadlineRetryDelay).Unix()
+	return k.DeadlineQueue.Set(ctx, collections.Join3(retryAt, key.K2(), key.K3()))
+}
+
+// applyDeadline applies a due deadline of the given kind to a task. Entries
+// that no longer match the task's status are dropped, and entries whose
+// deadline moved into the future (e.g. after a params change) are re-queued.
+func (k Keeper) applyDeadline(ctx sdk.Context, params types.Params, id uint64, kind types.DeadlineKind) error {
+	task, err := k.Task.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if pending, ok := task.PendingDeadline(); !ok || pending != kind {
+		return nil
+	}
+	at, ok := task.DeadlineAt(params, kind)
+	if !ok {
+		return nil
+	}
+	if !ctx.BlockTime().After(at) {
+		return k.scheduleDeadline(ctx, task, params)
+	}
+
+	switch kind {
+	case types.DeadlineTaskExpiry:
+		return k.expireTask(ctx, task)
+	case types.DeadlineClaim:
+		return k.expireClaim(ctx, task, params)
+	default:
+		return k.timeoutReview(ctx, task, params)
+	}
+}
@@ -0,0 +1,404 @@
+package keeper_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+// afterSeconds returns a context whose block time is seconds after the fixture's block time.
+func afterSeconds(f *fixture, seconds uint64) sdk.Context {
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	return sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(time.Duration(seconds) * time.Second)).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// requireEvent asserts that the typed event was emitted on the context,
+// comparing every attribute of the event.
+func requireEvent(t *testing.T, ctx context.Context, expected proto.Message) {
+	t.Helper()
+
+	want, err := sdk.TypedEventToEvent(expected)
+	require.NoError(t, err)
+
+	var emitted []abci.Event
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents() {
+		if event.Type != want.Type {
+			continue
+		}
+		if reflect.DeepEqual(want.Attributes, event.Attributes) {
+			return
+		}
+		emitted = append(emitted, event)
+	}
+	require.Failf(t, "event not emitted", "want %v, emitted %v", want, emitted)
+}
+
+func TestEndBlockerTaskExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	// nothing is due before the expiry
+	ctx := afterSeconds(f, params.TaskExpiry)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err := f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	ctx = afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err = f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	refund, err := f.keeper.TaskRefund.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.RefundReasonExpired, refund.Reason)
+
+	requireEvent(t, ctx, &types.EventTaskExpired{
+		TaskId:    resp.Id,
+		Creator:   actors.creator,
+		Refund:    testBounty,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLOSED,
+	})
+	requireEvent(t, ctx, &types.EventTaskRefunded{
+		TaskId:  resp.Id,
+		Creator: actors.creator,
+		Amount:  testBounty,
+		Reason:  types.RefundReasonExpired,
+	})
+
+	// the queue has been drained
+	empty, err := f.keeper.DeadlineQueue.Iterate(ctx, nil)
+	require.NoError(t, err)
+	defer empty.Close()
+	require.False(t, empty.Valid())
+}
+
+func TestEndBlockerClaimDeadline(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, resp.Id))
+	require.NoError(t, err)
+
+	ctx := afterSeconds(f, params.ClaimDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err := f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+	require.Empty(t, task.Claimant)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	requireEvent(t, ctx, &types.EventTaskClaimExpired{
+		TaskId:    resp.Id,
+		Claimant:  actors.claimant,
+		OldStatus: types.TASK_STATUS_CLAIMED,
+		NewStatus: types.TASK_STATUS_OPEN,
+	})
+
+	// the reopened task still expires at its original expiry
+	ctx = afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err = f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+}
+
+func TestEndBlockerSubmissionDeadline(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		action types.ReviewTimeoutAction
+		status types.TaskStatus
+	}{
+		{desc: "approve", action: types.REVIEW_TIMEOUT_ACTION_APPROVE, status: types.TASK_STATUS_APPROVED},
+		{desc: "reject", action: types.REVIEW_TIMEOUT_ACTION_REJECT, status: types.TASK_STATUS_REJECTED},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := initFixture(t)
+			srv := keeper.NewMsgServerImpl(f.keeper)
+			actors := newTaskActors(t, f)
+
+			params := types.DefaultParams()
+			params.ReviewTimeoutAction = tc.action
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			id := createSubmittedTask(t, f, srv, actors)
+
+			ctx := afterSeconds(f, params.SubmissionDeadline+1)
+			require.NoError(t, f.keeper.EndBlocker(ctx))
+
+			task, err := f.keeper.Task.Get(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, task.Status)
+
+			requireEvent(t, ctx, &types.EventTaskReviewTimeout{
+				TaskId:    id,
+				Claimant:  actors.claimant,
+				Action:    tc.action,
+				OldStatus: types.TASK_STATUS_SUBMITTED,
+				NewStatus: tc.status,
+			})
+
+			_, err = f.keeper.TaskReward.Get(ctx, id)
+			if tc.status == types.TASK_STATUS_APPROVED {
+				require.NoError(t, err)
+				require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+			} else {
+				require.Error(t, err)
+				require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+			}
+		})
+	}
+}
+
+func TestEndBlockerDeadlinesIgnoreUpdates(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	params := types.DefaultParams()
+	params.ReviewTimeoutAction = types.REVIEW_TIMEOUT_ACTION_APPROVE
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the creator updates the claimed task just before its claim deadline
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	claimed := resp.Id
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, claimed))
+	require.NoError(t, err)
+
+	// and the submitted task just before its review window ends
+	submitted := createSubmittedTask(t, f, srv, actors)
+
+	ctx := afterSeconds(f, params.ClaimDeadline-1)
+	_, err = srv.UpdateTask(ctx, newTestMsgUpdateTask(actors.creator, claimed, testBounty))
+	require.NoError(t, err)
+	ctx = afterSeconds(f, params.SubmissionDeadline-1)
+	_, err = srv.UpdateTask(ctx, newTestMsgUpdateTask(actors.creator, submitted, testBounty))
+	require.NoError(t, err)
+
+	// the deadlines still run from the claim and the submission
+	ctx = afterSeconds(f, params.ClaimDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err := f.keeper.Task.Get(ctx, claimed)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	ctx = afterSeconds(f, params.SubmissionDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err = f.keeper.Task.Get(ctx, submitted)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+}
+
+func TestEndBlockerStaleDeadlines(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	// the task is approved before any of its deadlines pass
+	id := createSubmittedTask(t, f, srv, actors)
+	_, err := srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.NoError(t, err)
+
+	ctx := afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err := f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, task.Status)
+	require.Empty(t, ctx.EventManager().Events())
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+}
+
+func TestEndBlockerRetriesFailedDeadlines(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	// the refund of the expired task fails while the escrow is missing
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	escrow := f.bankKeeper.balance(moduleAddr)
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins()
+
+	ctx := afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err := f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	// a later block applies the deadline once the refund can be paid
+	f.bankKeeper.fund(moduleAddr, escrow)
+	ctx = afterSeconds(f, params.TaskExpiry+2)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err = f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	ctx = afterSeconds(f, params.TaskExpiry+61)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err = f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+
+	has, err := f.keeper.DeadlineRetry.Has(ctx, collections.Join(resp.Id, int32(types.DeadlineTaskExpiry)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestEndBlockerDropsFailedDeadlines(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+	params.MaxDeadlineRetries = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	// the refund of the expired task keeps failing as the escrow is missing
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins()
+
+	// the first attempt and the first retry re-queue the deadline
+	for i, at := range []uint64{params.TaskExpiry + 1, params.TaskExpiry + 61} {
+		ctx := afterSeconds(f, at)
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+		retries, err := f.keeper.DeadlineRetry.Get(ctx, collections.Join(resp.Id, int32(types.DeadlineTaskExpiry)))
+		require.NoError(t, err)
+		require.Equal(t, uint32(i+1), retries)
+	}
+
+	// the last retry drops it
+	ctx := afterSeconds(f, params.TaskExpiry+121)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err := f.keeper.Task.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	queued, err := f.keeper.DeadlineQueue.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, queued.Valid())
+	require.NoError(t, queued.Close())
+	has, err := f.keeper.DeadlineRetry.Has(ctx, collections.Join(resp.Id, int32(types.DeadlineTaskExpiry)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	var dropped *types.EventTaskDeadlineDropped
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if e, ok := msg.(*types.EventTaskDeadlineDropped); ok {
+			dropped = e
+		}
+	}
+	require.NotNil(t, dropped)
+	require.Equal(t, resp.Id, dropped.TaskId)
+	require.Equal(t, int32(types.DeadlineTaskExpiry), dropped.Kind)
+	require.Equal(t, uint32(3), dropped.Attempts)
+	require.Contains(t, dropped.Error, "insufficient funds")
+}
+
+func TestEndBlockerMaxDeadlinesPerBlock(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+	params.MaxDeadlinesPerBlock = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	var ids []uint64
+	for range 3 {
+		f.bankKeeper.fund(actors.creatorAddr, testBounty)
+		resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+		require.NoError(t, err)
+		ids = append(ids, resp.Id)
+	}
+
+	requireStatuses := func(ctx context.Context, statuses ...types.TaskStatus) {
+		t.Helper()
+		for i, id := range ids {
+			task, err := f.keeper.Task.Get(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, statuses[i], task.Status)
+		}
+	}
+
+	// the oldest two deadlines are applied, the third waits for the next block
+	ctx := afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	requireStatuses(ctx, types.TASK_STATUS_CLOSED, types.TASK_STATUS_CLOSED, types.TASK_STATUS_OPEN)
+
+	ctx = afterSeconds(f, params.TaskExpiry+2)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	requireStatuses(ctx, types.TASK_STATUS_CLOSED, types.TASK_STATUS_CLOSED, types.TASK_STATUS_CLOSED)
+}
+
+func TestEndBlockerPerTaskDeadlines(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty.Add(testBounty...))
+
+	// a one hour hotfix bounty next to a task using the default expiry
+	hotfix := newTestMsgCreateTask(actors.creator)
+	hotfix.TaskExpiry = 3600
+	hotfixResp, err := srv.CreateTask(f.ctx, hotfix)
+	require.NoError(t, err)
+
+	defaultResp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	ctx := afterSeconds(f, 3601)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err := f.keeper.Task.Get(ctx, hotfixResp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+
+	task, err = f.keeper.Task.Get(ctx, defaultResp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"taskbounty/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// mintCertificate mints the completion certificate of an approved task to its
+// claimant under the certificate class, which is created on first use.
+func (k Keeper) mintCertificate(ctx context.Context, task types.Task) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+
+	if !k.nftKeeper.HasClass(ctx, types.CertificateClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, types.CertificateClass()); err != nil {
+			return errorsmod.Wrap(err, "failed to create certificate class")
+		}
+	}
+
+	claimant, err := k.addressCodec.StringToBytes(task.Claimant)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid claimant address")
+	}
+
+	// tasks submitted before submissions were recorded only keep the proof summary
+	proofHash := task.Proof
+	submission, err := k.latestSubmission(ctx, task.Id)
+	switch {
+	case err == nil:
+		proofHash = submission.Proof.Hash
+	case !errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return err
+	}
+
+	token, err := types.NewCertificateNFT(types.TaskCertificate{
+		TaskId:     task.Id,
+		Title:      task.Title,
+		Bounty:     task.Bounty,
+		ProofHash:  proofHash,
+		ApprovedAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to build certificate")
+	}
+
+	if err := k.nftKeeper.Mint(ctx, token, claimant); err != nil {
+		return errorsmod.Wrap(err, "failed to mint certificate")
+	}
+
+	return nil
+}
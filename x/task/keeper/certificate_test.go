@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestTaskCertificateMintedOnApproval(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	approved := createSubmittedTask(t, f, srv, actors)
+	rejected := createSubmittedTask(t, f, srv, actors)
+
+	_, err := srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, rejected, "incomplete"))
+	require.NoError(t, err)
+	require.Empty(t, f.nftKeeper.tokens)
+
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, approved))
+	require.NoError(t, err)
+
+	// the class is created along with the first certificate
+	require.Equal(t, types.CertificateClass(), f.nftKeeper.classes[types.CertificateClassID])
+
+	token, owner, ok := f.nftKeeper.token(types.CertificateClassID, types.CertificateID(approved))
+	require.True(t, ok)
+	require.Equal(t, actors.claimantAddr, owner)
+
+	var certificate types.TaskCertificate
+	require.NoError(t, certificate.Unmarshal(token.Data.Value))
+	require.Equal(t, types.TaskCertificate{
+		TaskId:     approved,
+		Title:      "Fix login bug",
+		Bounty:     testBounty,
+		ProofHash:  newTestProof(f).Hash,
+		ApprovedAt: newTestProof(f).Timestamp,
+	}, certificate)
+
+	// approvals decided by reviewers or the chain mint certificates too
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, rejected, newTestProof(f)))
+	require.NoError(t, err)
+	ctx := afterSeconds(f, types.DefaultParams().SubmissionDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	_, owner, ok = f.nftKeeper.token(types.CertificateClassID, types.CertificateID(rejected))
+	require.True(t, ok)
+	require.Equal(t, actors.claimantAddr, owner)
+	require.Len(t, f.nftKeeper.tokens, 2)
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// escrowBounty moves the bounty from the creator's account into the task module account.
+func (k Keeper) escrowBounty(ctx context.Context, creator string, bounty sdk.Coins) error {
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, creatorAddr)
+	if !spendable.IsAllGTE(bounty) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than bounty %s", spendable, bounty)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, bounty); err != nil {
+		return errorsmod.Wrap(err, "failed to escrow bounty")
+	}
+
+	return nil
+}
+
+// releaseBounty pays escrowed coins out of the task module account to the recipient.
+func (k Keeper) releaseBounty(ctx context.Context, recipient string, amount sdk.Coins) error {
+	recipientAddr, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, amount); err != nil {
+		return errorsmod.Wrap(err, "failed to release escrowed bounty")
+	}
+
+	return nil
+}
+
+// releaseTaskBounty releases coins of the bounty of task to the recipient.
+// Nothing is released for an unescrowed task, whose bounty never reached the
+// task module account.
+func (k Keeper) releaseTaskBounty(ctx context.Context, task types.Task, recipient string, amount sdk.Coins) error {
+	if task.Unescrowed {
+		return nil
+	}
+
+	return k.releaseBounty(ctx, recipient, amount)
+}
+
+// payoutTask releases the escrowed bounty of an approved task to its claimant
+// and records the payout as a TaskReward.
+func (k Keeper) payoutTask(ctx context.Context, task types.Task) (types.TaskReward, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reward := types.CreateTaskReward(task.Id, task.Claimant, task.Bounty, txHash(sdkCtx), sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix())
+
+	if err := types.ValidateRewardDistribution(task, reward); err != nil {
+		return types.TaskReward{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.recordReward(ctx, task, reward); err != nil {
+		return types.TaskReward{}, err
+	}
+
+	return reward, nil
+}
+
+// recordReward releases the reward amount of task to its claimant and stores the TaskReward.
+func (k Keeper) recordReward(ctx context.Context, task types.Task, reward types.TaskReward) error {
+	if err := k.releaseTaskBounty(ctx, task, reward.Claimant, reward.Amount); err != nil {
+		return err
+	}
+
+	if err := k.TaskReward.Set(ctx, reward.TaskId, reward); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task reward")
+	}
+
+	return emitEvent(ctx, &types.EventTaskPaid{
+		TaskId:   reward.TaskId,
+		Claimant: reward.Claimant,
+		Amount:   reward.Amount,
+		TxHash:   reward.TxHash,
+	})
+}
+
+// txHash returns the hash of the transaction being executed, or an empty
+// string when no transaction is being executed (e.g. during EndBlock).
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%X", cmttypes.Tx(ctx.TxBytes()).Hash())
+}
+
+// refundTask returns the escrowed bounty of a task to its creator and records
+// the refund as a TaskRefund.
+func (k Keeper) refundTask(ctx context.Context, task types.Task, reason string) (types.TaskRefund, error) {
+	return k.refundAmount(ctx, task, task.Bounty, reason)
+}
+
+// refundAmount returns part of the escrowed bounty of a task to its creator
+// and records the refund as a TaskRefund.
+func (k Keeper) refundAmount(ctx context.Context, task types.Task, amount sdk.Coins, reason string) (types.TaskRefund, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	refund := types.CreateTaskRefund(task.Id, task.Creator, amount, reason, txHash(sdkCtx), sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix())
+
+	if err := k.releaseTaskBounty(ctx, task, task.Creator, amount); err != nil {
+		return types.TaskRefund{}, err
+	}
+
+	if err := k.TaskRefund.Set(ctx, task.Id, refund); err != nil {
+		return types.TaskRefund{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task refund")
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskRefunded{
+		TaskId:  task.Id,
+		Creator: task.Creator,
+		Amount:  amount,
+		Reason:  reason,
+	}); err != nil {
+		return types.TaskRefund{}, err
+	}
+
+	return refund, nil
+}
+
+// splitTask divides the escrowed bounty of a disputed task between its
+// claimant and creator by weight, using SplitTaskReward. Truncation dust goes
+// back to the creator. It returns the amounts paid to the claimant and creator.
+func (k Keeper) splitTask(ctx context.Context, task types.Task, claimantWeight, creatorWeight uint64) (sdk.Coins, sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reward := types.CreateTaskReward(task.Id, task.Claimant, task.Bounty, txHash(sdkCtx), sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix())
+
+	shares := types.SplitTaskReward(
+		reward,
+		[]string{task.Claimant, task.Creator},
+		[]math.Int{math.NewIntFromUint64(claimantWeight), math.NewIntFromUint64(creatorWeight)},
+	)
+
+	var claimantAmount sdk.Coins
+	for _, share := range shares {
+		if share.Claimant == task.Claimant {
+			claimantAmount = share.Amount
+		}
+	}
+	creatorAmount := task.Bounty.Sub(claimantAmount...)
+
+	if !claimantAmount.Empty() {
+		reward.Amount = claimantAmount
+		if err := reward.Validate(); err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := k.recordReward(ctx, task, reward); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !creatorAmount.Empty() {
+		if _, err := k.refundAmount(ctx, task, creatorAmount, types.RefundReasonDisputed); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return claimantAmount, creatorAmount, nil
+}
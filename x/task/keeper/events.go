@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// emitEvent emits one of the typed task events defined in events.proto.
+func emitEvent(ctx context.Context, event proto.Message) error {
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to emit %s", proto.MessageName(event))
+	}
+
+	return nil
+}
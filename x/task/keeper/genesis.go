@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"taskbounty/x/task/types"
 )
 
@@ -17,7 +19,68 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := k.TaskSeq.Set(ctx, genState.TaskCount); err != nil {
 		return err
 	}
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, elem := range genState.TaskRewardList {
+		if err := k.TaskReward.Set(ctx, elem.TaskId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TaskRefundList {
+		if err := k.TaskRefund.Set(ctx, elem.TaskId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TaskReviewList {
+		if err := k.TaskReview.Set(ctx, collections.Join(elem.TaskId, elem.Reviewer), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TaskDisputeList {
+		if err := k.TaskDispute.Set(ctx, collections.Join(elem.TaskId, elem.Seq), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.SubmissionList {
+		if err := k.Submission.Set(ctx, collections.Join(elem.TaskId, elem.Attempt), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TaskHistoryList {
+		if err := k.TaskHistory.Set(ctx, collections.Join(elem.TaskId, elem.Seq), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ArchivedTaskList {
+		if err := k.ArchivedTask.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.RemoteTaskList {
+		if err := k.RemoteTask.Set(ctx, collections.Join(elem.ChannelId, elem.TaskId), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.RemoteSenderList {
+		if err := k.RemoteSender.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+
+	// the deadline queue is derived from the tasks and rebuilt on import, the
+	// retries of failed deadlines start over
+	for _, elem := range genState.TaskList {
+		if err := k.scheduleDeadline(ctx, elem, genState.Params); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -42,5 +105,69 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.TaskReward.Walk(ctx, nil, func(_ uint64, elem types.TaskReward) (bool, error) {
+		genesis.TaskRewardList = append(genesis.TaskRewardList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TaskRefund.Walk(ctx, nil, func(_ uint64, elem types.TaskRefund) (bool, error) {
+		genesis.TaskRefundList = append(genesis.TaskRefundList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TaskReview.Walk(ctx, nil, func(_ collections.Pair[uint64, string], elem types.TaskReview) (bool, error) {
+		genesis.TaskReviewList = append(genesis.TaskReviewList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TaskDispute.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.TaskDispute) (bool, error) {
+		genesis.TaskDisputeList = append(genesis.TaskDisputeList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Submission.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.Submission) (bool, error) {
+		genesis.SubmissionList = append(genesis.SubmissionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TaskHistory.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.TaskTransition) (bool, error) {
+		genesis.TaskHistoryList = append(genesis.TaskHistoryList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.ArchivedTask.Walk(ctx, nil, func(_ uint64, elem types.Task) (bool, error) {
+		genesis.ArchivedTaskList = append(genesis.ArchivedTaskList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.RemoteTask.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.RemoteTask) (bool, error) {
+		genesis.RemoteTaskList = append(genesis.RemoteTaskList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.RemoteSender.Walk(ctx, nil, func(_ string, elem types.RemoteSender) (bool, error) {
+		genesis.RemoteSenderList = append(genesis.RemoteSenderList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
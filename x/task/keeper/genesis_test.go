@@ -3,6 +3,10 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"taskbounty/x/task/keeper"
 	"taskbounty/x/task/types"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +30,107 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.TaskCount, got.TaskCount)
 
 }
+
+func TestGenesisAuxiliaryState(t *testing.T) {
+	claimant := sdk.AccAddress([]byte("claimantAddr________________")).String()
+	creator := sdk.AccAddress([]byte("creatorAddr_________________")).String()
+	reviewer := sdk.AccAddress([]byte("reviewerAddr________________")).String()
+	bounty := sdk.NewCoins(types.DefaultParams().MinBounty)
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		TaskList: []types.Task{
+			{Id: 0, Creator: creator, Claimant: claimant, Bounty: bounty, Status: types.TASK_STATUS_APPROVED, CreatedAt: 100, UpdatedAt: 200},
+			{Id: 1, Creator: creator, Claimant: claimant, Bounty: bounty, Status: types.TASK_STATUS_SUBMITTED, CreatedAt: 100, UpdatedAt: 300},
+			{Id: 2, Creator: creator, Bounty: bounty, Status: types.TASK_STATUS_CLOSED, CreatedAt: 100, UpdatedAt: 400},
+		},
+		TaskCount: 5,
+		TaskRewardList: []types.TaskReward{
+			{TaskId: 0, Claimant: claimant, Amount: bounty, Timestamp: 200, TxHash: "AB", BlockHeight: 5},
+		},
+		TaskRefundList: []types.TaskRefund{
+			{TaskId: 2, Creator: creator, Amount: bounty, Timestamp: 400, Reason: types.RefundReasonExpired},
+			{TaskId: 3, Creator: creator, Amount: bounty, Timestamp: 500, Reason: types.RefundReasonDeleted},
+			{TaskId: 4, Creator: creator, Amount: bounty, Timestamp: 600, Reason: types.RefundReasonClosed},
+		},
+		TaskReviewList: []types.TaskReview{
+			{TaskId: 1, Reviewer: reviewer, Decision: types.REVIEW_DECISION_ENDORSE, Timestamp: 310},
+		},
+		TaskDisputeList: []types.TaskDispute{
+			{TaskId: 0, Seq: 1, Claimant: claimant, Reason: "done", Resolution: types.DISPUTE_RESOLUTION_PAYOUT, ClaimantAmount: bounty},
+		},
+		SubmissionList: []types.Submission{
+			{TaskId: 0, Attempt: 1, Submitter: claimant, Outcome: types.SUBMISSION_OUTCOME_APPROVED},
+			{TaskId: 1, Attempt: 1, Submitter: claimant, Outcome: types.SUBMISSION_OUTCOME_REJECTED},
+			{TaskId: 1, Attempt: 2, Submitter: claimant, Proof: types.TaskProof{Hash: "bafy", Type: "ipfs", Timestamp: 300}},
+		},
+		TaskHistoryList: []types.TaskTransition{
+			{TaskId: 2, Seq: 0, From: types.TASK_STATUS_UNDEFINED, To: types.TASK_STATUS_OPEN, Actor: creator, BlockHeight: 1, Timestamp: 100},
+			{TaskId: 2, Seq: 1, From: types.TASK_STATUS_OPEN, To: types.TASK_STATUS_CLOSED, BlockHeight: 3, Timestamp: 400, Reason: types.RefundReasonExpired},
+			{TaskId: 3, Seq: 0, From: types.TASK_STATUS_OPEN, To: types.TASK_STATUS_UNDEFINED, Actor: creator, Reason: types.RefundReasonDeleted},
+		},
+		ArchivedTaskList: []types.Task{
+			{Id: 4, Creator: creator, Bounty: bounty, Status: types.TASK_STATUS_CLOSED, CreatedAt: 100, UpdatedAt: 600},
+		},
+		RemoteTaskList: []types.RemoteTask{
+			{ChannelId: "channel-0", TaskId: 7, Status: types.TASK_STATUS_CLAIMED, Creator: creator, CreatorAccount: "remote1creator", Claimant: claimant, ClaimantAccount: "remote1claimant"},
+		},
+		RemoteSenderList: []types.RemoteSender{
+			{Address: types.RemoteAccount("channel-1", remoteSender).String(), ChannelId: "channel-1", Sender: remoteSender},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+
+	// only the submitted task has a pending deadline
+	has, err := f.keeper.DeadlineQueue.Has(f.ctx, collections.Join3(
+		int64(300+genesisState.Params.SubmissionDeadline), uint64(1), int32(types.DeadlineSubmission),
+	))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.EqualExportedValues(t, genesisState, *got)
+}
+
+func TestGenesisExportClosedApprovedTask(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	// requireValidExport exports the genesis state, validates it and imports
+	// it into a new chain, which exports the same state
+	requireValidExport := func(t *testing.T) {
+		t.Helper()
+
+		got, err := f.keeper.ExportGenesis(f.ctx)
+		require.NoError(t, err)
+		require.NoError(t, got.Validate())
+
+		imported := initFixture(t)
+		require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *got))
+		reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+		require.NoError(t, err)
+		require.EqualExportedValues(t, *got, *reexported)
+	}
+
+	id := createSubmittedTask(t, f, srv, actors)
+	_, err := srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.NoError(t, err)
+	requireValidExport(t)
+
+	// the reward of the approved task is kept once it is closed
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.NoError(t, err)
+	requireValidExport(t)
+
+	// and once the closed task is deleted
+	_, err = srv.DeleteTask(f.ctx, types.NewMsgDeleteTask(actors.creator, id))
+	require.NoError(t, err)
+	_, err = f.keeper.TaskReward.Get(f.ctx, id)
+	require.NoError(t, err)
+	requireValidExport(t)
+}
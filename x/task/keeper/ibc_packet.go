@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// packetTimeout returns the absolute timeout of a task packet, defaulting to
+// DefaultPacketTimeout from the block time.
+func (k Keeper) packetTimeout(ctx context.Context, timeoutTimestamp uint64) (uint64, error) {
+	blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano())
+	if timeoutTimestamp == 0 {
+		return blockTime + uint64(types.DefaultPacketTimeout.Nanoseconds()), nil
+	}
+	if timeoutTimestamp <= blockTime {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPacketTimeout, "timeout %d is not after block time %d", timeoutTimestamp, blockTime)
+	}
+
+	return timeoutTimestamp, nil
+}
+
+// transmitTaskPacket sends a task packet over channelID and returns its
+// sequence.
+func (k Keeper) transmitTaskPacket(ctx context.Context, channelID string, timeoutTimestamp uint64, data types.TaskPacketData) (uint64, error) {
+	if k.ics4WrapperFn == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task packets are not supported")
+	}
+
+	bz, err := k.cdc.MarshalJSON(&data)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal task packet")
+	}
+
+	return k.ics4WrapperFn().SendPacket(sdk.UnwrapSDKContext(ctx), types.PortID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, bz)
+}
+
+// remoteAccount returns the address of the account acting for the sender of
+// a received packet and records the sender it acts for.
+func (k Keeper) remoteAccount(ctx context.Context, packet channeltypes.Packet, sender string) (string, error) {
+	account, err := k.addressCodec.BytesToString(types.RemoteAccount(packet.DestinationChannel, sender))
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid remote account")
+	}
+
+	if err := k.RemoteSender.Set(ctx, account, types.RemoteSender{
+		Address:   account,
+		ChannelId: packet.DestinationChannel,
+		Sender:    sender,
+	}); err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set remote sender")
+	}
+
+	return account, nil
+}
+
+// OnRecvCreateTaskPacket creates the task of a received create-task packet
+// for the remote account of the sender, which escrows the bounty.
+func (k Keeper) OnRecvCreateTaskPacket(ctx context.Context, packet channeltypes.Packet, data types.CreateTaskPacketData) (types.TaskPacketAck, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.TaskPacketAck{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	account, err := k.remoteAccount(ctx, packet, data.Sender)
+	if err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	resp, err := msgServer{Keeper: k}.CreateTask(ctx, types.NewMsgCreateTask(account, data.Title, data.Description, data.Bounty))
+	if err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	transition, err := k.latestTransition(ctx, resp.Id)
+	if err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	return types.TaskPacketAck{
+		TaskId:    resp.Id,
+		OldStatus: types.TASK_STATUS_UNDEFINED,
+		NewStatus: transition.To,
+		Account:   account,
+		Seq:       transition.Seq,
+	}, nil
+}
+
+// OnRecvClaimTaskPacket claims the task of a received claim-task packet for
+// the remote account of the sender.
+func (k Keeper) OnRecvClaimTaskPacket(ctx context.Context, packet channeltypes.Packet, data types.ClaimTaskPacketData) (types.TaskPacketAck, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.TaskPacketAck{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	account, err := k.remoteAccount(ctx, packet, data.Sender)
+	if err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	task, err := k.Task.Get(ctx, data.TaskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TaskPacketAck{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", data.TaskId))
+		}
+		return types.TaskPacketAck{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if _, err := (msgServer{Keeper: k}).ClaimTask(ctx, types.NewMsgClaimTask(account, data.TaskId)); err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	transition, err := k.latestTransition(ctx, data.TaskId)
+	if err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	return types.TaskPacketAck{
+		TaskId:    data.TaskId,
+		OldStatus: task.Status,
+		NewStatus: transition.To,
+		Account:   account,
+		Seq:       transition.Seq,
+	}, nil
+}
+
+// OnRecvTaskStatusPacket records the status change of a task of the other
+// chain created or claimed from this chain.
+func (k Keeper) OnRecvTaskStatusPacket(ctx context.Context, packet channeltypes.Packet, data types.TaskStatusPacketData) (types.TaskPacketAck, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.TaskPacketAck{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	transition := data.Transition
+	if err := k.updateRemoteTask(ctx, packet.DestinationChannel, transition.TaskId, transition.To, transition.Seq, func(*types.RemoteTask) {}); err != nil {
+		return types.TaskPacketAck{}, err
+	}
+
+	return types.TaskPacketAck{
+		TaskId:    transition.TaskId,
+		OldStatus: transition.From,
+		NewStatus: transition.To,
+		Seq:       transition.Seq,
+	}, nil
+}
+
+// updateRemoteTask records status as the status of a remote task unless a
+// later status change was already recorded, then applies update to it.
+func (k Keeper) updateRemoteTask(ctx context.Context, channelID string, id uint64, status types.TaskStatus, seq uint64, update func(*types.RemoteTask)) error {
+	key := collections.Join(channelID, id)
+	remoteTask, err := k.RemoteTask.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		remoteTask = types.RemoteTask{ChannelId: channelID, TaskId: id, Status: status, Seq: seq}
+	case err != nil:
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get remote task")
+	case seq >= remoteTask.Seq:
+		remoteTask.Status = status
+		remoteTask.Seq = seq
+	}
+
+	update(&remoteTask)
+	if err := k.RemoteTask.Set(ctx, key, remoteTask); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set remote task")
+	}
+
+	return nil
+}
+
+// reportTransition sends a status packet for a transition of a task to the
+// chain of every remote sender that created, claimed or acted on the task.
+// A packet that cannot be sent, e.g. because the client of its channel
+// expired, is only logged so that it does not block the transition.
+func (k Keeper) reportTransition(ctx context.Context, transition types.TaskTransition) error {
+	if k.ics4WrapperFn == nil {
+		return nil
+	}
+
+	accounts := []string{transition.Actor}
+	task, err := k.Task.Get(ctx, transition.TaskId)
+	if errors.Is(err, collections.ErrNotFound) {
+		task, err = k.ArchivedTask.Get(ctx, transition.TaskId)
+	}
+	switch {
+	case err == nil:
+		accounts = append(accounts, task.Creator, task.Claimant)
+	case !errors.Is(err, collections.ErrNotFound):
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout, err := k.packetTimeout(ctx, 0)
+	if err != nil {
+		return err
+	}
+	data := types.TaskPacketData{
+		Packet: &types.TaskPacketData_TaskStatusPacket{TaskStatusPacket: &types.TaskStatusPacketData{Transition: transition}},
+	}
+
+	// remote tasks are kept per channel, so every channel is sent one packet
+	reported := make(map[string]bool)
+	for _, account := range accounts {
+		if account == "" {
+			continue
+		}
+		sender, err := k.RemoteSender.Get(ctx, account)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get remote sender")
+		}
+		if reported[sender.ChannelId] {
+			continue
+		}
+		reported[sender.ChannelId] = true
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if _, err := k.transmitTaskPacket(cacheCtx, sender.ChannelId, timeout, data); err != nil {
+			sdkCtx.Logger().Error("failed to report task status", "task_id", transition.TaskId, "channel_id", sender.ChannelId, "error", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// OnAcknowledgementTaskPacket records the status change carried by the
+// acknowledgement of a task packet sent from this chain.
+func (k Keeper) OnAcknowledgementTaskPacket(ctx context.Context, packet channeltypes.Packet, data types.TaskPacketData, ack channeltypes.Acknowledgement) error {
+	event := types.EventTaskPacketAcknowledged{
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Sender:    data.Sender(),
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		// nothing was escrowed on this chain, the failure is only reported
+		event.Error = resp.Error
+		return emitEvent(ctx, &event)
+
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.TaskPacketAck
+		if err := k.cdc.UnmarshalJSON(resp.Result, &packetAck); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, "cannot unmarshal task packet acknowledgement")
+		}
+
+		switch data.Packet.(type) {
+		case *types.TaskPacketData_CreateTaskPacket, *types.TaskPacketData_ClaimTaskPacket:
+			if err := k.updateRemoteTask(ctx, packet.SourceChannel, packetAck.TaskId, packetAck.NewStatus, packetAck.Seq, func(remoteTask *types.RemoteTask) {
+				switch data.Packet.(type) {
+				case *types.TaskPacketData_CreateTaskPacket:
+					remoteTask.Creator = event.Sender
+					remoteTask.CreatorAccount = packetAck.Account
+				case *types.TaskPacketData_ClaimTaskPacket:
+					remoteTask.Claimant = event.Sender
+					remoteTask.ClaimantAccount = packetAck.Account
+				}
+			}); err != nil {
+				return err
+			}
+		}
+
+		event.TaskId = packetAck.TaskId
+		event.OldStatus = packetAck.OldStatus
+		event.NewStatus = packetAck.NewStatus
+		return emitEvent(ctx, &event)
+
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidType, "unknown acknowledgement type")
+	}
+}
+
+// OnTimeoutTaskPacket reports a task packet sent from this chain that was
+// never received.
+func (k Keeper) OnTimeoutTaskPacket(ctx context.Context, packet channeltypes.Packet, data types.TaskPacketData) error {
+	return emitEvent(ctx, &types.EventTaskPacketTimeout{
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Sender:    data.Sender(),
+	})
+}
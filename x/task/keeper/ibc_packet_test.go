@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	module "taskbounty/x/task/module"
+	"taskbounty/x/task/types"
+)
+
+// remoteSender and remoteClaimant are addresses of senders on the other chain
+// of a task channel, which uses another prefix.
+var (
+	remoteSender   = sdk.MustBech32ifyAddressBytes("osmo", []byte("remoteSender________________"))
+	remoteClaimant = sdk.MustBech32ifyAddressBytes("osmo", []byte("remoteClaimant______________"))
+)
+
+// newTestPacket returns a task packet received on channel-1 of this chain.
+func newTestPacket(t *testing.T, data types.TaskPacketData) channeltypes.Packet {
+	t.Helper()
+
+	bz, err := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec.MarshalJSON(&data)
+	require.NoError(t, err)
+	return channeltypes.NewPacket(bz, 1, types.PortID, "channel-0", types.PortID, "channel-1", clienttypes.ZeroHeight(), 0)
+}
+
+func TestTaskMsgServerSendPackets(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	blockTime := uint64(sdk.UnwrapSDKContext(f.ctx).BlockTime().UnixNano())
+
+	_, err := srv.SendCreateTask(f.ctx, types.NewMsgSendCreateTask("invalid", "channel-0", 0, "Title", "Description", testBounty))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.SendCreateTask(f.ctx, types.NewMsgSendCreateTask(actors.creator, "channel-0", 0, "Title", "Description", sdk.NewCoins()))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SendClaimTask(f.ctx, types.NewMsgSendClaimTask(actors.claimant, "channel-0", blockTime, 0))
+	require.ErrorIs(t, err, types.ErrInvalidPacketTimeout)
+	require.Empty(t, f.ics4Wrapper.packets)
+
+	// the timeout defaults to DefaultPacketTimeout from the block time
+	resp, err := srv.SendCreateTask(f.ctx, types.NewMsgSendCreateTask(actors.creator, "channel-0", 0, "Title", "Description", testBounty))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Sequence)
+	claimResp, err := srv.SendClaimTask(f.ctx, types.NewMsgSendClaimTask(actors.claimant, "channel-0", blockTime+1, 7))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), claimResp.Sequence)
+
+	require.Len(t, f.ics4Wrapper.packets, 2)
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	for i, expected := range []struct {
+		timeout uint64
+		data    types.TaskPacketData
+	}{
+		{
+			timeout: blockTime + uint64(types.DefaultPacketTimeout.Nanoseconds()),
+			data: types.TaskPacketData{Packet: &types.TaskPacketData_CreateTaskPacket{CreateTaskPacket: &types.CreateTaskPacketData{
+				Sender:      actors.creator,
+				Title:       "Title",
+				Description: "Description",
+				Bounty:      testBounty,
+			}}},
+		},
+		{
+			timeout: blockTime + 1,
+			data: types.TaskPacketData{Packet: &types.TaskPacketData_ClaimTaskPacket{ClaimTaskPacket: &types.ClaimTaskPacketData{
+				Sender: actors.claimant,
+				TaskId: 7,
+			}}},
+		},
+	} {
+		packet := f.ics4Wrapper.packets[i]
+		require.Equal(t, types.PortID, packet.SourcePort)
+		require.Equal(t, "channel-0", packet.SourceChannel)
+		require.Equal(t, expected.timeout, packet.TimeoutTimestamp)
+
+		var data types.TaskPacketData
+		require.NoError(t, cdc.UnmarshalJSON(packet.Data, &data))
+		require.Equal(t, expected.data, data)
+	}
+}
+
+func TestTaskOnRecvPackets(t *testing.T) {
+	f := initFixture(t)
+	actors := newTaskActors(t, f)
+
+	createData := types.CreateTaskPacketData{Sender: remoteSender, Title: "Title", Description: "Description", Bounty: testBounty}
+	packet := newTestPacket(t, types.TaskPacketData{Packet: &types.TaskPacketData_CreateTaskPacket{CreateTaskPacket: &createData}})
+
+	// the remote account of the sender holds the bounty
+	account := types.RemoteAccount("channel-1", remoteSender)
+	accountAddr, err := f.addressCodec.BytesToString(account)
+	require.NoError(t, err)
+	require.NotEqual(t, account, types.RemoteAccount("channel-2", remoteSender))
+
+	// like IBC core, discard the state of a failed packet
+	cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+	_, err = f.keeper.OnRecvCreateTaskPacket(cacheCtx, packet, createData)
+	require.Error(t, err)
+
+	f.bankKeeper.fund(account, testBounty)
+	ack, err := f.keeper.OnRecvCreateTaskPacket(f.ctx, packet, createData)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskPacketAck{
+		TaskId:    0,
+		OldStatus: types.TASK_STATUS_UNDEFINED,
+		NewStatus: types.TASK_STATUS_OPEN,
+		Account:   accountAddr,
+	}, ack)
+
+	task, err := f.keeper.Task.Get(f.ctx, ack.TaskId)
+	require.NoError(t, err)
+	require.Equal(t, accountAddr, task.Creator)
+	require.Equal(t, testBounty, task.Bounty)
+	require.True(t, f.bankKeeper.balance(account).IsZero())
+
+	// a remote claimant claims through its own remote account
+	claimData := types.ClaimTaskPacketData{Sender: remoteClaimant, TaskId: ack.TaskId}
+	claimAccount, err := f.addressCodec.BytesToString(types.RemoteAccount("channel-1", claimData.Sender))
+	require.NoError(t, err)
+
+	_, err = f.keeper.OnRecvClaimTaskPacket(f.ctx, packet, types.ClaimTaskPacketData{Sender: claimData.Sender, TaskId: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	ack, err = f.keeper.OnRecvClaimTaskPacket(f.ctx, packet, claimData)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskPacketAck{
+		TaskId:    claimData.TaskId,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLAIMED,
+		Account:   claimAccount,
+		Seq:       1,
+	}, ack)
+
+	task, err = f.keeper.Task.Get(f.ctx, ack.TaskId)
+	require.NoError(t, err)
+	require.Equal(t, claimAccount, task.Claimant)
+
+	// a task can only be claimed once, local claimants included
+	_, err = f.keeper.OnRecvClaimTaskPacket(f.ctx, packet, types.ClaimTaskPacketData{Sender: actors.claimant, TaskId: ack.TaskId})
+	require.Error(t, err)
+}
+
+func TestTaskReportTransition(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+
+	statusPackets := func() []types.TaskTransition {
+		var transitions []types.TaskTransition
+		for _, packet := range f.ics4Wrapper.packets {
+			var data types.TaskPacketData
+			require.NoError(t, cdc.UnmarshalJSON(packet.Data, &data))
+			require.Equal(t, "channel-1", packet.SourceChannel)
+			transitions = append(transitions, data.GetTaskStatusPacket().Transition)
+		}
+		return transitions
+	}
+
+	// tasks without remote senders are not reported
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	_, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	require.Empty(t, f.ics4Wrapper.packets)
+
+	// status changes of a task created from the other chain are reported
+	// there, whoever causes them
+	f.bankKeeper.fund(types.RemoteAccount("channel-1", remoteSender), testBounty)
+	packet := newTestPacket(t, types.TaskPacketData{Packet: &types.TaskPacketData_NoData{NoData: &types.NoData{}}})
+	ack, err := f.keeper.OnRecvCreateTaskPacket(f.ctx, packet, types.CreateTaskPacketData{Sender: remoteSender, Title: "Title", Description: "Description", Bounty: testBounty})
+	require.NoError(t, err)
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, ack.TaskId))
+	require.NoError(t, err)
+
+	transitions := statusPackets()
+	require.Len(t, transitions, 2)
+	require.Equal(t, types.TASK_STATUS_OPEN, transitions[0].To)
+	require.Equal(t, uint64(0), transitions[0].Seq)
+	require.Equal(t, types.TASK_STATUS_CLAIMED, transitions[1].To)
+	require.Equal(t, uint64(1), transitions[1].Seq)
+	require.Equal(t, actors.claimant, transitions[1].Actor)
+}
+
+func TestTaskReportClaimExpired(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+
+	// a local task claimed from the other chain
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	packet := newTestPacket(t, types.TaskPacketData{Packet: &types.TaskPacketData_NoData{NoData: &types.NoData{}}})
+	_, err = f.keeper.OnRecvClaimTaskPacket(f.ctx, packet, types.ClaimTaskPacketData{Sender: remoteClaimant, TaskId: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, f.ics4Wrapper.packets, 1)
+
+	// the chain takes the task back from the claimant, who is told so
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(afterSeconds(f, params.ClaimDeadline+1)))
+
+	require.Len(t, f.ics4Wrapper.packets, 2)
+	sent := f.ics4Wrapper.packets[1]
+	require.Equal(t, "channel-1", sent.SourceChannel)
+	var data types.TaskPacketData
+	require.NoError(t, cdc.UnmarshalJSON(sent.Data, &data))
+	transition := data.GetTaskStatusPacket().Transition
+	require.Equal(t, resp.Id, transition.TaskId)
+	require.Equal(t, types.TASK_STATUS_CLAIMED, transition.From)
+	require.Equal(t, types.TASK_STATUS_OPEN, transition.To)
+	require.Empty(t, transition.Actor)
+}
+
+func TestTaskOnRecvTaskStatusPacket(t *testing.T) {
+	f := initFixture(t)
+	packet := newTestPacket(t, types.TaskPacketData{Packet: &types.TaskPacketData_NoData{NoData: &types.NoData{}}})
+
+	statusData := func(seq uint64, from, to types.TaskStatus) types.TaskStatusPacketData {
+		return types.TaskStatusPacketData{Transition: types.TaskTransition{TaskId: 4, Seq: seq, From: from, To: to}}
+	}
+
+	// status packets are recorded on the channel they are received on
+	ack, err := f.keeper.OnRecvTaskStatusPacket(f.ctx, packet, statusData(3, types.TASK_STATUS_SUBMITTED, types.TASK_STATUS_APPROVED))
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, ack.NewStatus)
+
+	// a status change relayed late does not roll the task back
+	_, err = f.keeper.OnRecvTaskStatusPacket(f.ctx, packet, statusData(1, types.TASK_STATUS_OPEN, types.TASK_STATUS_CLAIMED))
+	require.NoError(t, err)
+
+	remoteTask, err := f.keeper.RemoteTask.Get(f.ctx, collections.Join("channel-1", uint64(4)))
+	require.NoError(t, err)
+	require.Equal(t, types.RemoteTask{ChannelId: "channel-1", TaskId: 4, Status: types.TASK_STATUS_APPROVED, Seq: 3}, remoteTask)
+
+	// malformed transitions are rejected with an error acknowledgement and
+	// leave the remote task untouched
+	im := module.NewIBCModule(moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec, f.keeper)
+	tests := []struct {
+		desc string
+		data types.TaskStatusPacketData
+	}{
+		{desc: "unknown status", data: statusData(4, types.TASK_STATUS_APPROVED, types.TaskStatus(42))},
+		{desc: "unchanged status", data: statusData(4, types.TASK_STATUS_APPROVED, types.TASK_STATUS_APPROVED)},
+		{desc: "created after the first transition", data: statusData(4, types.TASK_STATUS_UNDEFINED, types.TASK_STATUS_OPEN)},
+		{desc: "first transition not creating the task", data: statusData(0, types.TASK_STATUS_OPEN, types.TASK_STATUS_CLAIMED)},
+		{desc: "invalid actor", data: types.TaskStatusPacketData{Transition: types.TaskTransition{
+			TaskId: 4, Seq: 4, From: types.TASK_STATUS_APPROVED, To: types.TASK_STATUS_CLOSED, Actor: "osmo1stranger",
+		}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := f.keeper.OnRecvTaskStatusPacket(f.ctx, packet, tc.data)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+			data := tc.data
+			ack := im.OnRecvPacket(sdk.UnwrapSDKContext(f.ctx), types.Version, newTestPacket(t, types.TaskPacketData{
+				Packet: &types.TaskPacketData_TaskStatusPacket{TaskStatusPacket: &data},
+			}), nil)
+			require.False(t, ack.Success())
+
+			got, err := f.keeper.RemoteTask.Get(f.ctx, collections.Join("channel-1", uint64(4)))
+			require.NoError(t, err)
+			require.Equal(t, remoteTask, got)
+		})
+	}
+}
+
+func TestTaskOnAcknowledgementPacket(t *testing.T) {
+	f := initFixture(t)
+	actors := newTaskActors(t, f)
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+
+	createData := types.TaskPacketData{Packet: &types.TaskPacketData_CreateTaskPacket{CreateTaskPacket: &types.CreateTaskPacketData{
+		Sender: actors.creator, Title: "Title", Description: "Description", Bounty: testBounty,
+	}}}
+	claimData := types.TaskPacketData{Packet: &types.TaskPacketData_ClaimTaskPacket{ClaimTaskPacket: &types.ClaimTaskPacketData{
+		Sender: actors.claimant, TaskId: 3,
+	}}}
+	// packets sent from channel-1 of this chain
+	createPacket := channeltypes.NewPacket(nil, 1, types.PortID, "channel-1", types.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+	claimPacket := channeltypes.NewPacket(nil, 2, types.PortID, "channel-1", types.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+
+	resultAck := func(ack types.TaskPacketAck) channeltypes.Acknowledgement {
+		bz, err := cdc.MarshalJSON(&ack)
+		require.NoError(t, err)
+		return channeltypes.NewResultAcknowledgement(bz)
+	}
+
+	// a failed packet is only reported
+	errorAck := channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds)
+	require.NoError(t, f.keeper.OnAcknowledgementTaskPacket(f.ctx, createPacket, createData, errorAck))
+	requireEvent(t, f.ctx, &types.EventTaskPacketAcknowledged{
+		ChannelId: "channel-1",
+		Sequence:  1,
+		Sender:    actors.creator,
+		Error:     errorAck.GetError(),
+	})
+	_, err := f.keeper.RemoteTask.Get(f.ctx, collections.Join("channel-1", uint64(3)))
+	require.Error(t, err)
+
+	require.NoError(t, f.keeper.OnAcknowledgementTaskPacket(f.ctx, createPacket, createData, resultAck(types.TaskPacketAck{
+		TaskId: 3, OldStatus: types.TASK_STATUS_UNDEFINED, NewStatus: types.TASK_STATUS_OPEN, Account: "remote1creator",
+	})))
+	require.NoError(t, f.keeper.OnAcknowledgementTaskPacket(f.ctx, claimPacket, claimData, resultAck(types.TaskPacketAck{
+		TaskId: 3, OldStatus: types.TASK_STATUS_OPEN, NewStatus: types.TASK_STATUS_CLAIMED, Account: "remote1claimant",
+	})))
+	requireEvent(t, f.ctx, &types.EventTaskPacketAcknowledged{
+		ChannelId: "channel-1",
+		Sequence:  2,
+		Sender:    actors.claimant,
+		TaskId:    3,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLAIMED,
+	})
+
+	remoteTask, err := f.keeper.RemoteTask.Get(f.ctx, collections.Join("channel-1", uint64(3)))
+	require.NoError(t, err)
+	require.Equal(t, types.RemoteTask{
+		ChannelId:       "channel-1",
+		TaskId:          3,
+		Status:          types.TASK_STATUS_CLAIMED,
+		Creator:         actors.creator,
+		CreatorAccount:  "remote1creator",
+		Claimant:        actors.claimant,
+		ClaimantAccount: "remote1claimant",
+	}, remoteTask)
+
+	require.NoError(t, f.keeper.OnTimeoutTaskPacket(f.ctx, claimPacket, claimData))
+	requireEvent(t, f.ctx, &types.EventTaskPacketTimeout{
+		ChannelId: "channel-1",
+		Sequence:  2,
+		Sender:    actors.claimant,
+	})
+}
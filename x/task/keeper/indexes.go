@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"taskbounty/x/task/types"
+)
+
+// TaskIndexes are the secondary indexes of Task. Addresses that are not set
+// yet, such as the claimant of an open task, are indexed under "".
+type TaskIndexes struct {
+	Creator  *indexes.Multi[string, uint64, types.Task]
+	Claimant *indexes.Multi[string, uint64, types.Task]
+	// Approver indexes tasks under every address approving them
+	Approver *ApproverIndex
+	Status   *indexes.Multi[int32, uint64, types.Task]
+	// Bounty orders tasks by bounty amount within each denom
+	Bounty    *BountyIndex
+	CreatedAt *indexes.Multi[int64, uint64, types.Task]
+}
+
+func (i TaskIndexes) IndexesList() []collections.Index[uint64, types.Task] {
+	return []collections.Index[uint64, types.Task]{
+		i.Creator, i.Claimant, i.Approver, i.Status, i.Bounty, i.CreatedAt,
+	}
+}
+
+func newTaskIndexes(sb *collections.SchemaBuilder) TaskIndexes {
+	return TaskIndexes{
+		Creator: indexes.NewMulti(
+			sb, types.TaskByCreatorKey, "task_by_creator",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, task types.Task) (string, error) { return task.Creator, nil },
+		),
+		Claimant: indexes.NewMulti(
+			sb, types.TaskByClaimantKey, "task_by_claimant",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, task types.Task) (string, error) { return task.Claimant, nil },
+		),
+		Approver: newApproverIndex(sb, types.TaskByApproverKey, "task_by_approver"),
+		Status: indexes.NewMulti(
+			sb, types.TaskByStatusKey, "task_by_status",
+			collections.Int32Key, collections.Uint64Key,
+			func(_ uint64, task types.Task) (int32, error) { return int32(task.Status), nil },
+		),
+		Bounty: newBountyIndex(sb, types.TaskByBountyKey, "task_by_bounty"),
+		CreatedAt: indexes.NewMulti(
+			sb, types.TaskByCreatedAtKey, "task_by_created_at",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, task types.Task) (int64, error) { return task.CreatedAt, nil },
+		),
+	}
+}
+
+// ApproverIndex indexes a task under the approvers nominated in its Approvers
+// and the Approver that approved it, so that tasks waiting on an approver are
+// listed for it as well as the tasks it approved. A task without any is
+// indexed under "".
+type ApproverIndex struct {
+	refKeys collections.KeySet[collections.Pair[string, uint64]]
+}
+
+func newApproverIndex(sb *collections.SchemaBuilder, prefix collections.Prefix, name string) *ApproverIndex {
+	return &ApproverIndex{
+		refKeys: collections.NewKeySet(
+			sb, prefix, name,
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+	}
+}
+
+func (i *ApproverIndex) Reference(ctx context.Context, pk uint64, newValue types.Task, lazyOldValue func() (types.Task, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	for _, approver := range taskApprovers(newValue) {
+		if err := i.refKeys.Set(ctx, collections.Join(approver, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *ApproverIndex) Unreference(ctx context.Context, pk uint64, lazyOldValue func() (types.Task, error)) error {
+	oldValue, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, oldValue)
+}
+
+func (i *ApproverIndex) unreference(ctx context.Context, pk uint64, task types.Task) error {
+	for _, approver := range taskApprovers(task) {
+		if err := i.refKeys.Remove(ctx, collections.Join(approver, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchExact returns an iterator over the ids of the tasks indexed under
+// approver.
+func (i *ApproverIndex) MatchExact(ctx context.Context, approver string) (indexes.MultiIterator[string, uint64], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](approver))
+}
+
+// Iterate iterates the index over ranger.
+func (i *ApproverIndex) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[string, uint64]]) (indexes.MultiIterator[string, uint64], error) {
+	iter, err := i.refKeys.Iterate(ctx, ranger)
+	return indexes.MultiIterator[string, uint64](iter), err
+}
+
+// KeyCodec returns the codec of the index keys.
+func (i *ApproverIndex) KeyCodec() collcodec.KeyCodec[collections.Pair[string, uint64]] {
+	return i.refKeys.KeyCodec()
+}
+
+// taskApprovers returns the addresses a task is indexed under by the
+// ApproverIndex, each once.
+func taskApprovers(task types.Task) []string {
+	approvers := task.Approvers
+	if task.Approver != "" && !task.IsApprover(task.Approver) {
+		approvers = append(approvers[:len(approvers):len(approvers)], task.Approver)
+	}
+	if len(approvers) == 0 {
+		return []string{""}
+	}
+	return approvers
+}
+
+// BountyIndex orders tasks by the amount of their bounty in each of its
+// denoms. A task is indexed once per coin of its bounty, under the denom and
+// the types.BountyIndexKey of the amount, as amounts of different denoms
+// cannot be compared.
+type BountyIndex struct {
+	refKeys collections.KeySet[collections.Triple[string, []byte, uint64]]
+}
+
+func newBountyIndex(sb *collections.SchemaBuilder, prefix collections.Prefix, name string) *BountyIndex {
+	return &BountyIndex{
+		refKeys: collections.NewKeySet(
+			sb, prefix, name,
+			collections.TripleKeyCodec(collections.StringKey, collections.BytesKey, collections.Uint64Key),
+		),
+	}
+}
+
+func (i *BountyIndex) Reference(ctx context.Context, pk uint64, newValue types.Task, lazyOldValue func() (types.Task, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	for _, coin := range newValue.Bounty {
+		if err := i.refKeys.Set(ctx, collections.Join3(coin.Denom, types.BountyIndexKey(coin.Amount), pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *BountyIndex) Unreference(ctx context.Context, pk uint64, lazyOldValue func() (types.Task, error)) error {
+	oldValue, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, oldValue)
+}
+
+func (i *BountyIndex) unreference(ctx context.Context, pk uint64, task types.Task) error {
+	for _, coin := range task.Bounty {
+		if err := i.refKeys.Remove(ctx, collections.Join3(coin.Denom, types.BountyIndexKey(coin.Amount), pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchExact returns the ids of the tasks with amount of denom in their
+// bounty, in id order.
+func (i *BountyIndex) MatchExact(ctx context.Context, denom string, amount math.Int) ([]uint64, error) {
+	iter, err := i.refKeys.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, []byte, uint64](denom, types.BountyIndexKey(amount)))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(keys))
+	for j, key := range keys {
+		ids[j] = key.K3()
+	}
+	return ids, nil
+}
+
+// Iterate iterates the index over ranger.
+func (i *BountyIndex) Iterate(ctx context.Context, ranger collections.Ranger[collections.Triple[string, []byte, uint64]]) (BountyIterator, error) {
+	iter, err := i.refKeys.Iterate(ctx, ranger)
+	return BountyIterator{iter}, err
+}
+
+// KeyCodec returns the codec of the index keys.
+func (i *BountyIndex) KeyCodec() collcodec.KeyCodec[collections.Triple[string, []byte, uint64]] {
+	return i.refKeys.KeyCodec()
+}
+
+// BountyIterator iterates the keys of a BountyIndex.
+type BountyIterator struct {
+	collections.KeySetIterator[collections.Triple[string, []byte, uint64]]
+}
+
+// FullKey returns the current index key, ending with the task id.
+func (it BountyIterator) FullKey() (collections.Triple[string, []byte, uint64], error) {
+	return it.Key()
+}
+
+// TaskRewardIndexes are the secondary indexes of TaskReward.
+type TaskRewardIndexes struct {
+	Claimant *indexes.Multi[string, uint64, types.TaskReward]
+}
+
+func (i TaskRewardIndexes) IndexesList() []collections.Index[uint64, types.TaskReward] {
+	return []collections.Index[uint64, types.TaskReward]{i.Claimant}
+}
+
+func newTaskRewardIndexes(sb *collections.SchemaBuilder) TaskRewardIndexes {
+	return TaskRewardIndexes{
+		Claimant: indexes.NewMulti(
+			sb, types.TaskRewardByClaimantKey, "task_reward_by_claimant",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, reward types.TaskReward) (string, error) { return reward.Claimant, nil },
+		),
+	}
+}
+
+// multiIndex is an index of records keyed by a reference and the record id,
+// such as an indexes.Multi.
+type multiIndex[R any] interface {
+	Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[R, uint64]]) (indexes.MultiIterator[R, uint64], error)
+	KeyCodec() collcodec.KeyCodec[collections.Pair[R, uint64]]
+}
+
+// paginateIndex pages through the task ids indexed under ref, in id order.
+// The page key is the big endian encoding of the next task id.
+func paginateIndex[R any](
+	ctx context.Context,
+	index multiIndex[R],
+	ref R,
+	pageReq *query.PageRequest,
+) ([]uint64, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if len(pageReq.Key) != 0 && len(pageReq.Key) != 8 {
+		return nil, nil, fmt.Errorf("invalid pagination key")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero and no key is set
+		pageReq.CountTotal = pageReq.CountTotal || len(pageReq.Key) == 0
+	}
+
+	ranger := collections.NewPrefixedPairRange[R, uint64](ref)
+	if len(pageReq.Key) != 0 {
+		if pageReq.Reverse {
+			ranger = ranger.EndInclusive(sdk.BigEndianToUint64(pageReq.Key))
+		} else {
+			ranger = ranger.StartInclusive(sdk.BigEndianToUint64(pageReq.Key))
+		}
+	}
+	if pageReq.Reverse {
+		ranger = ranger.Descending()
+	}
+
+	iter, err := index.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		ids     []uint64
+		count   uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		id, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		count++
+
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(ids)) < limit:
+			ids = append(ids, id)
+		case nextKey == nil:
+			nextKey = sdk.Uint64ToBigEndian(id)
+			if !pageReq.CountTotal {
+				return ids, &query.PageResponse{NextKey: nextKey}, nil
+			}
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+
+	return ids, pageRes, nil
+}
@@ -19,11 +19,46 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
-	Schema  collections.Schema
-	Params  collections.Item[types.Params]
-	TaskSeq collections.Sequence
-	Task    collections.Map[uint64, types.Task]
-	TaskReward collections.Map[uint64, types.TaskReward]
+	bankKeeper     types.BankKeeper
+	feeGrantKeeper types.FeeGrantKeeper
+	nftKeeper      types.NFTKeeper
+	// ics4WrapperFn returns the IBC channel keeper, which is created after the
+	// app is built
+	ics4WrapperFn func() types.ICS4Wrapper
+	// transferKeeperFn returns the IBC transfer keeper, which is created after
+	// the app is built
+	transferKeeperFn func() types.TransferKeeper
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	TaskSeq    collections.Sequence
+	Task       *collections.IndexedMap[uint64, types.Task, TaskIndexes]
+	TaskReward *collections.IndexedMap[uint64, types.TaskReward, TaskRewardIndexes]
+	TaskRefund collections.Map[uint64, types.TaskRefund]
+	// TaskReview holds the reviews of the current submission of each task, keyed by (task id, reviewer)
+	TaskReview collections.Map[collections.Pair[uint64, string], types.TaskReview]
+	// TaskDispute holds the dispute history of each task, keyed by (task id, seq)
+	TaskDispute collections.Map[collections.Pair[uint64, uint64], types.TaskDispute]
+	// Submission holds every proof submitted for a task, keyed by (task id, attempt)
+	Submission collections.Map[collections.Pair[uint64, uint64], types.Submission]
+	// TaskHistory is the append-only status history of each task, keyed by (task id, seq).
+	// It outlives deleted tasks.
+	TaskHistory collections.Map[collections.Pair[uint64, uint64], types.TaskTransition]
+	// ArchivedTask holds closed tasks moved out of Task when the
+	// ArchiveClosedTasks param is set, keyed by task id.
+	ArchivedTask collections.Map[uint64, types.Task]
+	// RemoteTask holds the tasks of other chains created or claimed over task
+	// channels, keyed by (channel id, task id) as last acknowledged.
+	RemoteTask collections.Map[collections.Pair[string, uint64], types.RemoteTask]
+	// RemoteSender holds the sender of the other chain each remote account of
+	// this chain acts for, keyed by remote account address.
+	RemoteSender collections.Map[string, types.RemoteSender]
+	// DeadlineQueue holds the upcoming deadline of every task, ordered by time,
+	// and is drained by the EndBlocker.
+	DeadlineQueue collections.KeySet[collections.Triple[int64, uint64, int32]]
+	// DeadlineRetry counts how often the queued deadline of a task failed to
+	// apply, keyed by (task id, deadline kind), until it applies or is dropped.
+	DeadlineRetry collections.Map[collections.Pair[uint64, int32], uint32]
 }
 
 func NewKeeper(
@@ -31,7 +66,11 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	feeGrantKeeper types.FeeGrantKeeper,
+	nftKeeper types.NFTKeeper,
+	ics4WrapperFn func() types.ICS4Wrapper,
+	transferKeeperFn func() types.TransferKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -40,15 +79,85 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		storeService: storeService,
-		cdc:          cdc,
-		addressCodec: addressCodec,
-		authority:    authority,
+		storeService:     storeService,
+		cdc:              cdc,
+		addressCodec:     addressCodec,
+		authority:        authority,
+		bankKeeper:       bankKeeper,
+		feeGrantKeeper:   feeGrantKeeper,
+		nftKeeper:        nftKeeper,
+		ics4WrapperFn:    ics4WrapperFn,
+		transferKeeperFn: transferKeeperFn,
 
-		Params:  collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Task:    collections.NewMap(sb, types.TaskKey, "task", collections.Uint64Key, codec.CollValue[types.Task](cdc)),
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Task: collections.NewIndexedMap(
+			sb,
+			types.TaskKey,
+			"task",
+			collections.Uint64Key,
+			codec.CollValue[types.Task](cdc),
+			newTaskIndexes(sb),
+		),
 		TaskSeq: collections.NewSequence(sb, types.TaskCountKey, "taskSequence"),
-		TaskReward: collections.NewMap(sb, collections.NewPrefix(1), "task_reward", collections.Uint64Key, codec.CollValue[types.TaskReward](cdc)),
+		TaskReward: collections.NewIndexedMap(
+			sb,
+			collections.NewPrefix(1),
+			"task_reward",
+			collections.Uint64Key,
+			codec.CollValue[types.TaskReward](cdc),
+			newTaskRewardIndexes(sb),
+		),
+		TaskRefund: collections.NewMap(sb, types.TaskRefundKey, "task_refund", collections.Uint64Key, codec.CollValue[types.TaskRefund](cdc)),
+		TaskReview: collections.NewMap(
+			sb,
+			types.TaskReviewKey,
+			"task_review",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.TaskReview](cdc),
+		),
+		TaskDispute: collections.NewMap(
+			sb,
+			types.TaskDisputeKey,
+			"task_dispute",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.TaskDispute](cdc),
+		),
+		Submission: collections.NewMap(
+			sb,
+			types.SubmissionKey,
+			"submission",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.Submission](cdc),
+		),
+		TaskHistory: collections.NewMap(
+			sb,
+			types.TaskHistoryKey,
+			"task_history",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.TaskTransition](cdc),
+		),
+		ArchivedTask: collections.NewMap(sb, types.ArchivedTaskKey, "archived_task", collections.Uint64Key, codec.CollValue[types.Task](cdc)),
+		RemoteTask: collections.NewMap(
+			sb,
+			types.RemoteTaskKey,
+			"remote_task",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.RemoteTask](cdc),
+		),
+		RemoteSender: collections.NewMap(sb, types.RemoteSenderKey, "remote_sender", collections.StringKey, codec.CollValue[types.RemoteSender](cdc)),
+		DeadlineQueue: collections.NewKeySet(
+			sb,
+			types.DeadlineQueueKey,
+			"deadline_queue",
+			collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.Int32Key),
+		),
+		DeadlineRetry: collections.NewMap(
+			sb,
+			types.DeadlineRetryKey,
+			"deadline_retry",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Int32Key),
+			collections.Uint32Value,
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -3,8 +3,10 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -19,9 +21,15 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx            context.Context
+	storeService   corestore.KVStoreService
+	keeper         keeper.Keeper
+	addressCodec   address.Codec
+	bankKeeper     *mockBankKeeper
+	feeGrantKeeper *mockFeeGrantKeeper
+	nftKeeper      *mockNFTKeeper
+	ics4Wrapper    *mockICS4Wrapper
+	transferKeeper *mockTransferKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -32,15 +40,26 @@ func initFixture(t *testing.T) *fixture {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	storeService := runtime.NewKVStoreService(storeKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithBlockTime(time.Unix(1700000000, 0))
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	feeGrantKeeper := newMockFeeGrantKeeper()
+	nftKeeper := newMockNFTKeeper()
+	ics4Wrapper := newMockICS4Wrapper()
+	transferKeeper := newMockTransferKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		feeGrantKeeper,
+		nftKeeper,
+		func() types.ICS4Wrapper { return ics4Wrapper },
+		func() types.TransferKeeper { return transferKeeper },
 	)
 
 	// Initialize params
@@ -49,8 +68,14 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:            ctx,
+		storeService:   storeService,
+		keeper:         k,
+		addressCodec:   addressCodec,
+		bankKeeper:     bankKeeper,
+		feeGrantKeeper: feeGrantKeeper,
+		nftKeeper:      nftKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		transferKeeper: transferKeeper,
 	}
 }
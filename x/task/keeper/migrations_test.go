@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	v1 "taskbounty/x/task/migrations/v1"
+	"taskbounty/x/task/types"
+)
+
+func TestMigrate1to2PreEscrowTasks(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+	now := sdk.UnwrapSDKContext(f.ctx).BlockTime().Unix()
+
+	// version 1 tasks, whose bounties never reached the task module account
+	bounty := v1.NewCoin("stake", testBounty.AmountOf("stake").Int64())
+	v1Tasks := []v1.Task{
+		{Id: 0, Creator: actors.creator, Bounty: bounty, Status: int32(types.TASK_STATUS_OPEN), CreatedAt: now - int64(params.TaskExpiry), UpdatedAt: now},
+		{Id: 1, Creator: actors.creator, Bounty: bounty, Status: int32(types.TASK_STATUS_SUBMITTED), Claimant: actors.claimant, Proof: "bafy:ipfs:150", CreatedAt: now, UpdatedAt: now},
+	}
+	store := f.storeService.OpenKVStore(f.ctx)
+	for _, task := range v1Tasks {
+		require.NoError(t, store.Set(v1.TaskKey(task.Id), task.Marshal()))
+	}
+	require.NoError(t, f.keeper.TaskSeq.Set(f.ctx, uint64(len(v1Tasks))))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	// a task created after the upgrade escrows its bounty
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	_, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	// the bounty of a pre-escrow task cannot be topped up into the escrow
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	_, err = srv.UpdateTask(f.ctx, newTestMsgUpdateTask(actors.creator, 0, testBounty.Add(testBounty...)))
+	require.Error(t, err)
+
+	// approving a pre-escrow task records the reward without paying out the
+	// escrow of the other task
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, 1))
+	require.NoError(t, err)
+	reward, err := f.keeper.TaskReward.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, testBounty, reward.Amount)
+	require.True(t, f.bankKeeper.balance(actors.claimantAddr).IsZero())
+
+	// expiring a pre-escrow task records the refund without refunding it
+	ctx := afterSeconds(f, 1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err := f.keeper.Task.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	_, err = f.keeper.TaskRefund.Get(ctx, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
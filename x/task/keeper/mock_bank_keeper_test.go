@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockBankKeeper is an in-memory implementation of types.BankKeeper that
+// tracks balances per address, including module accounts.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) fund(addr sdk.AccAddress, amt sdk.Coins) {
+	m.balances[addr.String()] = m.balances[addr.String()].Add(amt...)
+}
+
+func (m *mockBankKeeper) balance(addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) moduleBalance(moduleName string) sdk.Coins {
+	return m.balance(authtypes.NewModuleAddress(moduleName))
+}
+
+func (m *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := m.balances[from.String()]
+	remaining, hasNeg := balance.SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", balance, amt)
+	}
+	m.balances[from.String()] = remaining
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balance(addr)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockFeeGrantKeeper is an in-memory implementation of types.FeeGrantKeeper
+// keyed by granter and grantee.
+type mockFeeGrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func newMockFeeGrantKeeper() *mockFeeGrantKeeper {
+	return &mockFeeGrantKeeper{allowances: make(map[string]feegrant.FeeAllowanceI)}
+}
+
+func grantKey(granter, grantee sdk.AccAddress) string {
+	return granter.String() + "/" + grantee.String()
+}
+
+func (m *mockFeeGrantKeeper) GetAllowance(_ context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, ok := m.allowances[grantKey(granter, grantee)]
+	if !ok {
+		return nil, fmt.Errorf("fee-grant not found")
+	}
+	return allowance, nil
+}
+
+func (m *mockFeeGrantKeeper) GrantAllowance(_ context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	key := grantKey(granter, grantee)
+	if _, ok := m.allowances[key]; ok {
+		return fmt.Errorf("fee allowance already exists")
+	}
+	m.allowances[key] = allowance
+	return nil
+}
+
+func (m *mockFeeGrantKeeper) UpdateAllowance(_ context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	key := grantKey(granter, grantee)
+	if _, ok := m.allowances[key]; !ok {
+		return fmt.Errorf("fee-grant not found")
+	}
+	m.allowances[key] = allowance
+	return nil
+}
+
+func (m *mockFeeGrantKeeper) RevokeAllowance(_ context.Context, granter, grantee sdk.AccAddress) error {
+	key := grantKey(granter, grantee)
+	if _, ok := m.allowances[key]; !ok {
+		return fmt.Errorf("fee-grant not found")
+	}
+	delete(m.allowances, key)
+	return nil
+}
@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// mockICS4Wrapper is an implementation of types.ICS4Wrapper that keeps the
+// sent packets instead of committing them.
+type mockICS4Wrapper struct {
+	packets []channeltypes.Packet
+}
+
+func newMockICS4Wrapper() *mockICS4Wrapper {
+	return &mockICS4Wrapper{}
+}
+
+func (m *mockICS4Wrapper) SendPacket(_ sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	sequence := uint64(len(m.packets) + 1)
+	m.packets = append(m.packets, channeltypes.NewPacket(data, sequence, sourcePort, sourceChannel, "task", "channel-1", timeoutHeight, timeoutTimestamp))
+	return sequence, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockNFTKeeper is an in-memory implementation of types.NFTKeeper that keeps
+// the classes and the owner of every minted token.
+type mockNFTKeeper struct {
+	classes map[string]nft.Class
+	tokens  map[string]nft.NFT
+	owners  map[string]sdk.AccAddress
+}
+
+func newMockNFTKeeper() *mockNFTKeeper {
+	return &mockNFTKeeper{
+		classes: make(map[string]nft.Class),
+		tokens:  make(map[string]nft.NFT),
+		owners:  make(map[string]sdk.AccAddress),
+	}
+}
+
+func tokenKey(classID, id string) string {
+	return classID + "/" + id
+}
+
+// token returns a minted token and its owner.
+func (m *mockNFTKeeper) token(classID, id string) (nft.NFT, sdk.AccAddress, bool) {
+	token, ok := m.tokens[tokenKey(classID, id)]
+	return token, m.owners[tokenKey(classID, id)], ok
+}
+
+func (m *mockNFTKeeper) HasClass(_ context.Context, classID string) bool {
+	_, ok := m.classes[classID]
+	return ok
+}
+
+func (m *mockNFTKeeper) SaveClass(_ context.Context, class nft.Class) error {
+	if _, ok := m.classes[class.Id]; ok {
+		return fmt.Errorf("class %s already exists", class.Id)
+	}
+	m.classes[class.Id] = class
+	return nil
+}
+
+func (m *mockNFTKeeper) Mint(_ context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if _, ok := m.classes[token.ClassId]; !ok {
+		return fmt.Errorf("class %s not found", token.ClassId)
+	}
+	key := tokenKey(token.ClassId, token.Id)
+	if _, ok := m.tokens[key]; ok {
+		return fmt.Errorf("nft %s already exists", token.Id)
+	}
+	m.tokens[key] = token
+	m.owners[key] = receiver
+	return nil
+}
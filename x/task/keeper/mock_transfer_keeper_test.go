@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// mockTransferKeeper is an implementation of types.TransferKeeper that knows
+// the denom traces of the vouchers it received.
+type mockTransferKeeper struct {
+	denoms map[string]ibctransfertypes.Denom
+}
+
+func newMockTransferKeeper() *mockTransferKeeper {
+	return &mockTransferKeeper{denoms: make(map[string]ibctransfertypes.Denom)}
+}
+
+// receive records the denom trace of a voucher, as receiving it over a
+// transfer channel does.
+func (m *mockTransferKeeper) receive(denom ibctransfertypes.Denom) string {
+	m.denoms[denom.IBCDenom()] = denom
+	return denom.IBCDenom()
+}
+
+func (m *mockTransferKeeper) GetDenomFromIBCDenom(_ sdk.Context, ibcDenom string) (ibctransfertypes.Denom, error) {
+	denom, ok := m.denoms[ibcDenom]
+	if !ok {
+		return ibctransfertypes.Denom{}, fmt.Errorf("denomination not found for %s", ibcDenom)
+	}
+	return denom, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetTaskApprovers lets the creator replace the nominated approvers of an
+// open task, delegating the review of its submissions.
+func (k msgServer) SetTaskApprovers(ctx context.Context, msg *types.MsgSetTaskApprovers) (*types.MsgSetTaskApproversResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanSetApprovers(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	oldApprovers := task.Approvers
+	task.Approvers = msg.Approvers
+	task.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if err := task.ValidateApprovers(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskApproversSet{
+		TaskId:       task.Id,
+		Creator:      msg.Creator,
+		OldApprovers: oldApprovers,
+		NewApprovers: task.Approvers,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTaskApproversResponse{}, nil
+}
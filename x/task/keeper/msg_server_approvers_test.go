@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+// newTestApprovers returns n distinct approver addresses.
+func newTestApprovers(t *testing.T, f *fixture, n int) []string {
+	t.Helper()
+
+	approvers := make([]string, n)
+	for i := range approvers {
+		addr := sdk.AccAddress([]byte("approverAddr_______________" + string(rune('a'+i))))
+		approver, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		approvers[i] = approver
+	}
+	return approvers
+}
+
+func TestTaskMsgServerCreateWithApprovers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	approvers := newTestApprovers(t, f, 2)
+	f.bankKeeper.fund(actors.creatorAddr, testBounty.Add(testBounty...))
+
+	for _, tc := range []struct {
+		desc      string
+		approver  string
+		approvers []string
+	}{
+		{desc: "invalid address", approvers: []string{"invalid"}},
+		{desc: "creator", approvers: []string{actors.creator}},
+		{desc: "duplicate", approvers: []string{approvers[0], approvers[0]}},
+		{desc: "duplicate of approver", approver: approvers[0], approvers: []string{approvers[0]}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := newTestMsgCreateTask(actors.creator)
+			msg.Approver = tc.approver
+			msg.Approvers = tc.approvers
+			_, err := srv.CreateTask(f.ctx, msg)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.Approvers = approvers
+	resp, err := srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+	task, err := f.keeper.Task.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, approvers, task.Approvers)
+	require.Empty(t, task.Approver)
+
+	// the single approver field nominates an approver as well
+	msg = newTestMsgCreateTask(actors.creator)
+	msg.Approver = approvers[1]
+	resp, err = srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+	task, err = f.keeper.Task.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{approvers[1]}, task.Approvers)
+	require.Empty(t, task.Approver)
+
+	// approvers cannot claim the task they approve
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(approvers[1], resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestTaskMsgServerApproveByApprover(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	approvers := newTestApprovers(t, f, 3)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.Approvers = approvers[:2]
+	resp, err := srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+	id := resp.Id
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, id, newTestProof(f)))
+	require.NoError(t, err)
+
+	// someone who was not nominated cannot review the submission
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(approvers[2], id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(approvers[2], id, "no"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.claimant, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// any nominated approver can reject or approve
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(approvers[1], id, "missing tests"))
+	require.NoError(t, err)
+	submission, err := f.keeper.Submission.Get(f.ctx, collections.Join(id, uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, approvers[1], submission.Reviewer)
+
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, id, newTestProof(f)))
+	require.NoError(t, err)
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(approvers[0], id))
+	require.NoError(t, err)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, task.Status)
+	require.Equal(t, approvers[0], task.Approver)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+
+	requireEvent(t, f.ctx, &types.EventTaskApproved{
+		TaskId:    id,
+		Approver:  approvers[0],
+		Claimant:  actors.claimant,
+		Amount:    testBounty,
+		OldStatus: types.TASK_STATUS_SUBMITTED,
+		NewStatus: types.TASK_STATUS_APPROVED,
+		Attempt:   2,
+	})
+}
+
+func TestTaskMsgServerSetTaskApprovers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	approvers := newTestApprovers(t, f, 2)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.Approvers = approvers[:1]
+	resp, err := srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+	id := resp.Id
+
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers("invalid", id, approvers[1:]))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers(actors.creator, 10, approvers[1:]))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers(approvers[0], id, approvers[1:]))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers(actors.creator, id, []string{actors.creator}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers(actors.creator, id, approvers[1:]))
+	require.NoError(t, err)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, approvers[1:], task.Approvers)
+
+	requireEvent(t, f.ctx, &types.EventTaskApproversSet{
+		TaskId:       id,
+		Creator:      actors.creator,
+		OldApprovers: approvers[:1],
+		NewApprovers: approvers[1:],
+	})
+
+	// the replaced approver is free to claim the task
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(approvers[0], id))
+	require.NoError(t, err)
+
+	// approvers are fixed once the task is claimed
+	_, err = srv.SetTaskApprovers(f.ctx, types.NewMsgSetTaskApprovers(actors.creator, id, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(approvers[0], id, newTestProof(f)))
+	require.NoError(t, err)
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(approvers[0], id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the creator can always approve
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.NoError(t, err)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CloseTask lets the creator cancel an open task, refunding its escrowed
+// bounty, or finalize an approved task whose bounty was already paid out.
+// The closed task is archived when the ArchiveClosedTasks param is set.
+func (k msgServer) CloseTask(ctx context.Context, msg *types.MsgCloseTask) (*types.MsgCloseTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanClose(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.closeTask(ctx, task, msg.Creator, types.RefundReasonClosed); err != nil {
+		return nil, err
+	}
+
+	archived, err := k.ArchivedTask.Has(ctx, task.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get archived task")
+	}
+
+	refund := task.Bounty
+	if task.Status == types.TASK_STATUS_APPROVED {
+		refund = sdk.NewCoins()
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskClosed{
+		TaskId:    task.Id,
+		Creator:   msg.Creator,
+		Refund:    refund,
+		OldStatus: task.Status,
+		NewStatus: types.TASK_STATUS_CLOSED,
+		Archived:  archived,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseTaskResponse{Archived: archived}, nil
+}
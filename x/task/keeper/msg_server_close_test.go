@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestTaskMsgServerCloseOpen(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	id := resp.Id
+
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask("invalid", id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, 10))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.claimant, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	closeResp, err := srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.NoError(t, err)
+	require.False(t, closeResp.Archived)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	refund, err := qs.GetTaskRefund(f.ctx, &types.QueryGetTaskRefundRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, types.RefundReasonClosed, refund.TaskRefund.Reason)
+	require.Equal(t, testBounty, refund.TaskRefund.Amount)
+
+	requireEvent(t, f.ctx, &types.EventTaskClosed{
+		TaskId:    id,
+		Creator:   actors.creator,
+		Refund:    testBounty,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLOSED,
+	})
+
+	history, err := qs.TaskHistory(f.ctx, &types.QueryTaskHistoryRequest{Id: id})
+	require.NoError(t, err)
+	closed := history.TaskTransition[len(history.TaskTransition)-1]
+	require.Equal(t, types.TASK_STATUS_OPEN, closed.From)
+	require.Equal(t, types.TASK_STATUS_CLOSED, closed.To)
+	require.Equal(t, actors.creator, closed.Actor)
+
+	// a closed task cannot be closed, or refunded, twice
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// closed tasks can be deleted without a second refund
+	_, err = srv.DeleteTask(f.ctx, types.NewMsgDeleteTask(actors.creator, id))
+	require.NoError(t, err)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+}
+
+func TestTaskMsgServerCloseApproved(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	// tasks under review cannot be closed
+	_, err := srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.NoError(t, err)
+
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.NoError(t, err)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+	require.True(t, f.bankKeeper.balance(actors.creatorAddr).IsZero())
+
+	// the bounty was paid out on approval, so nothing is refunded
+	has, err := f.keeper.TaskRefund.Has(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	requireEvent(t, f.ctx, &types.EventTaskClosed{
+		TaskId:    id,
+		Creator:   actors.creator,
+		Refund:    sdk.NewCoins(),
+		OldStatus: types.TASK_STATUS_APPROVED,
+		NewStatus: types.TASK_STATUS_CLOSED,
+	})
+}
+
+func TestTaskMsgServerCloseArchive(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	params := types.DefaultParams()
+	params.ArchiveClosedTasks = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty.Add(testBounty...))
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	id := resp.Id
+	kept, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	closeResp, err := srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.NoError(t, err)
+	require.True(t, closeResp.Archived)
+
+	requireEvent(t, f.ctx, &types.EventTaskClosed{
+		TaskId:    id,
+		Creator:   actors.creator,
+		Refund:    testBounty,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLOSED,
+		Archived:  true,
+	})
+
+	// the task left the hot store and its indexes
+	_, err = qs.GetTask(f.ctx, &types.QueryGetTaskRequest{Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	byCreator, err := qs.TasksByCreator(f.ctx, &types.QueryTasksByCreatorRequest{Creator: actors.creator})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Task, 1)
+	require.Equal(t, kept.Id, byCreator.Task[0].Id)
+
+	archived, err := qs.GetArchivedTask(f.ctx, &types.QueryGetArchivedTaskRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, archived.Task.Status)
+	require.Equal(t, actors.creator, archived.Task.Creator)
+
+	_, err = qs.GetArchivedTask(f.ctx, &types.QueryGetArchivedTaskRequest{Id: kept.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	all, err := qs.ListArchivedTask(f.ctx, &types.QueryAllArchivedTaskRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Task, 1)
+
+	// the history and refund of an archived task are kept
+	history, err := qs.TaskHistory(f.ctx, &types.QueryTaskHistoryRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, history.TaskTransition[len(history.TaskTransition)-1].To)
+	_, err = qs.GetTaskRefund(f.ctx, &types.QueryGetTaskRefundRequest{Id: id})
+	require.NoError(t, err)
+
+	// expired tasks are archived as well, and the stale expiry of the
+	// archived task is dropped
+	ctx := afterSeconds(f, params.TaskExpiry+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	has, err := f.keeper.Task.Has(ctx, kept.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	all, err = qs.ListArchivedTask(ctx, &types.QueryAllArchivedTaskRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Task, 2)
+	require.Equal(t, testBounty.Add(testBounty...), f.bankKeeper.balance(actors.creatorAddr))
+}
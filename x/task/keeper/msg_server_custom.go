@@ -1,17 +1,17 @@
 package keeper
 
 import (
-"context"
-"errors"
-"fmt"
-"time"
+	"context"
+	"errors"
+	"fmt"
+	"time"
 
-"taskbounty/x/task/types"
+	"taskbounty/x/task/types"
 
-"cosmossdk.io/collections"
-errorsmod "cosmossdk.io/errors"
-sdk "github.com/cosmos/cosmos-sdk/types"
-sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // claiming of a task by a user
@@ -31,6 +31,9 @@ func (k msgServer) ClaimTask(ctx context.Context, msg *types.MsgClaimTask) (*typ
 	if err := task.CanClaim(msg.Claimant); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := task.ValidateMaxBounty(msg.MaxBounty); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -43,15 +46,38 @@ func (k msgServer) ClaimTask(ctx context.Context, msg *types.MsgClaimTask) (*typ
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task has expired")
 	}
 
+	oldStatus := task.Status
 	task.Claimant = msg.Claimant
 	task.Status = types.TASK_STATUS_CLAIMED
 	task.UpdatedAt = currentTime
+	task.ClaimedAt = currentTime
 
 	// Save the updated task
 	if err := k.Task.Set(ctx, task.Id, task); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
 	}
 
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, msg.Claimant, ""); err != nil {
+		return nil, err
+	}
+
+	if err := k.scheduleDeadline(ctx, task, params); err != nil {
+		return nil, err
+	}
+
+	if err := k.grantTaskFeeAllowance(ctx, task); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskClaimed{
+		TaskId:    task.Id,
+		Claimant:  task.Claimant,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgClaimTaskResponse{}, nil
 }
 
@@ -83,24 +109,72 @@ func (k msgServer) SubmitTask(ctx context.Context, msg *types.MsgSubmitTask) (*t
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentTime := sdkCtx.BlockTime().Unix()
-	if task.IsClaimExpired(params, time.Unix(currentTime, 0)) {
+	if task.Status == types.TASK_STATUS_CLAIMED && task.IsClaimExpired(params, time.Unix(currentTime, 0)) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task claim has expired")
 	}
 
+	if err := k.checkSubmissionAttempts(ctx, task.Id, msg.Claimant, params); err != nil {
+		return nil, err
+	}
+
+	// A rejected claimant resubmits by taking the claim back first
+	if task.Status == types.TASK_STATUS_REJECTED {
+		if !types.IsValidTransition(task.Status, types.TASK_STATUS_CLAIMED) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot resubmit a rejected task")
+		}
+		if err := k.recordTransition(ctx, task.Id, task.Status, types.TASK_STATUS_CLAIMED, msg.Claimant, "resubmission"); err != nil {
+			return nil, err
+		}
+		task.Status = types.TASK_STATUS_CLAIMED
+	}
+
 	proofStr := fmt.Sprintf("%s:%s:%d", msg.Proof.Hash, msg.Proof.Type, msg.Proof.Timestamp)
 	if msg.Proof.Data != "" {
 		proofStr += fmt.Sprintf(":%s", msg.Proof.Data)
 	}
 
+	// Reviews apply to a single submission
+	if err := k.TaskReview.Clear(ctx, collections.NewPrefixedPairRange[uint64, string](task.Id)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear task reviews")
+	}
+
+	submission, err := k.recordSubmission(ctx, task, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	oldStatus := task.Status
 	task.Proof = proofStr
+	task.Attempt = submission.Attempt
 	task.Status = types.TASK_STATUS_SUBMITTED
 	task.UpdatedAt = currentTime
+	task.SubmittedAt = currentTime
 
 	if err := k.Task.Set(ctx, task.Id, task); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
 	}
 
-	return &types.MsgSubmitTaskResponse{}, nil
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, msg.Claimant, fmt.Sprintf("attempt %d", submission.Attempt)); err != nil {
+		return nil, err
+	}
+
+	if err := k.scheduleDeadline(ctx, task, params); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskSubmitted{
+		TaskId:    task.Id,
+		Claimant:  task.Claimant,
+		Attempt:   submission.Attempt,
+		ProofHash: msg.Proof.Hash,
+		ProofType: msg.Proof.Type,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTaskResponse{Attempt: submission.Attempt}, nil
 }
 
 func (k msgServer) ApproveTask(ctx context.Context, msg *types.MsgApproveTask) (*types.MsgApproveTaskResponse, error) {
@@ -119,36 +193,23 @@ func (k msgServer) ApproveTask(ctx context.Context, msg *types.MsgApproveTask) (
 	if err := task.CanApprove(msg.Approver); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	
+	if err := task.ValidateMaxBounty(msg.MaxBounty); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
 	}
-	
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentTime := sdkCtx.BlockTime().Unix()
 	if task.IsSubmissionExpired(params, time.Unix(currentTime, 0)) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task submission has expired")
 	}
 
-	// Update the task status to APPROVED first
-	task.Approver = msg.Approver
-	task.Status = types.TASK_STATUS_APPROVED
-	task.UpdatedAt = currentTime
-	
-	reward := types.CreateTaskReward(task.Id, task.Claimant, task.Bounty, msg.TxHash, currentTime)
-	
-	// Validate the reward with the updated task (whic is now APPROVED)
-	if err := types.ValidateRewardDistribution(task, reward); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-	}
-
-	if err := k.Task.Set(ctx, task.Id, task); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
-	}
-
-	if err := k.TaskReward.Set(ctx, task.Id, reward); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task reward")
+	if err := k.approveTask(ctx, task, msg.Approver, ""); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgApproveTaskResponse{}, nil
@@ -168,6 +229,9 @@ func (k msgServer) RejectTask(ctx context.Context, msg *types.MsgRejectTask) (*t
 	if err := task.CanReject(msg.Rejecter); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := task.ValidateMaxBounty(msg.MaxBounty); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
@@ -178,14 +242,9 @@ func (k msgServer) RejectTask(ctx context.Context, msg *types.MsgRejectTask) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task submission has expired")
 	}
 
-	task.Proof = fmt.Sprintf("REJECTED: %s", msg.Reason)
-	task.Status = types.TASK_STATUS_REJECTED
-	task.UpdatedAt = currentTime
-
-	if err := k.Task.Set(ctx, task.Id, task); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	if err := k.rejectTask(ctx, task, msg.Rejecter, msg.Reason); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRejectTaskResponse{}, nil
 }
-
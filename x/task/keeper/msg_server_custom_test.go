@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+type taskActors struct {
+	creatorAddr  sdk.AccAddress
+	creator      string
+	claimantAddr sdk.AccAddress
+	claimant     string
+}
+
+func newTaskActors(t *testing.T, f *fixture) taskActors {
+	t.Helper()
+
+	creatorAddr := sdk.AccAddress([]byte("creatorAddr_________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
+	require.NoError(t, err)
+
+	claimantAddr := sdk.AccAddress([]byte("claimantAddr________________"))
+	claimant, err := f.addressCodec.BytesToString(claimantAddr)
+	require.NoError(t, err)
+
+	return taskActors{
+		creatorAddr:  creatorAddr,
+		creator:      creator,
+		claimantAddr: claimantAddr,
+		claimant:     claimant,
+	}
+}
+
+func newTestProof(f *fixture) types.TaskProof {
+	return types.TaskProof{
+		Hash:      "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		Type:      "ipfs",
+		Timestamp: sdk.UnwrapSDKContext(f.ctx).BlockTime().Unix(),
+	}
+}
+
+// createSubmittedTask funds the creator, creates a task, claims it and submits a proof.
+func createSubmittedTask(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors) uint64 {
+	t.Helper()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, resp.Id))
+	require.NoError(t, err)
+
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, resp.Id, newTestProof(f)))
+	require.NoError(t, err)
+
+	return resp.Id
+}
+
+func TestTaskMsgServerApprovePayout(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	txBytes := []byte("approve-task-tx")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42).WithTxBytes(txBytes)
+
+	_, err := srv.ApproveTask(ctx, types.NewMsgApproveTask(actors.claimant, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ApproveTask(ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.NoError(t, err)
+
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	task, err := f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, task.Status)
+
+	reward, err := f.keeper.TaskReward.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, actors.claimant, reward.Claimant)
+	require.Equal(t, testBounty, reward.Amount)
+	require.Equal(t, int64(42), reward.BlockHeight)
+	require.Equal(t, fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()), reward.TxHash)
+	require.Equal(t, ctx.BlockTime().Unix(), reward.Timestamp)
+
+	submission, err := f.keeper.Submission.Get(ctx, collections.Join(id, uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, types.SUBMISSION_OUTCOME_APPROVED, submission.Outcome)
+	require.Equal(t, actors.creator, submission.Reviewer)
+
+	requireEvent(t, ctx, &types.EventTaskCreated{
+		TaskId:  id,
+		Creator: actors.creator,
+		Bounty:  testBounty,
+		Status:  types.TASK_STATUS_OPEN,
+	})
+	requireEvent(t, ctx, &types.EventTaskClaimed{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		OldStatus: types.TASK_STATUS_OPEN,
+		NewStatus: types.TASK_STATUS_CLAIMED,
+	})
+	requireEvent(t, ctx, &types.EventTaskSubmitted{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		Attempt:   1,
+		ProofHash: newTestProof(f).Hash,
+		ProofType: newTestProof(f).Type,
+		OldStatus: types.TASK_STATUS_CLAIMED,
+		NewStatus: types.TASK_STATUS_SUBMITTED,
+	})
+	requireEvent(t, ctx, &types.EventTaskApproved{
+		TaskId:    id,
+		Approver:  actors.creator,
+		Claimant:  actors.claimant,
+		Amount:    testBounty,
+		OldStatus: types.TASK_STATUS_SUBMITTED,
+		NewStatus: types.TASK_STATUS_APPROVED,
+		Attempt:   1,
+	})
+	requireEvent(t, ctx, &types.EventTaskPaid{
+		TaskId:   id,
+		Claimant: actors.claimant,
+		Amount:   testBounty,
+		TxHash:   reward.TxHash,
+	})
+
+	// an approved task cannot be paid twice
+	_, err = srv.ApproveTask(ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+}
+
+func TestTaskMsgServerApproveEmptyEscrow(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	// drain the module account so that the payout cannot be made
+	moduleBalance := f.bankKeeper.moduleBalance(types.ModuleName)
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, actors.creatorAddr, moduleBalance))
+
+	_, err := srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+	require.Error(t, err)
+
+	_, err = f.keeper.TaskReward.Get(f.ctx, id)
+	require.Error(t, err)
+}
+
+func TestTaskMsgServerSubmissionRecords(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	resp, err := qs.GetSubmission(f.ctx, &types.QueryGetSubmissionRequest{Id: id, Attempt: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Submission{
+		TaskId:      id,
+		Attempt:     1,
+		Submitter:   actors.claimant,
+		Proof:       newTestProof(f),
+		SubmittedAt: sdk.UnwrapSDKContext(f.ctx).BlockTime().Unix(),
+		Outcome:     types.SUBMISSION_OUTCOME_PENDING,
+	}, resp.Submission)
+
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "missing tests"))
+	require.NoError(t, err)
+
+	// the rejected proof is kept next to the rejection reason
+	list, err := qs.ListSubmission(f.ctx, &types.QueryAllSubmissionRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, list.Submission, 1)
+
+	submission := list.Submission[0]
+	require.Equal(t, newTestProof(f), submission.Proof)
+	require.Equal(t, types.SUBMISSION_OUTCOME_REJECTED, submission.Outcome)
+	require.Equal(t, actors.creator, submission.Reviewer)
+	require.Equal(t, "missing tests", submission.ReviewNote)
+
+	requireEvent(t, f.ctx, &types.EventTaskRejected{
+		TaskId:    id,
+		Rejecter:  actors.creator,
+		Claimant:  actors.claimant,
+		Reason:    "missing tests",
+		OldStatus: types.TASK_STATUS_SUBMITTED,
+		NewStatus: types.TASK_STATUS_REJECTED,
+		Attempt:   1,
+	})
+
+	_, err = qs.GetSubmission(f.ctx, &types.QueryGetSubmissionRequest{Id: id, Attempt: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestTaskMsgServerResubmission(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	params := types.DefaultParams()
+	params.MaxSubmissionAttempts = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	id := createSubmittedTask(t, f, srv, actors)
+	_, err := srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "missing tests"))
+	require.NoError(t, err)
+
+	// only the rejected claimant can resubmit
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.creator, id, newTestProof(f)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	proof := newTestProof(f)
+	proof.Data = "added tests"
+	resp, err := srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, id, proof))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Attempt)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_SUBMITTED, task.Status)
+	require.Equal(t, uint64(2), task.Attempt)
+
+	requireEvent(t, f.ctx, &types.EventTaskSubmitted{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		Attempt:   2,
+		ProofHash: proof.Hash,
+		ProofType: proof.Type,
+		OldStatus: types.TASK_STATUS_CLAIMED,
+		NewStatus: types.TASK_STATUS_SUBMITTED,
+	})
+
+	// the resubmission goes back through CLAIMED
+	history, err := qs.TaskHistory(f.ctx, &types.QueryTaskHistoryRequest{Id: id})
+	require.NoError(t, err)
+	transitions := history.TaskTransition[len(history.TaskTransition)-2:]
+	require.Equal(t, types.TASK_STATUS_REJECTED, transitions[0].From)
+	require.Equal(t, types.TASK_STATUS_CLAIMED, transitions[0].To)
+	require.Equal(t, "resubmission", transitions[0].Reason)
+	require.Equal(t, types.TASK_STATUS_CLAIMED, transitions[1].From)
+	require.Equal(t, types.TASK_STATUS_SUBMITTED, transitions[1].To)
+	require.Equal(t, "attempt 2", transitions[1].Reason)
+
+	// every attempt is kept with its own outcome
+	submissions, err := qs.ListSubmission(f.ctx, &types.QueryAllSubmissionRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, submissions.Submission, 2)
+	require.Equal(t, types.SUBMISSION_OUTCOME_REJECTED, submissions.Submission[0].Outcome)
+	require.Equal(t, "missing tests", submissions.Submission[0].ReviewNote)
+	require.Equal(t, newTestProof(f), submissions.Submission[0].Proof)
+	require.Equal(t, types.SUBMISSION_OUTCOME_PENDING, submissions.Submission[1].Outcome)
+	require.Equal(t, proof, submissions.Submission[1].Proof)
+
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "still missing tests"))
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventTaskRejected{
+		TaskId:    id,
+		Rejecter:  actors.creator,
+		Claimant:  actors.claimant,
+		Reason:    "still missing tests",
+		OldStatus: types.TASK_STATUS_SUBMITTED,
+		NewStatus: types.TASK_STATUS_REJECTED,
+		Attempt:   2,
+	})
+
+	// the claimant used up their attempts
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, id, proof))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	task, err = f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_REJECTED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
+
+func TestTaskMsgServerMaxBounty(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	for _, maxBounty := range []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", testBounty.AmountOf("stake").Int64()-1)),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", testBounty.AmountOf("stake").Int64())),
+	} {
+		msg := types.NewMsgRejectTask(actors.creator, id, "missing tests")
+		msg.MaxBounty = maxBounty
+		_, err := srv.RejectTask(f.ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+		approve := types.NewMsgApproveTask(actors.creator, id)
+		approve.MaxBounty = maxBounty
+		_, err = srv.ApproveTask(f.ctx, approve)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+
+	approve := types.NewMsgApproveTask(actors.creator, id)
+	approve.MaxBounty = testBounty
+	_, err := srv.ApproveTask(f.ctx, approve)
+	require.NoError(t, err)
+}
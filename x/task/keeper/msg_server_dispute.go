@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DisputeTask lets the claimant contest the rejection of their submission.
+// The task moves to DISPUTED, which freezes its escrow until an arbiter
+// resolves the dispute. A rejection can only be disputed within the dispute
+// window.
+func (k msgServer) DisputeTask(ctx context.Context, msg *types.MsgDisputeTask) (*types.MsgDisputeTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Claimant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanDispute(msg.Claimant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+	if len(params.Arbiters) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no arbiters are configured to resolve disputes")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !task.IsDisputeWindowOpen(params, sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("dispute window of task %d closed at %d", task.Id, task.DisputeWindowEndsAt(params).Unix()))
+	}
+
+	seq, err := k.nextDisputeSeq(ctx, task.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := sdkCtx.BlockTime().Unix()
+
+	dispute := types.TaskDispute{
+		TaskId:    task.Id,
+		Seq:       seq,
+		Claimant:  msg.Claimant,
+		Reason:    msg.Reason,
+		CreatedAt: currentTime,
+	}
+	if err := k.TaskDispute.Set(ctx, collections.Join(task.Id, seq), dispute); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task dispute")
+	}
+
+	oldStatus := task.Status
+	task.Status = types.TASK_STATUS_DISPUTED
+	task.UpdatedAt = currentTime
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, msg.Claimant, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskDisputed{
+		TaskId:    task.Id,
+		Claimant:  msg.Claimant,
+		Seq:       seq,
+		Reason:    msg.Reason,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisputeTaskResponse{Seq: seq}, nil
+}
+
+// ResolveDispute settles a disputed task with a full payout to the claimant,
+// a full refund to the creator or a weighted split between the two. Only the
+// arbiters in Params, which may be x/group policy addresses, can resolve.
+func (k msgServer) ResolveDispute(ctx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Arbiter); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if task.Status != types.TASK_STATUS_DISPUTED {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task is not disputed")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+	if !params.IsArbiter(msg.Arbiter) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only an arbiter can resolve disputes")
+	}
+
+	dispute, err := k.latestDispute(ctx, task.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var (
+		claimantAmount, creatorAmount sdk.Coins
+		newStatus                     = types.TASK_STATUS_CLOSED
+	)
+	switch msg.Resolution {
+	case types.DISPUTE_RESOLUTION_PAYOUT:
+		newStatus = types.TASK_STATUS_APPROVED
+		if err := k.approveTask(ctx, task, msg.Arbiter, msg.Note); err != nil {
+			return nil, err
+		}
+		claimantAmount, creatorAmount = task.Bounty, sdk.NewCoins()
+	case types.DISPUTE_RESOLUTION_REFUND:
+		if err := k.closeTask(ctx, task, msg.Arbiter, types.RefundReasonDisputed); err != nil {
+			return nil, err
+		}
+		claimantAmount, creatorAmount = sdk.NewCoins(), task.Bounty
+	case types.DISPUTE_RESOLUTION_SPLIT:
+		if msg.ClaimantWeight == 0 || msg.CreatorWeight == 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "split weights must both be positive, use payout or refund instead")
+		}
+		if claimantAmount, creatorAmount, err = k.splitTask(ctx, task, msg.ClaimantWeight, msg.CreatorWeight); err != nil {
+			return nil, err
+		}
+
+		task.Status = types.TASK_STATUS_CLOSED
+		task.UpdatedAt = sdkCtx.BlockTime().Unix()
+		if err := k.Task.Set(ctx, task.Id, task); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+		}
+		if err := k.recordTransition(ctx, task.Id, types.TASK_STATUS_DISPUTED, task.Status, msg.Arbiter, msg.Note); err != nil {
+			return nil, err
+		}
+		if _, err := k.archiveTask(ctx, task); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "resolution must be payout, refund or split")
+	}
+
+	dispute.Arbiter = msg.Arbiter
+	dispute.Resolution = msg.Resolution
+	dispute.Note = msg.Note
+	dispute.ClaimantAmount = claimantAmount
+	dispute.CreatorAmount = creatorAmount
+	dispute.ResolvedAt = sdkCtx.BlockTime().Unix()
+
+	if err := k.TaskDispute.Set(ctx, collections.Join(dispute.TaskId, dispute.Seq), dispute); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task dispute")
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskDisputeResolved{
+		TaskId:         task.Id,
+		Seq:            dispute.Seq,
+		Arbiter:        msg.Arbiter,
+		Resolution:     msg.Resolution,
+		ClaimantAmount: claimantAmount,
+		CreatorAmount:  creatorAmount,
+		NewStatus:      newStatus,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveDisputeResponse{}, nil
+}
+
+// nextDisputeSeq returns the sequence of the next dispute of a task.
+func (k Keeper) nextDisputeSeq(ctx context.Context, id uint64) (uint64, error) {
+	latest, err := k.latestDispute(ctx, id)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return latest.Seq + 1, nil
+}
+
+// latestDispute returns the most recent dispute of a task.
+func (k Keeper) latestDispute(ctx context.Context, id uint64) (types.TaskDispute, error) {
+	iter, err := k.TaskDispute.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id).Descending())
+	if err != nil {
+		return types.TaskDispute{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task dispute")
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TaskDispute{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d has no dispute", id))
+	}
+
+	dispute, err := iter.Value()
+	if err != nil {
+		return types.TaskDispute{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task dispute")
+	}
+
+	return dispute, nil
+}
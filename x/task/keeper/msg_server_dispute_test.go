@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+// createDisputedTask configures arbiter as the only arbiter, then creates,
+// submits, rejects and disputes a task.
+func createDisputedTask(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, arbiter string) uint64 {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.Arbiters = []string{arbiter}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	id := createSubmittedTask(t, f, srv, actors)
+	_, err := srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "incomplete"))
+	require.NoError(t, err)
+
+	_, err = srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.claimant, id, "all requirements are met"))
+	require.NoError(t, err)
+
+	return id
+}
+
+func newTestArbiter(t *testing.T, f *fixture) (sdk.AccAddress, string) {
+	t.Helper()
+
+	arbiterAddr := sdk.AccAddress([]byte("arbiterAddr_________________"))
+	arbiter, err := f.addressCodec.BytesToString(arbiterAddr)
+	require.NoError(t, err)
+	return arbiterAddr, arbiter
+}
+
+func TestTaskMsgServerDispute(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	_, arbiter := newTestArbiter(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	// only rejected tasks can be disputed
+	_, err := srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.claimant, id, "too early"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "incomplete"))
+	require.NoError(t, err)
+
+	// disputes cannot be raised without arbiters to resolve them
+	_, err = srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.claimant, id, "all requirements are met"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	params := types.DefaultParams()
+	params.Arbiters = []string{arbiter}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.creator, id, "not the claimant"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	resp, err := srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.claimant, id, "all requirements are met"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Seq)
+
+	requireEvent(t, f.ctx, &types.EventTaskDisputed{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		Seq:       resp.Seq,
+		Reason:    "all requirements are met",
+		OldStatus: types.TASK_STATUS_REJECTED,
+		NewStatus: types.TASK_STATUS_DISPUTED,
+	})
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_DISPUTED, task.Status)
+
+	// the escrow is frozen while the dispute is open
+	_, err = srv.UpdateTask(f.ctx, newTestMsgUpdateTask(actors.creator, id, testBounty))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.DeleteTask(f.ctx, &types.MsgDeleteTask{Creator: actors.creator, Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	ctx := afterSeconds(f, params.TaskExpiry+params.SubmissionDeadline)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err = f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_DISPUTED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
+
+func TestTaskMsgServerResolveDispute(t *testing.T) {
+	tests := []struct {
+		desc           string
+		resolution     types.DisputeResolution
+		claimantWeight uint64
+		creatorWeight  uint64
+		status         types.TaskStatus
+		claimantAmount sdk.Coins
+		creatorAmount  sdk.Coins
+	}{
+		{
+			desc:           "payout",
+			resolution:     types.DISPUTE_RESOLUTION_PAYOUT,
+			status:         types.TASK_STATUS_APPROVED,
+			claimantAmount: testBounty,
+			creatorAmount:  sdk.NewCoins(),
+		},
+		{
+			desc:           "refund",
+			resolution:     types.DISPUTE_RESOLUTION_REFUND,
+			status:         types.TASK_STATUS_CLOSED,
+			claimantAmount: sdk.NewCoins(),
+			creatorAmount:  testBounty,
+		},
+		{
+			desc:           "split",
+			resolution:     types.DISPUTE_RESOLUTION_SPLIT,
+			claimantWeight: 3,
+			creatorWeight:  1,
+			status:         types.TASK_STATUS_CLOSED,
+			claimantAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 3750)),
+			creatorAmount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1250)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := initFixture(t)
+			srv := keeper.NewMsgServerImpl(f.keeper)
+			qs := keeper.NewQueryServerImpl(f.keeper)
+			actors := newTaskActors(t, f)
+			_, arbiter := newTestArbiter(t, f)
+
+			id := createDisputedTask(t, f, srv, actors, arbiter)
+
+			msg := types.NewMsgResolveDispute(arbiter, id, tc.resolution, tc.claimantWeight, tc.creatorWeight, "reviewed the evidence")
+
+			unauthorized := *msg
+			unauthorized.Arbiter = actors.creator
+			_, err := srv.ResolveDispute(f.ctx, &unauthorized)
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			_, err = srv.ResolveDispute(f.ctx, msg)
+			require.NoError(t, err)
+
+			task, err := f.keeper.Task.Get(f.ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, task.Status)
+
+			require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+			require.True(t, f.bankKeeper.balance(actors.claimantAddr).Equal(tc.claimantAmount))
+			require.True(t, f.bankKeeper.balance(actors.creatorAddr).Equal(tc.creatorAmount))
+
+			disputes, err := qs.ListTaskDispute(f.ctx, &types.QueryAllTaskDisputeRequest{Id: id})
+			require.NoError(t, err)
+			require.Len(t, disputes.TaskDispute, 1)
+
+			dispute := disputes.TaskDispute[0]
+			require.Equal(t, actors.claimant, dispute.Claimant)
+			require.Equal(t, "all requirements are met", dispute.Reason)
+			require.Equal(t, arbiter, dispute.Arbiter)
+			require.Equal(t, tc.resolution, dispute.Resolution)
+			require.Equal(t, "reviewed the evidence", dispute.Note)
+			require.True(t, dispute.ClaimantAmount.Equal(tc.claimantAmount))
+			require.True(t, dispute.CreatorAmount.Equal(tc.creatorAmount))
+			require.NotZero(t, dispute.ResolvedAt)
+
+			requireEvent(t, f.ctx, &types.EventTaskDisputeResolved{
+				TaskId:         id,
+				Seq:            dispute.Seq,
+				Arbiter:        arbiter,
+				Resolution:     tc.resolution,
+				ClaimantAmount: tc.claimantAmount,
+				CreatorAmount:  tc.creatorAmount,
+				NewStatus:      tc.status,
+			})
+
+			// a resolved dispute cannot be resolved again
+			_, err = srv.ResolveDispute(f.ctx, msg)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+}
+
+func TestTaskMsgServerResolveDisputeInvalidSplit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	_, arbiter := newTestArbiter(t, f)
+
+	id := createDisputedTask(t, f, srv, actors, arbiter)
+
+	_, err := srv.ResolveDispute(f.ctx, types.NewMsgResolveDispute(arbiter, id, types.DISPUTE_RESOLUTION_SPLIT, 1, 0, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ResolveDispute(f.ctx, types.NewMsgResolveDispute(arbiter, id, types.DISPUTE_RESOLUTION_UNSPECIFIED, 0, 0, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
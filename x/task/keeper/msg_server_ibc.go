@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// sendTaskPacket validates and sends a task packet of sender over a task
+// channel, returning its sequence.
+func (k msgServer) sendTaskPacket(ctx context.Context, sender, channelID string, timeoutTimestamp uint64, data types.TaskPacketData) (uint64, error) {
+	if _, err := k.addressCodec.StringToBytes(sender); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	timeout, err := k.packetTimeout(ctx, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.transmitTaskPacket(ctx, channelID, timeout, data)
+}
+
+// SendCreateTask sends a create-task packet over a task channel. The task is
+// created on the other chain for the creator's remote account there, which
+// must hold the bounty.
+func (k msgServer) SendCreateTask(ctx context.Context, msg *types.MsgSendCreateTask) (*types.MsgSendCreateTaskResponse, error) {
+	sequence, err := k.sendTaskPacket(ctx, msg.Creator, msg.ChannelId, msg.TimeoutTimestamp, types.TaskPacketData{
+		Packet: &types.TaskPacketData_CreateTaskPacket{CreateTaskPacket: &types.CreateTaskPacketData{
+			Sender:      msg.Creator,
+			Title:       msg.Title,
+			Description: msg.Description,
+			Bounty:      msg.Bounty,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendCreateTaskResponse{Sequence: sequence}, nil
+}
+
+// SendClaimTask sends a claim-task packet over a task channel. The task is
+// claimed on the other chain for the claimant's remote account there.
+func (k msgServer) SendClaimTask(ctx context.Context, msg *types.MsgSendClaimTask) (*types.MsgSendClaimTaskResponse, error) {
+	sequence, err := k.sendTaskPacket(ctx, msg.Claimant, msg.ChannelId, msg.TimeoutTimestamp, types.TaskPacketData{
+		Packet: &types.TaskPacketData_ClaimTaskPacket{ClaimTaskPacket: &types.ClaimTaskPacketData{
+			Sender: msg.Claimant,
+			TaskId: msg.TaskId,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendClaimTaskResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UnclaimTask lets the claimant abandon a claimed task, which goes back to
+// OPEN so that someone else can claim it.
+func (k msgServer) UnclaimTask(ctx context.Context, msg *types.MsgUnclaimTask) (*types.MsgUnclaimTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Claimant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanUnclaim(msg.Claimant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+
+	if err := k.reopenTask(ctx, task, params, msg.Claimant, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskUnclaimed{
+		TaskId:    task.Id,
+		Claimant:  msg.Claimant,
+		Reason:    msg.Reason,
+		OldStatus: task.Status,
+		NewStatus: types.TASK_STATUS_OPEN,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnclaimTaskResponse{}, nil
+}
+
+// ReopenTask lets the creator reopen a rejected task for new claims once the
+// claimant can no longer dispute the rejection. The bounty stays in escrow and
+// the rejected submission is kept.
+func (k msgServer) ReopenTask(ctx context.Context, msg *types.MsgReopenTask) (*types.MsgReopenTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanReopen(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+
+	// the claimant keeps the chance to dispute the rejection until the
+	// window closes
+	if task.IsDisputeWindowOpen(params, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("task %d can be disputed until %d", task.Id, task.DisputeWindowEndsAt(params).Unix()))
+	}
+
+	if err := k.reopenTask(ctx, task, params, msg.Creator, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskReopened{
+		TaskId:           task.Id,
+		Creator:          msg.Creator,
+		PreviousClaimant: task.Claimant,
+		Reason:           msg.Reason,
+		OldStatus:        task.Status,
+		NewStatus:        types.TASK_STATUS_OPEN,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReopenTaskResponse{}, nil
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestTaskMsgServerUnclaim(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	id := resp.Id
+
+	// an open task has no claim to release
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask(actors.claimant, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask("invalid", id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask(actors.claimant, 10, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask(actors.creator, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask(actors.claimant, id, "no time left"))
+	require.NoError(t, err)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+	require.Empty(t, task.Claimant)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	requireEvent(t, f.ctx, &types.EventTaskUnclaimed{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		Reason:    "no time left",
+		OldStatus: types.TASK_STATUS_CLAIMED,
+		NewStatus: types.TASK_STATUS_OPEN,
+	})
+
+	history, err := qs.TaskHistory(f.ctx, &types.QueryTaskHistoryRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, history.TaskTransition, 3)
+	unclaimed := history.TaskTransition[2]
+	require.Equal(t, types.TASK_STATUS_CLAIMED, unclaimed.From)
+	require.Equal(t, types.TASK_STATUS_OPEN, unclaimed.To)
+	require.Equal(t, actors.claimant, unclaimed.Actor)
+	require.Equal(t, "no time left", unclaimed.Reason)
+
+	// the released task is subject to its expiry, not to the abandoned claim deadline
+	ctx := afterSeconds(f, params.ClaimDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	task, err = f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	has, err := f.keeper.DeadlineQueue.Has(ctx, collections.Join3(
+		int64(task.CreatedAt)+int64(params.TaskExpiry), id, int32(types.DeadlineTaskExpiry),
+	))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// someone else can claim it now
+	other := sdk.AccAddress([]byte("otherClaimantAddr___________")).String()
+	_, err = srv.ClaimTask(ctx, types.NewMsgClaimTask(other, id))
+	require.NoError(t, err)
+}
+
+func TestTaskMsgServerReopen(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+	reviewers := newTestReviewers(t, f, 3)
+
+	id := createReviewedTask(t, f, srv, actors, reviewers)
+
+	// only rejected tasks can be reopened
+	_, err := srv.ReopenTask(f.ctx, types.NewMsgReopenTask(actors.creator, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[0], id, types.REVIEW_DECISION_ENDORSE, ""))
+	require.NoError(t, err)
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "incomplete"))
+	require.NoError(t, err)
+
+	// the rejection can be disputed until the dispute window closes
+	ctx := afterSeconds(f, params.DisputeWindow)
+	_, err = srv.ReopenTask(ctx, types.NewMsgReopenTask(actors.creator, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	ctx = afterSeconds(f, params.DisputeWindow+1)
+	_, err = srv.ReopenTask(ctx, types.NewMsgReopenTask(actors.claimant, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ReopenTask(ctx, types.NewMsgReopenTask(actors.creator, id, "looking for another contributor"))
+	require.NoError(t, err)
+
+	// the previous claim is forgotten
+	task, err := f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+	require.Empty(t, task.Claimant)
+	require.Empty(t, task.Proof)
+	require.Zero(t, task.Attempt)
+	require.Zero(t, task.ClaimedAt)
+	require.Zero(t, task.SubmittedAt)
+	require.Zero(t, task.RejectedAt)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	reviews, err := qs.ListTaskReview(ctx, &types.QueryAllTaskReviewRequest{Id: id})
+	require.NoError(t, err)
+	require.Empty(t, reviews.TaskReview)
+
+	// the rejected submission is kept
+	submission, err := f.keeper.Submission.Get(ctx, collections.Join(id, uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, types.SUBMISSION_OUTCOME_REJECTED, submission.Outcome)
+
+	requireEvent(t, ctx, &types.EventTaskReopened{
+		TaskId:           id,
+		Creator:          actors.creator,
+		PreviousClaimant: actors.claimant,
+		Reason:           "looking for another contributor",
+		OldStatus:        types.TASK_STATUS_REJECTED,
+		NewStatus:        types.TASK_STATUS_OPEN,
+	})
+
+	history, err := qs.TaskHistory(ctx, &types.QueryTaskHistoryRequest{Id: id})
+	require.NoError(t, err)
+	reopened := history.TaskTransition[len(history.TaskTransition)-1]
+	require.Equal(t, types.TASK_STATUS_REJECTED, reopened.From)
+	require.Equal(t, types.TASK_STATUS_OPEN, reopened.To)
+	require.Equal(t, actors.creator, reopened.Actor)
+
+	// a new claimant's proof becomes the next attempt, and its deadlines run
+	// from its own claim
+	other := sdk.AccAddress([]byte("otherClaimantAddr___________")).String()
+	_, err = srv.ClaimTask(ctx, types.NewMsgClaimTask(other, id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(ctx, types.NewMsgSubmitTask(other, id, newTestProof(f)))
+	require.NoError(t, err)
+
+	task, err = f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), task.Attempt)
+	require.Equal(t, ctx.BlockTime().Unix(), task.ClaimedAt)
+
+	submission, err = f.keeper.Submission.Get(ctx, collections.Join(id, uint64(2)))
+	require.NoError(t, err)
+	require.Equal(t, other, submission.Submitter)
+}
+
+func TestTaskMsgServerReopenDisputeWindow(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	_, arbiter := newTestArbiter(t, f)
+
+	params := types.DefaultParams()
+	params.Arbiters = []string{arbiter}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the creator cannot reopen and close a rejected task to escape a dispute
+	id := createSubmittedTask(t, f, srv, actors)
+	_, err := srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "incomplete"))
+	require.NoError(t, err)
+
+	_, err = srv.ReopenTask(f.ctx, types.NewMsgReopenTask(actors.creator, id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.DisputeTask(f.ctx, types.NewMsgDisputeTask(actors.claimant, id, "all requirements are met"))
+	require.NoError(t, err)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_DISPUTED, task.Status)
+
+	// once the window has closed, the rejection is final and the creator can
+	// reopen and close the task
+	id = createSubmittedTask(t, f, srv, actors)
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "incomplete"))
+	require.NoError(t, err)
+
+	ctx := afterSeconds(f, params.DisputeWindow+1)
+	_, err = srv.DisputeTask(ctx, types.NewMsgDisputeTask(actors.claimant, id, "too late"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ReopenTask(ctx, types.NewMsgReopenTask(actors.creator, id, ""))
+	require.NoError(t, err)
+	_, err = srv.CloseTask(ctx, types.NewMsgCloseTask(actors.creator, id))
+	require.NoError(t, err)
+
+	_, err = srv.DisputeTask(ctx, types.NewMsgDisputeTask(actors.claimant, id, "too late"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReviewTask records a reviewer's endorsement or rejection of the current
+// submission. The task is approved and paid out once the endorsements reach
+// Params.AutoApproveThreshold, and rejected once the rejections do.
+func (k msgServer) ReviewTask(ctx context.Context, msg *types.MsgReviewTask) (*types.MsgReviewTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Reviewer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.Decision != types.REVIEW_DECISION_ENDORSE && msg.Decision != types.REVIEW_DECISION_REJECT {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "decision must be endorse or reject")
+	}
+
+	task, err := k.Task.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", msg.Id))
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	if err := task.CanReview(msg.Reviewer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTime := sdkCtx.BlockTime().Unix()
+	if task.IsSubmissionExpired(params, time.Unix(currentTime, 0)) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task submission has expired")
+	}
+
+	// A reviewer may change their mind until the threshold is reached
+	review := types.TaskReview{
+		TaskId:    task.Id,
+		Reviewer:  msg.Reviewer,
+		Decision:  msg.Decision,
+		Comment:   msg.Comment,
+		Timestamp: currentTime,
+	}
+	if err := k.TaskReview.Set(ctx, collections.Join(task.Id, msg.Reviewer), review); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task review")
+	}
+
+	endorsements, rejections, err := k.countReviews(ctx, task.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskReviewed{
+		TaskId:       task.Id,
+		Reviewer:     msg.Reviewer,
+		Decision:     msg.Decision,
+		Endorsements: endorsements,
+		Rejections:   rejections,
+	}); err != nil {
+		return nil, err
+	}
+
+	submission, err := k.latestSubmission(ctx, task.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case types.CheckAutoApproval(task, params, submission.Proof, endorsements):
+		if err := k.approveTask(ctx, task, "", "endorsed by reviewers"); err != nil {
+			return nil, err
+		}
+
+		if err := emitEvent(ctx, &types.EventTaskAutoApproved{
+			TaskId:       task.Id,
+			Claimant:     task.Claimant,
+			Endorsements: endorsements,
+		}); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgReviewTaskResponse{Status: types.TASK_STATUS_APPROVED}, nil
+	case params.AutoApproveThreshold > 0 && rejections >= params.AutoApproveThreshold:
+		if err := k.rejectTask(ctx, task, "", "rejected by reviewers"); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgReviewTaskResponse{Status: types.TASK_STATUS_REJECTED}, nil
+	}
+
+	return &types.MsgReviewTaskResponse{Status: task.Status}, nil
+}
+
+// countReviews counts the endorsements and rejections of the current submission of a task.
+func (k Keeper) countReviews(ctx context.Context, id uint64) (endorsements, rejections uint32, err error) {
+	err = k.TaskReview.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](id), func(_ collections.Pair[uint64, string], review types.TaskReview) (bool, error) {
+		switch review.Decision {
+		case types.REVIEW_DECISION_ENDORSE:
+			endorsements++
+		case types.REVIEW_DECISION_REJECT:
+			rejections++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to count task reviews")
+	}
+
+	return endorsements, rejections, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+// newTestReviewers returns n distinct reviewer addresses.
+func newTestReviewers(t *testing.T, f *fixture, n int) []string {
+	t.Helper()
+
+	reviewers := make([]string, n)
+	for i := range reviewers {
+		addr := sdk.AccAddress([]byte("reviewerAddr_______________" + string(rune('a'+i))))
+		reviewer, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		reviewers[i] = reviewer
+	}
+	return reviewers
+}
+
+// createReviewedTask creates a task with reviewers and an auto approve
+// threshold of two, then claims it and submits a proof.
+func createReviewedTask(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, reviewers []string) uint64 {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.AutoApproveThreshold = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.Reviewers = reviewers
+	resp, err := srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, resp.Id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, resp.Id, newTestProof(f)))
+	require.NoError(t, err)
+
+	return resp.Id
+}
+
+func TestTaskMsgServerCreateReviewers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	reviewers := newTestReviewers(t, f, 4)
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+
+	tests := []struct {
+		desc      string
+		reviewers []string
+	}{
+		{desc: "below threshold", reviewers: reviewers[:2]},
+		{desc: "invalid address", reviewers: []string{"invalid", "invalid", "invalid", "invalid", "invalid"}},
+		{desc: "duplicate", reviewers: append([]string{reviewers[0]}, reviewers...)},
+		{desc: "creator", reviewers: append([]string{actors.creator}, reviewers...)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := newTestMsgCreateTask(actors.creator)
+			msg.Reviewers = tc.reviewers
+
+			_, err := srv.CreateTask(f.ctx, msg)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+}
+
+func TestTaskMsgServerReviewAutoApprove(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	reviewers := newTestReviewers(t, f, 3)
+
+	id := createReviewedTask(t, f, srv, actors, reviewers)
+
+	_, err := srv.ReviewTask(f.ctx, types.NewMsgReviewTask(actors.claimant, id, types.REVIEW_DECISION_ENDORSE, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[0], id, types.REVIEW_DECISION_UNSPECIFIED, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	resp, err := srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[0], id, types.REVIEW_DECISION_ENDORSE, "looks good"))
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_SUBMITTED, resp.Status)
+
+	// a reviewer changing their mind does not count twice
+	resp, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[0], id, types.REVIEW_DECISION_ENDORSE, "still good"))
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_SUBMITTED, resp.Status)
+
+	reviews, err := qs.ListTaskReview(f.ctx, &types.QueryAllTaskReviewRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, reviews.TaskReview, 1)
+	require.Equal(t, "still good", reviews.TaskReview[0].Comment)
+
+	resp, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[1], id, types.REVIEW_DECISION_ENDORSE, ""))
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, resp.Status)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_APPROVED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.claimantAddr))
+
+	_, err = f.keeper.TaskReward.Get(f.ctx, id)
+	require.NoError(t, err)
+
+	requireEvent(t, f.ctx, &types.EventTaskReviewed{
+		TaskId:       id,
+		Reviewer:     reviewers[1],
+		Decision:     types.REVIEW_DECISION_ENDORSE,
+		Endorsements: 2,
+	})
+	requireEvent(t, f.ctx, &types.EventTaskAutoApproved{
+		TaskId:       id,
+		Claimant:     actors.claimant,
+		Endorsements: 2,
+	})
+	requireEvent(t, f.ctx, &types.EventTaskApproved{
+		TaskId:    id,
+		Claimant:  actors.claimant,
+		Amount:    testBounty,
+		OldStatus: types.TASK_STATUS_SUBMITTED,
+		NewStatus: types.TASK_STATUS_APPROVED,
+		Attempt:   1,
+	})
+
+	// the approved task cannot be reviewed anymore
+	_, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[2], id, types.REVIEW_DECISION_ENDORSE, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestTaskMsgServerReviewReject(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	reviewers := newTestReviewers(t, f, 3)
+
+	id := createReviewedTask(t, f, srv, actors, reviewers)
+
+	_, err := srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[0], id, types.REVIEW_DECISION_ENDORSE, ""))
+	require.NoError(t, err)
+	_, err = srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[1], id, types.REVIEW_DECISION_REJECT, "missing tests"))
+	require.NoError(t, err)
+
+	resp, err := srv.ReviewTask(f.ctx, types.NewMsgReviewTask(reviewers[2], id, types.REVIEW_DECISION_REJECT, "breaks the build"))
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_REJECTED, resp.Status)
+
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_REJECTED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
@@ -45,12 +45,42 @@ func (k msgServer) CreateTask(ctx context.Context, msg *types.MsgCreateTask) (*t
 		Approver:    "",
 		CreatedAt:   currentTime,
 		UpdatedAt:   currentTime,
+
+		TaskExpiry:         msg.TaskExpiry,
+		ClaimDeadline:      msg.ClaimDeadline,
+		SubmissionDeadline: msg.SubmissionDeadline,
+		Reviewers:          msg.Reviewers,
+		Approvers:          msg.Approvers,
+		FeeAllowance:       msg.FeeAllowance,
+	}
+	if msg.Approver != "" {
+		task.Approvers = append([]string{msg.Approver}, msg.Approvers...)
 	}
 
 	// Validate the task
 	if err := task.Validate(params); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := task.ValidateDeadlines(params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := task.ValidateReviewers(params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := task.ValidateApprovers(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := task.ValidateFeeAllowance(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(task.FeeAllowance) > 0 && k.feeGrantKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowances are not supported")
+	}
+
+	// Lock the bounty in the module account until the task is paid out or refunded
+	if err := k.escrowBounty(ctx, msg.Creator, msg.Bounty); err != nil {
+		return nil, err
+	}
 
 	if err = k.Task.Set(
 		ctx,
@@ -60,6 +90,23 @@ func (k msgServer) CreateTask(ctx context.Context, msg *types.MsgCreateTask) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set task")
 	}
 
+	if err := k.scheduleDeadline(ctx, task, params); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordTransition(ctx, task.Id, types.TASK_STATUS_UNDEFINED, task.Status, msg.Creator, ""); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &types.EventTaskCreated{
+		TaskId:  task.Id,
+		Creator: task.Creator,
+		Bounty:  task.Bounty,
+		Status:  task.Status,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateTaskResponse{
 		Id: nextId,
 	}, nil
@@ -91,6 +138,11 @@ func (k msgServer) UpdateTask(ctx context.Context, msg *types.MsgUpdateTask) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// The escrow of a disputed task is frozen until an arbiter resolves it
+	if val.Status == types.TASK_STATUS_DISPUTED {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot update a disputed task")
+	}
+
 	// Get current timestamp
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentTime := sdkCtx.BlockTime().Unix()
@@ -108,10 +160,21 @@ func (k msgServer) UpdateTask(ctx context.Context, msg *types.MsgUpdateTask) (*t
 		Approver:    val.Approver,
 		CreatedAt:   val.CreatedAt,
 		UpdatedAt:   currentTime,
+
+		TaskExpiry:         val.TaskExpiry,
+		ClaimDeadline:      val.ClaimDeadline,
+		SubmissionDeadline: val.SubmissionDeadline,
+		Reviewers:          val.Reviewers,
+		Attempt:            val.Attempt,
+		Approvers:          val.Approvers,
+		FeeAllowance:       val.FeeAllowance,
+		Unescrowed:         val.Unescrowed,
+		ClaimedAt:          val.ClaimedAt,
+		SubmittedAt:        val.SubmittedAt,
 	}
 
 	// Validate the status transition
-	if !types.IsValidTransition(val.Status, task.Status) {
+	if task.Status != val.Status && !types.IsValidTransition(val.Status, task.Status) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid status transition from %s to %s", types.TaskStatusToString(val.Status), types.TaskStatusToString(task.Status)))
 	}
 
@@ -120,10 +183,44 @@ func (k msgServer) UpdateTask(ctx context.Context, msg *types.MsgUpdateTask) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// The bounty of an approved task has been paid and the bounty of a rejected
+	// or closed task is waiting on a dispute or refunded, so only tasks still
+	// being worked on can change it
+	if !task.Bounty.Equal(val.Bounty) {
+		if val.Unescrowed {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot change the bounty of a task created before escrow")
+		}
+		switch val.Status {
+		case types.TASK_STATUS_OPEN, types.TASK_STATUS_CLAIMED, types.TASK_STATUS_SUBMITTED:
+		default:
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot change the bounty of a %s task", types.TaskStatusToString(val.Status)))
+		}
+	}
+
+	// The escrowed bounty can be topped up, also with coins of new denoms, but
+	// no denom can be lowered or removed through an update
+	if !val.Bounty.IsAllLTE(task.Bounty) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("bounty cannot be lowered below the escrowed %s", val.Bounty))
+	}
+	if topUp := task.Bounty.Sub(val.Bounty...); !topUp.Empty() {
+		if err := k.escrowBounty(ctx, msg.Creator, topUp); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.Task.Set(ctx, msg.Id, task); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
 	}
 
+	if err := emitEvent(ctx, &types.EventTaskUpdated{
+		TaskId:    task.Id,
+		Creator:   task.Creator,
+		OldBounty: val.Bounty,
+		NewBounty: task.Bounty,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateTaskResponse{}, nil
 }
 
@@ -152,9 +249,30 @@ func (k msgServer) DeleteTask(ctx context.Context, msg *types.MsgDeleteTask) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot delete task in %s status", types.TaskStatusToString(val.Status)))
 	}
 
+	// Closed tasks have already released their escrow, open ones are refunded here
+	if val.Status == types.TASK_STATUS_OPEN {
+		if _, err := k.refundTask(ctx, val, types.RefundReasonDeleted); err != nil {
+			return nil, err
+		}
+	}
+
+	// the history of a deleted task is kept as its audit trail, recorded while
+	// the task can still be reported to its remote senders
+	if err := k.recordTransition(ctx, val.Id, val.Status, types.TASK_STATUS_UNDEFINED, msg.Creator, types.RefundReasonDeleted); err != nil {
+		return nil, err
+	}
+
 	if err := k.Task.Remove(ctx, msg.Id); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete task")
 	}
 
+	if err := emitEvent(ctx, &types.EventTaskDeleted{
+		TaskId:    val.Id,
+		Creator:   val.Creator,
+		OldStatus: val.Status,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDeleteTaskResponse{}, nil
 }
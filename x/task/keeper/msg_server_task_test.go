@@ -3,38 +3,83 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	"taskbounty/x/task/keeper"
 	"taskbounty/x/task/types"
 )
 
+var testBounty = sdk.NewCoins(sdk.NewInt64Coin("stake", 5000))
+
+func newTestMsgCreateTask(creator string) *types.MsgCreateTask {
+	return types.NewMsgCreateTask(creator, "Fix login bug", "Users cannot sign in with SSO", testBounty)
+}
+
+func newTestMsgUpdateTask(creator string, id uint64, bounty sdk.Coins) *types.MsgUpdateTask {
+	return types.NewMsgUpdateTask(creator, id, "Fix login bug", "Users cannot sign in with SSO or passkeys", bounty)
+}
+
 func TestTaskMsgServerCreate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	creatorAddr := sdk.AccAddress([]byte("signerAddr__________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
 	require.NoError(t, err)
+	f.bankKeeper.fund(creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 5*testBounty.AmountOf("stake").Int64())))
 
 	for i := 0; i < 5; i++ {
-		resp, err := srv.CreateTask(f.ctx, &types.MsgCreateTask{Creator: creator})
+		resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(creator))
 		require.NoError(t, err)
 		require.Equal(t, i, int(resp.Id))
 	}
 }
 
+func TestTaskMsgServerCreateEscrow(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creatorAddr := sdk.AccAddress([]byte("signerAddr__________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
+	require.NoError(t, err)
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		f.bankKeeper.fund(creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+		_, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(creator))
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+		_, err = f.keeper.Task.Get(f.ctx, 0)
+		require.Error(t, err)
+		require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+	})
+
+	t.Run("bounty is escrowed", func(t *testing.T) {
+		f.bankKeeper.fund(creatorAddr, testBounty)
+
+		_, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(creator))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balance(creatorAddr))
+		require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+	})
+}
+
 func TestTaskMsgServerUpdate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	creatorAddr := sdk.AccAddress([]byte("signerAddr__________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
 	require.NoError(t, err)
+	f.bankKeeper.fund(creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 8000)))
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{Creator: creator})
+	_, err = srv.CreateTask(f.ctx, newTestMsgCreateTask(creator))
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -49,17 +94,27 @@ func TestTaskMsgServerUpdate(t *testing.T) {
 		},
 		{
 			desc:    "unauthorized",
-			request: &types.MsgUpdateTask{Creator: unauthorizedAddr},
+			request: newTestMsgUpdateTask(unauthorizedAddr, 0, testBounty),
 			err:     sdkerrors.ErrUnauthorized,
 		},
 		{
 			desc:    "key not found",
-			request: &types.MsgUpdateTask{Creator: creator, Id: 10},
+			request: newTestMsgUpdateTask(creator, 10, testBounty),
 			err:     sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc:    "bounty lowered",
+			request: newTestMsgUpdateTask(creator, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 4000))),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "bounty top-up exceeds balance",
+			request: newTestMsgUpdateTask(creator, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 9000))),
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
 		{
 			desc:    "completed",
-			request: &types.MsgUpdateTask{Creator: creator},
+			request: newTestMsgUpdateTask(creator, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 7000))),
 		},
 	}
 	for _, tc := range tests {
@@ -72,19 +127,93 @@ func TestTaskMsgServerUpdate(t *testing.T) {
 			}
 		})
 	}
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7000)), f.bankKeeper.moduleBalance(types.ModuleName))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balance(creatorAddr))
+
+	requireEvent(t, f.ctx, &types.EventTaskUpdated{
+		TaskId:    0,
+		Creator:   creator,
+		OldBounty: testBounty,
+		NewBounty: sdk.NewCoins(sdk.NewInt64Coin("stake", 7000)),
+	})
+}
+
+func TestTaskMsgServerUpdateSettledBounty(t *testing.T) {
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("stake", 10000))
+
+	tests := []struct {
+		desc   string
+		settle func(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, id uint64)
+		status types.TaskStatus
+	}{
+		{
+			desc: "approved",
+			settle: func(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, id uint64) {
+				_, err := srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+				require.NoError(t, err)
+			},
+			status: types.TASK_STATUS_APPROVED,
+		},
+		{
+			desc: "rejected",
+			settle: func(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, id uint64) {
+				_, err := srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, id, "missing tests"))
+				require.NoError(t, err)
+			},
+			status: types.TASK_STATUS_REJECTED,
+		},
+		{
+			desc: "closed",
+			settle: func(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors, id uint64) {
+				_, err := srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, id))
+				require.NoError(t, err)
+				_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, id))
+				require.NoError(t, err)
+			},
+			status: types.TASK_STATUS_CLOSED,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := initFixture(t)
+			srv := keeper.NewMsgServerImpl(f.keeper)
+			actors := newTaskActors(t, f)
+
+			id := createSubmittedTask(t, f, srv, actors)
+			tc.settle(t, f, srv, actors, id)
+			moduleBalance := f.bankKeeper.moduleBalance(types.ModuleName)
+
+			f.bankKeeper.fund(actors.creatorAddr, topUp)
+			_, err := srv.UpdateTask(f.ctx, newTestMsgUpdateTask(actors.creator, id, testBounty.Add(topUp...)))
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			require.Equal(t, moduleBalance, f.bankKeeper.moduleBalance(types.ModuleName))
+
+			// the title and description can still be changed
+			_, err = srv.UpdateTask(f.ctx, newTestMsgUpdateTask(actors.creator, id, testBounty))
+			require.NoError(t, err)
+
+			task, err := f.keeper.Task.Get(f.ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, task.Status)
+			require.Equal(t, testBounty, task.Bounty)
+		})
+	}
 }
 
 func TestTaskMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	creatorAddr := sdk.AccAddress([]byte("signerAddr__________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
 	require.NoError(t, err)
+	f.bankKeeper.fund(creatorAddr, testBounty)
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{Creator: creator})
+	_, err = srv.CreateTask(f.ctx, newTestMsgCreateTask(creator))
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -122,4 +251,154 @@ func TestTaskMsgServerDelete(t *testing.T) {
 			}
 		})
 	}
+
+	// the escrowed bounty went back to the creator and the refund was recorded
+	require.Equal(t, testBounty, f.bankKeeper.balance(creatorAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	refund, err := f.keeper.TaskRefund.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, creator, refund.Creator)
+	require.Equal(t, testBounty, refund.Amount)
+	require.Equal(t, types.RefundReasonDeleted, refund.Reason)
+
+	requireEvent(t, f.ctx, &types.EventTaskDeleted{
+		TaskId:    0,
+		Creator:   creator,
+		OldStatus: types.TASK_STATUS_OPEN,
+	})
+	requireEvent(t, f.ctx, &types.EventTaskRefunded{
+		TaskId:  0,
+		Creator: creator,
+		Amount:  testBounty,
+		Reason:  types.RefundReasonDeleted,
+	})
+}
+
+func TestTaskMsgServerCreateDeadlines(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	creatorAddr := sdk.AccAddress([]byte("signerAddr__________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
+	require.NoError(t, err)
+	f.bankKeeper.fund(creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10*testBounty.AmountOf("stake").Int64())))
+
+	tests := []struct {
+		desc  string
+		apply func(msg *types.MsgCreateTask)
+		err   error
+	}{
+		{
+			desc:  "task expiry below min",
+			apply: func(msg *types.MsgCreateTask) { msg.TaskExpiry = params.MinTaskExpiry - 1 },
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:  "task expiry above max",
+			apply: func(msg *types.MsgCreateTask) { msg.TaskExpiry = params.MaxTaskExpiry + 1 },
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:  "claim deadline above max",
+			apply: func(msg *types.MsgCreateTask) { msg.ClaimDeadline = params.MaxClaimDeadline + 1 },
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:  "submission deadline below min",
+			apply: func(msg *types.MsgCreateTask) { msg.SubmissionDeadline = 1 },
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "within bounds",
+			apply: func(msg *types.MsgCreateTask) {
+				msg.TaskExpiry = 3600
+				msg.ClaimDeadline = 7200
+				msg.SubmissionDeadline = 86400
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := newTestMsgCreateTask(creator)
+			tc.apply(msg)
+
+			resp, err := srv.CreateTask(f.ctx, msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			task, err := f.keeper.Task.Get(f.ctx, resp.Id)
+			require.NoError(t, err)
+			require.Equal(t, msg.TaskExpiry, task.TaskExpiry)
+			require.Equal(t, msg.ClaimDeadline, task.ClaimDeadline)
+			require.Equal(t, msg.SubmissionDeadline, task.SubmissionDeadline)
+		})
+	}
+}
+
+// testIBCDenom returns the voucher denom of uatom received over channel-0,
+// whose denom trace the mocked transfer keeper knows.
+func testIBCDenom(f *fixture) string {
+	return f.transferKeeper.receive(ibctransfertypes.NewDenom("uatom", ibctransfertypes.NewHop(ibctransfertypes.PortID, "channel-0")))
+}
+
+func TestTaskMsgServerIBCBounty(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	ibcDenom := testIBCDenom(f)
+	bounty := sdk.NewInt64Coin(ibcDenom, 2500)
+	f.bankKeeper.fund(actors.creatorAddr, sdk.NewCoins(bounty.Add(bounty)))
+
+	// only allowlisted denoms can pay bounties
+	_, err := srv.CreateTask(f.ctx, types.NewMsgCreateTask(actors.creator, "Fix login bug", "Users cannot sign in with SSO", sdk.NewCoins(bounty)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+
+	// vouchers never received over a transfer channel have no denom trace
+	unknownDenom := ibctransfertypes.NewDenom("uosmo", ibctransfertypes.NewHop(ibctransfertypes.PortID, "channel-9")).IBCDenom()
+	params.BountyDenoms = []types.BountyDenom{{
+		MinBounty: sdk.NewInt64Coin(unknownDenom, 1000),
+		MaxBounty: sdk.NewInt64Coin(unknownDenom, 3000),
+	}}
+	_, err = srv.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	params.BountyDenoms = []types.BountyDenom{{
+		MinBounty: sdk.NewInt64Coin(ibcDenom, 1000),
+		MaxBounty: sdk.NewInt64Coin(ibcDenom, 3000),
+	}}
+	_, err = srv.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// the bounds of the denom apply, not those of the native denom
+	_, err = srv.CreateTask(f.ctx, types.NewMsgCreateTask(actors.creator, "Fix login bug", "Users cannot sign in with SSO", sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 5000))))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	paid, err := srv.CreateTask(f.ctx, types.NewMsgCreateTask(actors.creator, "Fix login bug", "Users cannot sign in with SSO", sdk.NewCoins(bounty)))
+	require.NoError(t, err)
+	refunded, err := srv.CreateTask(f.ctx, types.NewMsgCreateTask(actors.creator, "Fix login bug", "Users cannot sign in with SSO", sdk.NewCoins(bounty)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(bounty.Add(bounty)), f.bankKeeper.moduleBalance(types.ModuleName))
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, paid.Id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, paid.Id, newTestProof(f)))
+	require.NoError(t, err)
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, paid.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(bounty), f.bankKeeper.balance(actors.claimantAddr))
+
+	_, err = srv.CloseTask(f.ctx, types.NewMsgCloseTask(actors.creator, refunded.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(bounty), f.bankKeeper.balance(actors.creatorAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
 }
@@ -3,8 +3,12 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	"taskbounty/x/task/types"
 )
@@ -24,9 +28,32 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := k.validateBountyDenomTraces(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// validateBountyDenomTraces checks that the IBC vouchers allowed as bounties
+// were received over a transfer channel, so that their denom trace is known.
+func (k Keeper) validateBountyDenomTraces(ctx context.Context, params types.Params) error {
+	for _, bountyDenom := range params.BountyDenoms {
+		denom := bountyDenom.MinBounty.Denom
+		if !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+			continue
+		}
+		if k.transferKeeperFn == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bounty denom %s needs IBC transfer", denom)
+		}
+		if _, err := k.transferKeeperFn().GetDenomFromIBCDenom(sdk.UnwrapSDKContext(ctx), denom); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown denom trace of bounty denom %s: %s", denom, err)
+		}
+	}
+
+	return nil
+}
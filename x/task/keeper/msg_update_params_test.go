@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"taskbounty/x/task/keeper"
@@ -19,6 +20,18 @@ func TestMsgUpdateParams(t *testing.T) {
 	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
 	require.NoError(t, err)
 
+	withBountyDenoms := func(bountyDenoms ...types.BountyDenom) types.Params {
+		p := params
+		p.BountyDenoms = bountyDenoms
+		return p
+	}
+	withDeadlineLimits := func(perBlock, retries uint32) types.Params {
+		p := params
+		p.MaxDeadlinesPerBlock, p.MaxDeadlineRetries = perBlock, retries
+		return p
+	}
+	ibcDenom := testIBCDenom(f)
+
 	// default params
 	testCases := []struct {
 		name      string
@@ -36,11 +49,65 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "empty params",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
+			expErr:    true,
+			expErrMsg: "min bounty must be positive",
+		},
+		{
+			name: "bounty denom with inverted bounds",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withBountyDenoms(types.BountyDenom{MinBounty: sdk.NewInt64Coin(ibcDenom, 500), MaxBounty: sdk.NewInt64Coin(ibcDenom, 100)}),
+			},
+			expErr:    true,
+			expErrMsg: "min bounty cannot be greater than max bounty",
+		},
+		{
+			name: "bounty denom already allowed",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withBountyDenoms(types.BountyDenom{MinBounty: params.MinBounty, MaxBounty: params.MaxBounty}),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate bounty denom",
+		},
+		{
+			name: "ibc denom without a trace hash",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withBountyDenoms(types.BountyDenom{MinBounty: sdk.NewInt64Coin("ibc/uatom", 1), MaxBounty: sdk.NewInt64Coin("ibc/uatom", 10)}),
+			},
+			expErr:    true,
+			expErrMsg: "invalid denom trace hash",
+		},
+		{
+			name: "no deadlines per block",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withDeadlineLimits(0, params.MaxDeadlineRetries),
+			},
+			expErr:    true,
+			expErrMsg: "max deadlines per block must be positive",
+		},
+		{
+			name: "no deadline retries",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withDeadlineLimits(params.MaxDeadlinesPerBlock, 0),
+			},
+			expErr:    true,
+			expErrMsg: "max deadline retries must be positive",
+		},
+		{
+			name: "ibc bounty denom",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withBountyDenoms(types.BountyDenom{MinBounty: sdk.NewInt64Coin(ibcDenom, 100), MaxBounty: sdk.NewInt64Coin(ibcDenom, 10000)}),
+			},
 			expErr: false,
 		},
 		{
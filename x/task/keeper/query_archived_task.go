@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetArchivedTask(ctx context.Context, req *types.QueryGetArchivedTaskRequest) (*types.QueryGetArchivedTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	task, err := q.k.ArchivedTask.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetArchivedTaskResponse{Task: task}, nil
+}
+
+func (q queryServer) ListArchivedTask(ctx context.Context, req *types.QueryAllArchivedTaskRequest) (*types.QueryAllArchivedTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tasks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ArchivedTask,
+		req.Pagination,
+		func(_ uint64, value types.Task) (types.Task, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllArchivedTaskResponse{Task: tasks, Pagination: pageRes}, nil
+}
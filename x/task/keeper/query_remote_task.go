@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetRemoteTask(ctx context.Context, req *types.QueryGetRemoteTaskRequest) (*types.QueryGetRemoteTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	remoteTask, err := q.k.RemoteTask.Get(ctx, collections.Join(req.ChannelId, req.TaskId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRemoteTaskResponse{RemoteTask: remoteTask}, nil
+}
+
+func (q queryServer) ListRemoteTask(ctx context.Context, req *types.QueryAllRemoteTaskRequest) (*types.QueryAllRemoteTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	remoteTasks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RemoteTask,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.RemoteTask) (types.RemoteTask, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRemoteTaskResponse{RemoteTask: remoteTasks, Pagination: pageRes}, nil
+}
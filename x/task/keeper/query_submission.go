@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetSubmission(ctx context.Context, req *types.QueryGetSubmissionRequest) (*types.QueryGetSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	submission, err := q.k.Submission.Get(ctx, collections.Join(req.Id, req.Attempt))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetSubmissionResponse{Submission: submission}, nil
+}
+
+func (q queryServer) ListSubmission(ctx context.Context, req *types.QueryAllSubmissionRequest) (*types.QueryAllSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	submissions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Submission,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.Submission) (types.Submission, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSubmissionResponse{Submission: submissions, Pagination: pageRes}, nil
+}
@@ -8,7 +8,6 @@ import (
 
 	"cosmossdk.io/collections"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,15 +17,24 @@ func (q queryServer) ListTask(ctx context.Context, req *types.QueryAllTaskReques
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	tasks, pageRes, err := query.CollectionPaginate(
-		ctx,
-		q.k.Task,
-		req.Pagination,
-		func(_ uint64, value types.Task) (types.Task, error) {
-			return value, nil
-		},
+	var (
+		filter types.TaskFilter
+		by     types.TaskSort
 	)
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	if req.Sort != nil {
+		by = *req.Sort
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := by.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
+	tasks, pageRes, err := q.k.listTasks(ctx, filter, by, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListTaskDispute(ctx context.Context, req *types.QueryAllTaskDisputeRequest) (*types.QueryAllTaskDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	disputes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TaskDispute,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.TaskDispute) (types.TaskDispute, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTaskDisputeResponse{TaskDispute: disputes, Pagination: pageRes}, nil
+}
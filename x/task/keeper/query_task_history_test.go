@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestTaskHistory(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	id := resp.Id
+
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+
+	// the claimant misses the claim deadline and the chain reopens the task
+	ctx := afterSeconds(f, params.ClaimDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	_, err = srv.ClaimTask(ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+	_, err = srv.SubmitTask(ctx, types.NewMsgSubmitTask(actors.claimant, id, newTestProof(f)))
+	require.NoError(t, err)
+	_, err = srv.RejectTask(ctx, types.NewMsgRejectTask(actors.creator, id, "missing tests"))
+	require.NoError(t, err)
+
+	created := sdk.UnwrapSDKContext(f.ctx).BlockTime().Unix()
+	reopened := ctx.BlockTime().Unix()
+	expected := []types.TaskTransition{
+		{TaskId: id, Seq: 0, From: types.TASK_STATUS_UNDEFINED, To: types.TASK_STATUS_OPEN, Actor: actors.creator, Timestamp: created},
+		{TaskId: id, Seq: 1, From: types.TASK_STATUS_OPEN, To: types.TASK_STATUS_CLAIMED, Actor: actors.claimant, Timestamp: created},
+		{TaskId: id, Seq: 2, From: types.TASK_STATUS_CLAIMED, To: types.TASK_STATUS_OPEN, Timestamp: reopened, Reason: "claim deadline passed"},
+		{TaskId: id, Seq: 3, From: types.TASK_STATUS_OPEN, To: types.TASK_STATUS_CLAIMED, Actor: actors.claimant, Timestamp: reopened},
+		{TaskId: id, Seq: 4, From: types.TASK_STATUS_CLAIMED, To: types.TASK_STATUS_SUBMITTED, Actor: actors.claimant, Timestamp: reopened, Reason: "attempt 1"},
+		{TaskId: id, Seq: 5, From: types.TASK_STATUS_SUBMITTED, To: types.TASK_STATUS_REJECTED, Actor: actors.creator, Timestamp: reopened, Reason: "missing tests"},
+	}
+
+	t.Run("All", func(t *testing.T) {
+		history, err := qs.TaskHistory(ctx, &types.QueryTaskHistoryRequest{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, expected, history.TaskTransition)
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		var (
+			got  []types.TaskTransition
+			next []byte
+		)
+		for {
+			history, err := qs.TaskHistory(ctx, &types.QueryTaskHistoryRequest{Id: id, Pagination: &query.PageRequest{Key: next, Limit: 4}})
+			require.NoError(t, err)
+			got = append(got, history.TaskTransition...)
+			next = history.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, expected, got)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.TaskHistory(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestTaskHistoryOutlivesDeletion(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+	_, err = srv.DeleteTask(f.ctx, &types.MsgDeleteTask{Creator: actors.creator, Id: resp.Id})
+	require.NoError(t, err)
+
+	history, err := qs.TaskHistory(f.ctx, &types.QueryTaskHistoryRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, history.TaskTransition, 2)
+
+	deleted := history.TaskTransition[1]
+	require.Equal(t, types.TASK_STATUS_OPEN, deleted.From)
+	require.Equal(t, types.TASK_STATUS_UNDEFINED, deleted.To)
+	require.Equal(t, actors.creator, deleted.Actor)
+	require.Equal(t, types.RefundReasonDeleted, deleted.Reason)
+}
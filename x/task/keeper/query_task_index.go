@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"taskbounty/x/task/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) TasksByCreator(ctx context.Context, req *types.QueryTasksByCreatorRequest) (*types.QueryTasksByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+	}
+
+	ids, pageRes, err := paginateIndex(ctx, q.k.Task.Indexes.Creator, req.Creator, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tasks, err := q.k.getTasks(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTasksByCreatorResponse{Task: tasks, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TasksByClaimant(ctx context.Context, req *types.QueryTasksByClaimantRequest) (*types.QueryTasksByClaimantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Claimant); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid claimant address")
+	}
+
+	ids, pageRes, err := paginateIndex(ctx, q.k.Task.Indexes.Claimant, req.Claimant, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tasks, err := q.k.getTasks(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTasksByClaimantResponse{Task: tasks, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TasksByStatus(ctx context.Context, req *types.QueryTasksByStatusRequest) (*types.QueryTasksByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.TaskStatus_name[int32(req.Status)]; !ok || req.Status == types.TASK_STATUS_UNDEFINED {
+		return nil, status.Error(codes.InvalidArgument, "invalid task status")
+	}
+
+	ids, pageRes, err := paginateIndex(ctx, q.k.Task.Indexes.Status, int32(req.Status), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tasks, err := q.k.getTasks(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTasksByStatusResponse{Task: tasks, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RewardsByClaimant(ctx context.Context, req *types.QueryRewardsByClaimantRequest) (*types.QueryRewardsByClaimantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Claimant); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid claimant address")
+	}
+
+	ids, pageRes, err := paginateIndex(ctx, q.k.TaskReward.Indexes.Claimant, req.Claimant, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rewards := make([]types.TaskReward, 0, len(ids))
+	for _, id := range ids {
+		reward, err := q.k.TaskReward.Get(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		rewards = append(rewards, reward)
+	}
+
+	return &types.QueryRewardsByClaimantResponse{TaskReward: rewards, Pagination: pageRes}, nil
+}
+
+// getTasks returns the tasks with the given ids, in order.
+func (k Keeper) getTasks(ctx context.Context, ids []uint64) ([]types.Task, error) {
+	tasks := make([]types.Task, 0, len(ids))
+	for _, id := range ids {
+		task, err := k.Task.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestTasksByCreatorPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.AccAddress([]byte("aliceAddr___________________")).String()
+	bob := sdk.AccAddress([]byte("bobAddr_____________________")).String()
+
+	var aliceTasks []types.Task
+	for i := uint64(0); i < 7; i++ {
+		task := types.Task{Id: i, Creator: bob, Bounty: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Status: types.TASK_STATUS_OPEN}
+		if i%3 != 1 {
+			task.Creator = alice
+			aliceTasks = append(aliceTasks, task)
+		}
+		require.NoError(t, f.keeper.Task.Set(f.ctx, i, task))
+	}
+
+	request := func(next []byte, offset, limit uint64, total, reverse bool) *types.QueryTasksByCreatorRequest {
+		return &types.QueryTasksByCreatorRequest{
+			Creator: alice,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+				Reverse:    reverse,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		var got []types.Task
+		for i := 0; i < len(aliceTasks); i += step {
+			resp, err := qs.TasksByCreator(f.ctx, request(nil, uint64(i), uint64(step), false, false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Task), step)
+			got = append(got, resp.Task...)
+		}
+		require.Equal(t, aliceTasks, got)
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var (
+			got  []types.Task
+			next []byte
+		)
+		for {
+			resp, err := qs.TasksByCreator(f.ctx, request(next, 0, uint64(step), false, false))
+			require.NoError(t, err)
+			got = append(got, resp.Task...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, aliceTasks, got)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		resp, err := qs.TasksByCreator(f.ctx, request(nil, 0, 2, false, true))
+		require.NoError(t, err)
+		require.Equal(t, []types.Task{aliceTasks[4], aliceTasks[3]}, resp.Task)
+
+		resp, err = qs.TasksByCreator(f.ctx, request(resp.Pagination.NextKey, 0, 2, false, true))
+		require.NoError(t, err)
+		require.Equal(t, []types.Task{aliceTasks[2], aliceTasks[1]}, resp.Task)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.TasksByCreator(f.ctx, request(nil, 0, 0, true, false))
+		require.NoError(t, err)
+		require.Equal(t, len(aliceTasks), int(resp.Pagination.Total))
+		require.Equal(t, aliceTasks, resp.Task)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.TasksByCreator(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+		_, err = qs.TasksByCreator(f.ctx, &types.QueryTasksByCreatorRequest{Creator: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestTaskIndexesFollowUpdates(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________________")).String()
+	claimant := sdk.AccAddress([]byte("claimantAddr________________")).String()
+
+	task := types.Task{Id: 0, Creator: creator, Bounty: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Status: types.TASK_STATUS_OPEN}
+	require.NoError(t, f.keeper.Task.Set(f.ctx, task.Id, task))
+
+	byStatus := func(status types.TaskStatus) []types.Task {
+		resp, err := qs.TasksByStatus(f.ctx, &types.QueryTasksByStatusRequest{Status: status})
+		require.NoError(t, err)
+		return resp.Task
+	}
+	byClaimant := func() []types.Task {
+		resp, err := qs.TasksByClaimant(f.ctx, &types.QueryTasksByClaimantRequest{Claimant: claimant})
+		require.NoError(t, err)
+		return resp.Task
+	}
+
+	require.Equal(t, []types.Task{task}, byStatus(types.TASK_STATUS_OPEN))
+	require.Empty(t, byClaimant())
+
+	// claiming the task moves it between status indexes and into the claimant index
+	task.Status = types.TASK_STATUS_CLAIMED
+	task.Claimant = claimant
+	require.NoError(t, f.keeper.Task.Set(f.ctx, task.Id, task))
+
+	require.Empty(t, byStatus(types.TASK_STATUS_OPEN))
+	require.Equal(t, []types.Task{task}, byStatus(types.TASK_STATUS_CLAIMED))
+	require.Equal(t, []types.Task{task}, byClaimant())
+
+	// removing the task drops it from every index
+	require.NoError(t, f.keeper.Task.Remove(f.ctx, task.Id))
+	require.Empty(t, byStatus(types.TASK_STATUS_CLAIMED))
+	require.Empty(t, byClaimant())
+
+	_, err := qs.TasksByStatus(f.ctx, &types.QueryTasksByStatusRequest{Status: types.TASK_STATUS_UNDEFINED})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTasksByApprover(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________________")).String()
+	lead := sdk.AccAddress([]byte("leadAddr____________________")).String()
+	reviewer := sdk.AccAddress([]byte("reviewerAddr________________")).String()
+
+	byApprover := func(approver string) []types.Task {
+		resp, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Filter: &types.TaskFilter{Approver: approver}})
+		require.NoError(t, err)
+		return resp.Task
+	}
+
+	// an open task is listed under each approver it is waiting on
+	task := types.Task{
+		Id:        0,
+		Creator:   creator,
+		Bounty:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Status:    types.TASK_STATUS_OPEN,
+		Approvers: []string{lead, reviewer},
+	}
+	require.NoError(t, f.keeper.Task.Set(f.ctx, task.Id, task))
+	require.Equal(t, []types.Task{task}, byApprover(lead))
+	require.Equal(t, []types.Task{task}, byApprover(reviewer))
+	require.Empty(t, byApprover(creator))
+
+	// the approver that approved the task is listed once, with the others kept
+	task.Status = types.TASK_STATUS_APPROVED
+	task.Approver = lead
+	require.NoError(t, f.keeper.Task.Set(f.ctx, task.Id, task))
+	require.Equal(t, []types.Task{task}, byApprover(lead))
+	require.Equal(t, []types.Task{task}, byApprover(reviewer))
+
+	// removing the task drops it from the index
+	require.NoError(t, f.keeper.Task.Remove(f.ctx, task.Id))
+	require.Empty(t, byApprover(lead))
+	require.Empty(t, byApprover(reviewer))
+}
+
+func TestRewardsByClaimant(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.AccAddress([]byte("aliceAddr___________________")).String()
+	bob := sdk.AccAddress([]byte("bobAddr_____________________")).String()
+
+	var aliceRewards []types.TaskReward
+	for i := uint64(0); i < 5; i++ {
+		reward := types.TaskReward{TaskId: i, Claimant: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+		if i%2 == 0 {
+			reward.Claimant = alice
+			aliceRewards = append(aliceRewards, reward)
+		}
+		require.NoError(t, f.keeper.TaskReward.Set(f.ctx, i, reward))
+	}
+
+	resp, err := qs.RewardsByClaimant(f.ctx, &types.QueryRewardsByClaimantRequest{
+		Claimant:   alice,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, aliceRewards[:2], resp.TaskReward)
+	require.Equal(t, uint64(len(aliceRewards)), resp.Pagination.Total)
+
+	resp, err = qs.RewardsByClaimant(f.ctx, &types.QueryRewardsByClaimantRequest{
+		Claimant:   alice,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, aliceRewards[2:], resp.TaskReward)
+	require.Nil(t, resp.Pagination.NextKey)
+
+	all, err := qs.GetTaskRewardsByClaimant(f.ctx, &types.QueryGetTaskRewardsByClaimantRequest{Claimant: alice})
+	require.NoError(t, err)
+	require.Equal(t, aliceRewards, all.TaskRewards)
+}
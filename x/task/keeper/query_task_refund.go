@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetTaskRefund(ctx context.Context, req *types.QueryGetTaskRefundRequest) (*types.QueryGetTaskRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	refund, err := q.k.TaskRefund.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetTaskRefundResponse{TaskRefund: refund}, nil
+}
+
+func (q queryServer) ListTaskRefund(ctx context.Context, req *types.QueryAllTaskRefundRequest) (*types.QueryAllTaskRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	refunds, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TaskRefund,
+		req.Pagination,
+		func(_ uint64, value types.TaskRefund) (types.TaskRefund, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTaskRefundResponse{TaskRefund: refunds, Pagination: pageRes}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListTaskReview(ctx context.Context, req *types.QueryAllTaskReviewRequest) (*types.QueryAllTaskReviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reviews, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TaskReview,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], value types.TaskReview) (types.TaskReview, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTaskReviewResponse{TaskReview: reviews, Pagination: pageRes}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"taskbounty/x/task/types"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -58,14 +59,15 @@ func (q queryServer) GetTaskRewardsByClaimant(ctx context.Context, req *types.Qu
 
 	var rewards []types.TaskReward
 
-	// loop all rewards and filter by claimant
-	err := q.k.TaskReward.Walk(ctx, nil, func(key uint64, reward types.TaskReward) (bool, error) {
-		if reward.Claimant == req.Claimant {
-			rewards = append(rewards, reward)
-		}
-		return false, nil
+	// look the rewards up through the claimant index, see RewardsByClaimant for a paginated query
+	iter, err := q.k.TaskReward.Indexes.Claimant.MatchExact(ctx, req.Claimant)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = indexes.ScanValues(ctx, q.k.TaskReward, iter, func(reward types.TaskReward) bool {
+		rewards = append(rewards, reward)
+		return false
 	})
-
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -23,8 +25,8 @@ func createNTask(keeper keeper.Keeper, ctx context.Context, n int) []types.Task
 		items[i].Id = iu
 		items[i].Title = strconv.Itoa(i)
 		items[i].Description = strconv.Itoa(i)
-		items[i].Bounty = sdk.NewInt64Coin(`token`, int64(i+100))
-		items[i].Status = int64(i)
+		items[i].Bounty = sdk.NewCoins(sdk.NewInt64Coin(`token`, int64(i+100)))
+		items[i].Status = types.TaskStatus(i)
 		items[i].Claimant = strconv.Itoa(i)
 		items[i].Proof = strconv.Itoa(i)
 		items[i].Approver = strconv.Itoa(i)
@@ -123,3 +125,198 @@ func TestTaskQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestTaskQueryBountyDenoms(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// summed over their denoms, the bounties would order tasks 0, 2, 3, 1
+	bounties := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 300), sdk.NewInt64Coin("uatom", 900)),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}
+	for i, bounty := range bounties {
+		require.NoError(t, f.keeper.Task.Set(f.ctx, uint64(i), types.Task{Id: uint64(i), Bounty: bounty, Status: types.TASK_STATUS_OPEN}))
+	}
+
+	// list pages through ListTask one task at a time and returns the ids listed
+	list := func(filter *types.TaskFilter, by *types.TaskSort) []uint64 {
+		var (
+			ids  []uint64
+			next []byte
+		)
+		for {
+			resp, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{
+				Filter:     filter,
+				Sort:       by,
+				Pagination: &query.PageRequest{Key: next, Limit: 1},
+			})
+			require.NoError(t, err)
+			for _, task := range resp.Task {
+				ids = append(ids, task.Id)
+			}
+			next = resp.Pagination.NextKey
+			if next == nil {
+				return ids
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		filter *types.TaskFilter
+		by     *types.TaskSort
+		ids    []uint64
+	}{
+		{
+			desc: "sorted by the min_bounty denom of params",
+			by:   &types.TaskSort{Field: types.SortFieldBounty},
+			ids:  []uint64{1, 0, 3},
+		},
+		{
+			desc: "sorted by the sort denom",
+			by:   &types.TaskSort{Field: types.SortFieldBounty, Direction: types.SortDesc, Denom: "uatom"},
+			ids:  []uint64{1, 2},
+		},
+		{
+			desc:   "sorted by the denom of the bounty range",
+			filter: &types.TaskFilter{MinBounty: sdk.NewInt64Coin("uatom", 50)},
+			by:     &types.TaskSort{Field: types.SortFieldBounty},
+			ids:    []uint64{2, 1},
+		},
+		{
+			desc:   "min bounty in its denom",
+			filter: &types.TaskFilter{MinBounty: sdk.NewInt64Coin("uatom", 200)},
+			ids:    []uint64{1},
+		},
+		{
+			desc:   "max bounty in its denom",
+			filter: &types.TaskFilter{MaxBounty: sdk.NewInt64Coin("stake", 600)},
+			ids:    []uint64{0, 1},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.ids, list(tc.filter, tc.by))
+		})
+	}
+
+	t.Run("InvalidDenoms", func(t *testing.T) {
+		_, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Filter: &types.TaskFilter{
+			MinBounty: sdk.NewInt64Coin("stake", 100),
+			MaxBounty: sdk.NewInt64Coin("uatom", 1000),
+		}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Sort: &types.TaskSort{Field: types.SortFieldBounty, Denom: "1"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestTaskQueryFilterSort(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.AccAddress([]byte("aliceAddr___________________")).String()
+	bob := sdk.AccAddress([]byte("bobAddr_____________________")).String()
+
+	// bounties and creation times deliberately out of id order, with ties
+	bounties := []int64{500, 100, 900, 100, 1_000_000_000_000_000_000, 300, 700, 900, 200}
+	var tasks []types.Task
+	for i, amount := range bounties {
+		task := types.Task{
+			Id:        uint64(i),
+			Creator:   alice,
+			Bounty:    sdk.NewCoins(sdk.NewInt64Coin("stake", 1).AddAmount(math.NewInt(amount - 1))),
+			Status:    types.TaskStatus(i%3 + 1),
+			CreatedAt: int64(100 - i%4),
+		}
+		if i%2 == 1 {
+			task.Creator = bob
+		}
+		require.NoError(t, f.keeper.Task.Set(f.ctx, task.Id, task))
+		tasks = append(tasks, task)
+	}
+
+	// list pages through ListTask by key and returns every task listed
+	list := func(filter *types.TaskFilter, by *types.TaskSort, limit uint64) []types.Task {
+		var (
+			got  []types.Task
+			next []byte
+		)
+		for {
+			resp, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{
+				Filter:     filter,
+				Sort:       by,
+				Pagination: &query.PageRequest{Key: next, Limit: limit},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Task), int(limit))
+			got = append(got, resp.Task...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				return got
+			}
+		}
+	}
+
+	// tasks sorted in memory, ties broken by id as the indexes do. The bounty
+	// sort denom defaults to the min_bounty denom of params.
+	expected := func(filter types.TaskFilter, by types.TaskSort) []types.Task {
+		if by.Denom == "" {
+			by.Denom = types.DefaultParams().MinBounty.Denom
+		}
+		sorted := types.SortTasks(types.FilterTasks(tasks, filter), types.TaskSort{Field: types.SortFieldID, Direction: by.Direction})
+		return types.SortTasks(sorted, by)
+	}
+
+	filters := []types.TaskFilter{
+		{},
+		{Creator: alice},
+		{Status: types.TASK_STATUS_CLAIMED},
+		{Creator: bob, Status: types.TASK_STATUS_OPEN},
+		{MinBounty: sdk.NewInt64Coin("stake", 200), MaxBounty: sdk.NewInt64Coin("stake", 900)},
+	}
+	for _, field := range []string{types.SortFieldID, types.SortFieldBounty, types.SortFieldStatus, types.SortFieldCreatedAt} {
+		for _, direction := range []string{types.SortAsc, types.SortDesc} {
+			for i, filter := range filters {
+				by := types.TaskSort{Field: field, Direction: direction}
+				t.Run(fmt.Sprintf("%s_%s_%d", field, direction, i), func(t *testing.T) {
+					require.Equal(t, expected(filter, by), list(&filter, &by, 2))
+				})
+			}
+		}
+	}
+
+	t.Run("ByOffset", func(t *testing.T) {
+		by := types.TaskSort{Field: types.SortFieldBounty, Direction: types.SortDesc}
+		resp, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{
+			Sort:       &by,
+			Pagination: &query.PageRequest{Offset: 1, Limit: 3, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected(types.TaskFilter{}, by)[1:4], resp.Task)
+		require.Equal(t, uint64(len(tasks)), resp.Pagination.Total)
+	})
+	t.Run("Unsorted", func(t *testing.T) {
+		require.Equal(t, tasks, list(nil, nil, 4))
+	})
+	t.Run("InvalidSort", func(t *testing.T) {
+		_, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Sort: &types.TaskSort{Field: "title"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Sort: &types.TaskSort{Direction: "up"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("InvalidFilter", func(t *testing.T) {
+		_, err := qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Filter: &types.TaskFilter{Creator: "invalid"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = qs.ListTask(f.ctx, &types.QueryAllTaskRequest{Filter: &types.TaskFilter{
+			MinBounty: sdk.NewInt64Coin("stake", 900),
+			MaxBounty: sdk.NewInt64Coin("stake", 200),
+		}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// latestSubmission returns the most recent submission of a task.
+func (k Keeper) latestSubmission(ctx context.Context, id uint64) (types.Submission, error) {
+	iter, err := k.Submission.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id).Descending())
+	if err != nil {
+		return types.Submission{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get submission")
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.Submission{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d has no submission", id))
+	}
+
+	submission, err := iter.Value()
+	if err != nil {
+		return types.Submission{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get submission")
+	}
+
+	return submission, nil
+}
+
+// recordSubmission stores a proof submitted by the claimant as the next attempt of the task.
+func (k Keeper) recordSubmission(ctx context.Context, task types.Task, proof types.TaskProof) (types.Submission, error) {
+	attempt := uint64(1)
+	latest, err := k.latestSubmission(ctx, task.Id)
+	switch {
+	case err == nil:
+		attempt = latest.Attempt + 1
+	case !errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return types.Submission{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	submission := types.Submission{
+		TaskId:      task.Id,
+		Attempt:     attempt,
+		Submitter:   task.Claimant,
+		Proof:       proof,
+		SubmittedAt: sdkCtx.BlockTime().Unix(),
+		BlockHeight: sdkCtx.BlockHeight(),
+		Outcome:     types.SUBMISSION_OUTCOME_PENDING,
+	}
+	if err := k.Submission.Set(ctx, collections.Join(task.Id, attempt), submission); err != nil {
+		return types.Submission{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store submission")
+	}
+
+	return submission, nil
+}
+
+// checkSubmissionAttempts returns an error once the submitter has used up the
+// MaxSubmissionAttempts of a task. Attempts by earlier claimants of a reopened
+// task do not count.
+func (k Keeper) checkSubmissionAttempts(ctx context.Context, id uint64, submitter string, params types.Params) error {
+	if params.MaxSubmissionAttempts == 0 {
+		return nil
+	}
+
+	var attempts uint32
+	err := k.Submission.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](id), func(_ collections.Pair[uint64, uint64], submission types.Submission) (bool, error) {
+		if submission.Submitter == submitter {
+			attempts++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to count submissions")
+	}
+
+	if attempts >= params.MaxSubmissionAttempts {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("maximum of %d submission attempts reached", params.MaxSubmissionAttempts))
+	}
+
+	return nil
+}
+
+// setSubmissionOutcome records the review outcome on the latest submission of
+// a task. Tasks without a submission record are left untouched.
+func (k Keeper) setSubmissionOutcome(ctx context.Context, id uint64, outcome types.SubmissionOutcome, reviewer, note string) error {
+	submission, err := k.latestSubmission(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	submission.Outcome = outcome
+	submission.Reviewer = reviewer
+	submission.ReviewNote = note
+	submission.ReviewedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if err := k.Submission.Set(ctx, collections.Join(submission.TaskId, submission.Attempt), submission); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store submission")
+	}
+
+	return nil
+}
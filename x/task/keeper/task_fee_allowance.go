@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"taskbounty/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// grantTaskFeeAllowance grants the fee allowance of a freshly claimed task to
+// its claimant, on behalf of the creator. Open tasks can be claimed by anyone,
+// so there is nobody to grant it to before the claim, whose fee the claimant
+// pays. A claimant holding the creator's
+// allowance for other tasks has the task added to it. A claimant the creator
+// already sponsors through an allowance of their own is left untouched.
+func (k Keeper) grantTaskFeeAllowance(ctx context.Context, task types.Task) error {
+	if len(task.FeeAllowance) == 0 || k.feeGrantKeeper == nil {
+		return nil
+	}
+
+	granter, err := k.addressCodec.StringToBytes(task.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
+	}
+	grantee, err := k.addressCodec.StringToBytes(task.Claimant)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid claimant address")
+	}
+
+	existing, err := k.feeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		// no allowance between creator and claimant yet
+		if err := k.feeGrantKeeper.GrantAllowance(ctx, granter, grantee, types.NewTaskFeeAllowance(task.Id, task.FeeAllowance)); err != nil {
+			return errorsmod.Wrap(err, "failed to grant fee allowance")
+		}
+	} else {
+		allowance, ok := existing.(*types.TaskFeeAllowance)
+		if !ok {
+			return nil
+		}
+		allowance.SetLimit(task.Id, task.FeeAllowance)
+		if err := k.feeGrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance); err != nil {
+			return errorsmod.Wrap(err, "failed to update fee allowance")
+		}
+	}
+
+	return emitEvent(ctx, &types.EventTaskFeeAllowanceGranted{
+		TaskId:     task.Id,
+		Granter:    task.Creator,
+		Grantee:    task.Claimant,
+		SpendLimit: task.FeeAllowance,
+	})
+}
+
+// revokeTaskFeeAllowance takes the fee allowance of a task back from its
+// claimant once the task is approved, rejected or returned to OPEN. The grant
+// itself is revoked when it no longer sponsors any task.
+func (k Keeper) revokeTaskFeeAllowance(ctx context.Context, task types.Task) error {
+	if len(task.FeeAllowance) == 0 || task.Claimant == "" || k.feeGrantKeeper == nil {
+		return nil
+	}
+
+	granter, err := k.addressCodec.StringToBytes(task.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
+	}
+	grantee, err := k.addressCodec.StringToBytes(task.Claimant)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid claimant address")
+	}
+
+	existing, err := k.feeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		// already revoked
+		return nil
+	}
+	allowance, ok := existing.(*types.TaskFeeAllowance)
+	if !ok || !allowance.RemoveLimit(task.Id) {
+		return nil
+	}
+
+	if len(allowance.Limits) == 0 {
+		err = k.feeGrantKeeper.RevokeAllowance(ctx, granter, grantee)
+	} else {
+		err = k.feeGrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance)
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to revoke fee allowance")
+	}
+
+	return emitEvent(ctx, &types.EventTaskFeeAllowanceRevoked{
+		TaskId:  task.Id,
+		Granter: task.Creator,
+		Grantee: task.Claimant,
+	})
+}
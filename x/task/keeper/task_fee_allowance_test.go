@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+var testFeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+
+// createSponsoredTask creates an open task whose creator sponsors the
+// claimant's fees up to testFeeAllowance.
+func createSponsoredTask(t *testing.T, f *fixture, srv types.MsgServer, actors taskActors) uint64 {
+	t.Helper()
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.FeeAllowance = testFeeAllowance
+	resp, err := srv.CreateTask(f.ctx, msg)
+	require.NoError(t, err)
+	return resp.Id
+}
+
+// taskFeeAllowance returns the allowance the creator grants the claimant, nil
+// when there is none.
+func taskFeeAllowance(t *testing.T, f *fixture, actors taskActors) *types.TaskFeeAllowance {
+	t.Helper()
+
+	allowance, err := f.feeGrantKeeper.GetAllowance(f.ctx, actors.creatorAddr, actors.claimantAddr)
+	if err != nil {
+		return nil
+	}
+	taskAllowance, ok := allowance.(*types.TaskFeeAllowance)
+	require.True(t, ok)
+	return taskAllowance
+}
+
+func TestTaskMsgServerCreateWithFeeAllowance(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+
+	msg := newTestMsgCreateTask(actors.creator)
+	msg.FeeAllowance = sdk.Coins{sdk.NewInt64Coin("stake", 0)}
+	_, err := srv.CreateTask(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	id := createSponsoredTask(t, f, srv, actors)
+	task, err := f.keeper.Task.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, testFeeAllowance, task.FeeAllowance)
+
+	// nothing is granted before the task is claimed, nor for a failed claim
+	require.Nil(t, taskFeeAllowance(t, f, actors))
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.creator, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = f.feeGrantKeeper.GetAllowance(f.ctx, actors.creatorAddr, actors.creatorAddr)
+	require.Error(t, err)
+}
+
+func TestTaskFeeAllowanceGrantAndRevoke(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	approved := createSponsoredTask(t, f, srv, actors)
+	rejected := createSponsoredTask(t, f, srv, actors)
+
+	_, err := srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, approved))
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventTaskFeeAllowanceGranted{
+		TaskId:     approved,
+		Granter:    actors.creator,
+		Grantee:    actors.claimant,
+		SpendLimit: testFeeAllowance,
+	})
+
+	// a second sponsored task joins the existing allowance
+	_, err = srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, rejected))
+	require.NoError(t, err)
+	allowance := taskFeeAllowance(t, f, actors)
+	require.NotNil(t, allowance)
+	require.Equal(t, []types.TaskSpendLimit{
+		{TaskId: approved, SpendLimit: testFeeAllowance},
+		{TaskId: rejected, SpendLimit: testFeeAllowance},
+	}, allowance.Limits)
+
+	// the allowance only pays for submitting the sponsored tasks, the claimant
+	// paid for the claim that granted it
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{types.NewMsgSubmitTask(actors.claimant, approved, newTestProof(f))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowance.Limits[0].SpendLimit)
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{types.NewMsgSubmitTask(actors.claimant, approved, newTestProof(f))})
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{types.NewMsgSubmitTask(actors.claimant, 10, newTestProof(f))})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{types.NewMsgClaimTask(actors.claimant, approved)})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{types.NewMsgUnclaimTask(actors.claimant, approved, "")})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	_, err = allowance.Accept(f.ctx, fee, []sdk.Msg{
+		types.NewMsgSubmitTask(actors.claimant, approved, newTestProof(f)),
+		types.NewMsgSubmitTask(actors.claimant, rejected, newTestProof(f)),
+	})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	for _, id := range []uint64{approved, rejected} {
+		_, err = srv.SubmitTask(f.ctx, types.NewMsgSubmitTask(actors.claimant, id, newTestProof(f)))
+		require.NoError(t, err)
+	}
+
+	// approval drops the task from the allowance
+	_, err = srv.ApproveTask(f.ctx, types.NewMsgApproveTask(actors.creator, approved))
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventTaskFeeAllowanceRevoked{
+		TaskId:  approved,
+		Granter: actors.creator,
+		Grantee: actors.claimant,
+	})
+	allowance = taskFeeAllowance(t, f, actors)
+	require.NotNil(t, allowance)
+	require.Equal(t, []types.TaskSpendLimit{{TaskId: rejected, SpendLimit: testFeeAllowance}}, allowance.Limits)
+
+	// rejecting the last sponsored task revokes the grant
+	_, err = srv.RejectTask(f.ctx, types.NewMsgRejectTask(actors.creator, rejected, "incomplete"))
+	require.NoError(t, err)
+	require.Nil(t, taskFeeAllowance(t, f, actors))
+}
+
+func TestTaskFeeAllowanceRevokedOnClaimExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+	params := types.DefaultParams()
+
+	id := createSponsoredTask(t, f, srv, actors)
+	_, err := srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+	require.NotNil(t, taskFeeAllowance(t, f, actors))
+
+	ctx := afterSeconds(f, params.ClaimDeadline+1)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	task, err := f.keeper.Task.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+	require.Nil(t, taskFeeAllowance(t, f, actors))
+	requireEvent(t, ctx, &types.EventTaskFeeAllowanceRevoked{
+		TaskId:  id,
+		Granter: actors.creator,
+		Grantee: actors.claimant,
+	})
+}
+
+func TestTaskFeeAllowanceKeepsOtherGrants(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	// the creator already sponsors the claimant through a grant of their own
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}
+	require.NoError(t, f.feeGrantKeeper.GrantAllowance(f.ctx, actors.creatorAddr, actors.claimantAddr, basic))
+
+	id := createSponsoredTask(t, f, srv, actors)
+	_, err := srv.ClaimTask(f.ctx, types.NewMsgClaimTask(actors.claimant, id))
+	require.NoError(t, err)
+	_, err = srv.UnclaimTask(f.ctx, types.NewMsgUnclaimTask(actors.claimant, id, "busy"))
+	require.NoError(t, err)
+
+	allowance, err := f.feeGrantKeeper.GetAllowance(f.ctx, actors.creatorAddr, actors.claimantAddr)
+	require.NoError(t, err)
+	require.Equal(t, basic, allowance)
+}
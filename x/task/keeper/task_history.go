@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// recordTransition appends a status change of a task to its history and
+// reports it to the remote senders of the task. actor is empty when the chain
+// caused the transition.
+func (k Keeper) recordTransition(ctx context.Context, id uint64, from, to types.TaskStatus, actor, reason string) error {
+	seq, err := k.nextHistorySeq(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	transition := types.TaskTransition{
+		TaskId:      id,
+		Seq:         seq,
+		From:        from,
+		To:          to,
+		Actor:       actor,
+		BlockHeight: sdkCtx.BlockHeight(),
+		Timestamp:   sdkCtx.BlockTime().Unix(),
+		Reason:      reason,
+	}
+	if err := k.TaskHistory.Set(ctx, collections.Join(id, seq), transition); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to store task transition")
+	}
+
+	return k.reportTransition(ctx, transition)
+}
+
+// latestTransition returns the last status change of a task.
+func (k Keeper) latestTransition(ctx context.Context, id uint64) (types.TaskTransition, error) {
+	iter, err := k.TaskHistory.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id).Descending())
+	if err != nil {
+		return types.TaskTransition{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task history")
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TaskTransition{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d has no history", id))
+	}
+
+	transition, err := iter.Value()
+	if err != nil {
+		return types.TaskTransition{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task history")
+	}
+
+	return transition, nil
+}
+
+// nextHistorySeq returns the sequence of the next transition of a task.
+func (k Keeper) nextHistorySeq(ctx context.Context, id uint64) (uint64, error) {
+	iter, err := k.TaskHistory.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id).Descending())
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task history")
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+
+	key, err := iter.Key()
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task history")
+	}
+
+	return key.K2() + 1, nil
+}
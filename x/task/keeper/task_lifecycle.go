@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskbounty/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// closeTask moves a task to CLOSED. The escrowed bounty of an open or
+// disputed task is refunded to the creator, an approved task has already been
+// paid out. actor is empty when the chain closes the task.
+func (k Keeper) closeTask(ctx context.Context, task types.Task, actor, reason string) error {
+	if !types.IsValidTransition(task.Status, types.TASK_STATUS_CLOSED) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot close task in %s status", types.TaskStatusToString(task.Status)))
+	}
+
+	if task.Status != types.TASK_STATUS_APPROVED {
+		if _, err := k.refundTask(ctx, task, reason); err != nil {
+			return err
+		}
+	}
+
+	oldStatus := task.Status
+	task.Status = types.TASK_STATUS_CLOSED
+	task.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, actor, reason); err != nil {
+		return err
+	}
+
+	_, err := k.archiveTask(ctx, task)
+	return err
+}
+
+// archiveTask moves a closed task from Task to ArchivedTask when the
+// ArchiveClosedTasks param is set, and reports whether it did.
+func (k Keeper) archiveTask(ctx context.Context, task types.Task) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+	if !params.ArchiveClosedTasks || task.Status != types.TASK_STATUS_CLOSED {
+		return false, nil
+	}
+
+	if err := k.Task.Remove(ctx, task.Id); err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove task")
+	}
+	if err := k.ArchivedTask.Set(ctx, task.Id, task); err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to archive task")
+	}
+
+	return true, nil
+}
+
+// ExpireTask closes an open task whose TaskExpiry has passed and refunds its
+// escrowed bounty to the creator.
+func (k Keeper) ExpireTask(ctx context.Context, id uint64) error {
+	task, err := k.Task.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("task %d not found", id))
+		}
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get task")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get params")
+	}
+
+	if task.Status != types.TASK_STATUS_OPEN {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only open tasks can expire")
+	}
+	if !task.IsExpired(params, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task has not expired yet")
+	}
+
+	return k.expireTask(ctx, task)
+}
+
+// expireTask closes an expired open task, refunds its bounty and emits an
+// EventTaskExpired event.
+func (k Keeper) expireTask(ctx context.Context, task types.Task) error {
+	if err := k.closeTask(ctx, task, "", types.RefundReasonExpired); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &types.EventTaskExpired{
+		TaskId:    task.Id,
+		Creator:   task.Creator,
+		Refund:    task.Bounty,
+		OldStatus: task.Status,
+		NewStatus: types.TASK_STATUS_CLOSED,
+	})
+}
+
+// approveTask moves a submitted task to APPROVED, pays its escrowed bounty out
+// to the claimant and mints them a completion certificate. approver is empty
+// when the chain approves the task.
+func (k Keeper) approveTask(ctx context.Context, task types.Task, approver, reason string) error {
+	oldStatus := task.Status
+	task.Approver = approver
+	task.Status = types.TASK_STATUS_APPROVED
+	task.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	// Pay the escrowed bounty to the claimant (the task is now APPROVED)
+	if _, err := k.payoutTask(ctx, task); err != nil {
+		return err
+	}
+
+	if err := k.setSubmissionOutcome(ctx, task.Id, types.SUBMISSION_OUTCOME_APPROVED, approver, ""); err != nil {
+		return err
+	}
+
+	if err := k.revokeTaskFeeAllowance(ctx, task); err != nil {
+		return err
+	}
+
+	if err := k.mintCertificate(ctx, task); err != nil {
+		return err
+	}
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, approver, reason); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &types.EventTaskApproved{
+		TaskId:    task.Id,
+		Approver:  approver,
+		Claimant:  task.Claimant,
+		Amount:    task.Bounty,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+		Attempt:   task.Attempt,
+	})
+}
+
+// rejectTask moves a submitted task to REJECTED, keeping the bounty in escrow.
+// The reason is recorded on the submission, which keeps the rejected proof.
+// rejecter is empty when the chain rejects the task.
+func (k Keeper) rejectTask(ctx context.Context, task types.Task, rejecter, reason string) error {
+	oldStatus := task.Status
+	task.Status = types.TASK_STATUS_REJECTED
+	task.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	task.RejectedAt = task.UpdatedAt
+
+	if err := k.setSubmissionOutcome(ctx, task.Id, types.SUBMISSION_OUTCOME_REJECTED, rejecter, reason); err != nil {
+		return err
+	}
+
+	if err := k.revokeTaskFeeAllowance(ctx, task); err != nil {
+		return err
+	}
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := k.recordTransition(ctx, task.Id, oldStatus, task.Status, rejecter, reason); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &types.EventTaskRejected{
+		TaskId:    task.Id,
+		Rejecter:  rejecter,
+		Claimant:  task.Claimant,
+		Reason:    reason,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+		Attempt:   task.Attempt,
+	})
+}
+
+// reopenTask returns a claimed or rejected task to OPEN so that it can be
+// claimed again. actor is empty when the chain reopens the task.
+func (k Keeper) reopenTask(ctx context.Context, task types.Task, params types.Params, actor, reason string) error {
+	if !types.IsValidTransition(task.Status, types.TASK_STATUS_OPEN) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot reopen task in %s status", types.TaskStatusToString(task.Status)))
+	}
+
+	// the claimant loses the task, and with it the fee allowance
+	if err := k.revokeTaskFeeAllowance(ctx, task); err != nil {
+		return err
+	}
+
+	// the transition is recorded while the stored task still names the
+	// claimant, so that a remote claimant is told it lost the task
+	if err := k.recordTransition(ctx, task.Id, task.Status, types.TASK_STATUS_OPEN, actor, reason); err != nil {
+		return err
+	}
+
+	// the next claimant starts from scratch, its attempts, deadlines and
+	// reviews are not carried over from the previous claim
+	task.Claimant = ""
+	task.Proof = ""
+	task.Attempt = 0
+	task.ClaimedAt = 0
+	task.SubmittedAt = 0
+	task.RejectedAt = 0
+	task.Status = types.TASK_STATUS_OPEN
+	task.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update task")
+	}
+
+	if err := k.TaskReview.Clear(ctx, collections.NewPrefixedPairRange[uint64, string](task.Id)); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear task reviews")
+	}
+
+	// the reopened task is subject to its expiry again
+	return k.scheduleDeadline(ctx, task, params)
+}
+
+// expireClaim returns a task whose claimant missed the claim deadline to OPEN
+// and emits an EventTaskClaimExpired event.
+func (k Keeper) expireClaim(ctx context.Context, task types.Task, params types.Params) error {
+	if err := k.reopenTask(ctx, task, params, "", "claim deadline passed"); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &types.EventTaskClaimExpired{
+		TaskId:    task.Id,
+		Claimant:  task.Claimant,
+		OldStatus: task.Status,
+		NewStatus: types.TASK_STATUS_OPEN,
+	})
+}
+
+// timeoutReview applies the ReviewTimeoutAction param to a submission the
+// creator did not review before the submission deadline and emits an
+// EventTaskReviewTimeout event.
+func (k Keeper) timeoutReview(ctx context.Context, task types.Task, params types.Params) error {
+	const reason = "review deadline passed"
+
+	var (
+		status = types.TASK_STATUS_APPROVED
+		err    error
+	)
+	if params.ReviewTimeoutAction == types.REVIEW_TIMEOUT_ACTION_REJECT {
+		status = types.TASK_STATUS_REJECTED
+		err = k.rejectTask(ctx, task, "", reason)
+	} else {
+		err = k.approveTask(ctx, task, "", reason)
+	}
+	if err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &types.EventTaskReviewTimeout{
+		TaskId:    task.Id,
+		Claimant:  task.Claimant,
+		Action:    params.ReviewTimeoutAction,
+		OldStatus: task.Status,
+		NewStatus: status,
+	})
+}
+
+// scheduleDeadline enqueues the deadline that applies to the task in its
+// current status. Entries left behind by earlier statuses are dropped when the
+// queue is drained, so they never need to be removed here.
+func (k Keeper) scheduleDeadline(ctx context.Context, task types.Task, params types.Params) error {
+	kind, ok := task.PendingDeadline()
+	if !ok {
+		return nil
+	}
+	at, ok := task.DeadlineAt(params, kind)
+	if !ok {
+		return nil
+	}
+
+	if err := k.DeadlineQueue.Set(ctx, collections.Join3(at.Unix(), task.Id, int32(kind))); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to schedule task deadline")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+func TestExpireTaskRefund(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	f.bankKeeper.fund(actors.creatorAddr, testBounty)
+	resp, err := srv.CreateTask(f.ctx, newTestMsgCreateTask(actors.creator))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+
+	// not expired yet
+	err = f.keeper.ExpireTask(sdkCtx, resp.Id)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	expiredCtx := sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(time.Duration(params.TaskExpiry+1) * time.Second)).WithBlockHeight(10)
+	require.NoError(t, f.keeper.ExpireTask(expiredCtx, resp.Id))
+
+	task, err := f.keeper.Task.Get(expiredCtx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	refund, err := qs.GetTaskRefund(expiredCtx, &types.QueryGetTaskRefundRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.TaskRefund{
+		TaskId:      resp.Id,
+		Creator:     actors.creator,
+		Amount:      testBounty,
+		Timestamp:   expiredCtx.BlockTime().Unix(),
+		BlockHeight: 10,
+		Reason:      types.RefundReasonExpired,
+	}, refund.TaskRefund)
+
+	// a closed task cannot expire, or be refunded, twice
+	err = f.keeper.ExpireTask(expiredCtx, resp.Id)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	refunds, err := qs.ListTaskRefund(expiredCtx, &types.QueryAllTaskRefundRequest{})
+	require.NoError(t, err)
+	require.Len(t, refunds.TaskRefund, 1)
+
+	// closed tasks can be deleted without a second refund
+	_, err = srv.DeleteTask(expiredCtx, &types.MsgDeleteTask{Creator: actors.creator, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, testBounty, f.bankKeeper.balance(actors.creatorAddr))
+}
+
+func TestExpireTaskClaimed(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	actors := newTaskActors(t, f)
+
+	id := createSubmittedTask(t, f, srv, actors)
+
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	expiredCtx := sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(time.Duration(types.DefaultParams().TaskExpiry+1) * time.Second))
+
+	err := f.keeper.ExpireTask(expiredCtx, id)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, testBounty, f.bankKeeper.moduleBalance(types.ModuleName))
+}
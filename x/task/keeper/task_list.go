@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"taskbounty/x/task/types"
+)
+
+// listTasks pages through the tasks matching filter in the order given by
+// by, ties being broken by id. Orderings other than by id walk the matching
+// secondary index, and an equality filter on an indexed field narrows the walk
+// to that index prefix, so a page only reads the tasks it skips or returns.
+// Without a sort direction, pagination.reverse lists the tasks in descending order.
+func (k Keeper) listTasks(ctx context.Context, filter types.TaskFilter, by types.TaskSort, pageReq *query.PageRequest) ([]types.Task, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	desc := by.Direction == types.SortDesc || (by.Direction == "" && pageReq.Reverse)
+
+	status := int32(filter.Status)
+	hasStatus := filter.Status != types.TASK_STATUS_UNDEFINED
+
+	switch by.Field {
+	case types.SortFieldBounty:
+		denom := by.Denom
+		if denom == "" {
+			denom = filter.BountyDenom()
+		}
+		if denom == "" {
+			params, err := k.Params.Get(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			denom = params.MinBounty.Denom
+		}
+		return paginateBountyTasks(ctx, k, denom, filter, desc, pageReq)
+	case types.SortFieldCreatedAt:
+		return paginateTasks(ctx, k, k.Task.Indexes.CreatedAt, nil, filter, desc, pageReq)
+	case types.SortFieldStatus:
+		if hasStatus {
+			return paginateTasks(ctx, k, k.Task.Indexes.Status, &status, filter, desc, pageReq)
+		}
+		return paginateTasks(ctx, k, k.Task.Indexes.Status, nil, filter, desc, pageReq)
+	}
+
+	switch {
+	case filter.Creator != "":
+		return paginateTasks(ctx, k, k.Task.Indexes.Creator, &filter.Creator, filter, desc, pageReq)
+	case filter.Claimant != "":
+		return paginateTasks(ctx, k, k.Task.Indexes.Claimant, &filter.Claimant, filter, desc, pageReq)
+	case filter.Approver != "":
+		return paginateTasks(ctx, k, k.Task.Indexes.Approver, &filter.Approver, filter, desc, pageReq)
+	case hasStatus:
+		return paginateTasks(ctx, k, k.Task.Indexes.Status, &status, filter, desc, pageReq)
+	}
+
+	// ordered by id, the primary key
+	idReq := *pageReq
+	idReq.Reverse = desc
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.Task,
+		&idReq,
+		func(_ uint64, task types.Task) (bool, error) {
+			return filter.Matches(task), nil
+		},
+		func(_ uint64, task types.Task) (types.Task, error) {
+			return task, nil
+		},
+	)
+}
+
+// paginateTasks pages through the tasks matching filter in the order of index,
+// restricted to the entries under prefix when it is set. The page key is the
+// encoded index key of the next matching task.
+func paginateTasks[R any](
+	ctx context.Context,
+	k Keeper,
+	index multiIndex[R],
+	prefix *R,
+	filter types.TaskFilter,
+	desc bool,
+	pageReq *query.PageRequest,
+) ([]types.Task, *query.PageResponse, error) {
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	var start *collections.Pair[R, uint64]
+	if len(pageReq.Key) != 0 {
+		_, key, err := index.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid pagination key: %w", err)
+		}
+		start = &key
+	}
+
+	var ranger collections.Ranger[collections.Pair[R, uint64]]
+	if prefix != nil {
+		r := collections.NewPrefixedPairRange[R, uint64](*prefix)
+		if start != nil && desc {
+			r = r.EndInclusive(start.K2())
+		} else if start != nil {
+			r = r.StartInclusive(start.K2())
+		}
+		if desc {
+			r = r.Descending()
+		}
+		ranger = r
+	} else {
+		r := new(collections.Range[collections.Pair[R, uint64]])
+		if start != nil && desc {
+			r = r.EndInclusive(*start)
+		} else if start != nil {
+			r = r.StartInclusive(*start)
+		}
+		if desc {
+			r = r.Descending()
+		}
+		ranger = r
+	}
+
+	iter, err := index.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collectTasks(ctx, k, iter, index.KeyCodec(), collections.Pair[R, uint64].K2, filter, pageReq)
+}
+
+// paginateBountyTasks pages through the tasks with a bounty in denom matching
+// filter, in the order of their amount of denom. The page key is the encoded
+// bounty index key of the next matching task.
+func paginateBountyTasks(
+	ctx context.Context,
+	k Keeper,
+	denom string,
+	filter types.TaskFilter,
+	desc bool,
+	pageReq *query.PageRequest,
+) ([]types.Task, *query.PageResponse, error) {
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	index := k.Task.Indexes.Bounty
+	r := new(collections.Range[collections.Triple[string, []byte, uint64]]).
+		Prefix(collections.TriplePrefix[string, []byte, uint64](denom))
+	if len(pageReq.Key) != 0 {
+		_, start, err := index.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid pagination key: %w", err)
+		}
+		if start.K1() != denom {
+			return nil, nil, fmt.Errorf("invalid pagination key: denom %s is not the sort denom %s", start.K1(), denom)
+		}
+		if desc {
+			r = r.EndInclusive(start)
+		} else {
+			r = r.StartInclusive(start)
+		}
+	}
+	if desc {
+		r = r.Descending()
+	}
+
+	iter, err := index.Iterate(ctx, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collectTasks(ctx, k, iter, index.KeyCodec(), collections.Triple[string, []byte, uint64].K3, filter, pageReq)
+}
+
+// taskIndexIterator iterates the keys of a task index.
+type taskIndexIterator[K any] interface {
+	Valid() bool
+	Next()
+	FullKey() (K, error)
+	Close() error
+}
+
+// collectTasks collects the page of tasks matching filter from iter, whose
+// keys hold the task id returned by taskID, and closes it.
+func collectTasks[K any](
+	ctx context.Context,
+	k Keeper,
+	iter taskIndexIterator[K],
+	keyCodec collcodec.KeyCodec[K],
+	taskID func(K) uint64,
+	filter types.TaskFilter,
+	pageReq *query.PageRequest,
+) ([]types.Task, *query.PageResponse, error) {
+	defer iter.Close()
+
+	countTotal := pageReq.CountTotal
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero and no key is set
+		countTotal = countTotal || len(pageReq.Key) == 0
+	}
+
+	var (
+		tasks   []types.Task
+		count   uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		task, err := k.Task.Get(ctx, taskID(key))
+		if err != nil {
+			return nil, nil, err
+		}
+		if !filter.Matches(task) {
+			continue
+		}
+		count++
+
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(tasks)) < limit:
+			tasks = append(tasks, task)
+		case nextKey == nil:
+			nextKey, err = collections.EncodeKeyWithPrefix(nil, keyCodec, key)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !countTotal {
+				return tasks, &query.PageResponse{NextKey: nextKey}, nil
+			}
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return tasks, pageRes, nil
+}
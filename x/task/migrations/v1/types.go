@@ -0,0 +1,163 @@
+// Package v1 holds a frozen copy of the state x/task stored at consensus
+// version 1, encoded the way version 1 encoded it. The types of the module
+// have changed since, so they cannot be used to write version 1 state, such as
+// the state the v1 to v2 migration is tested against.
+package v1
+
+import (
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var (
+	// ParamsKey is the store key of the params in version 1.
+	ParamsKey = collections.NewPrefix("p_task")
+	// TaskKeyPrefix is the prefix of the store keys of tasks in version 1.
+	TaskKeyPrefix = collections.NewPrefix("task/value/")
+	// TaskRewardKeyPrefix is the prefix of the store keys of task rewards in
+	// version 1.
+	TaskRewardKeyPrefix = collections.NewPrefix(1)
+)
+
+// TaskKey returns the store key of task id in version 1.
+func TaskKey(id uint64) []byte {
+	return append(TaskKeyPrefix.Bytes(), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TaskRewardKey returns the store key of the reward of task id in version 1.
+func TaskRewardKey(id uint64) []byte {
+	return append(TaskRewardKeyPrefix.Bytes(), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Coin is a cosmos.base.v1beta1.Coin. Version 1 held bounties and rewards in
+// a single coin.
+type Coin struct {
+	Denom  string
+	Amount string
+}
+
+// NewCoin returns a Coin of amount denom.
+func NewCoin(denom string, amount int64) Coin {
+	return Coin{Denom: denom, Amount: strconv.FormatInt(amount, 10)}
+}
+
+// Marshal returns the protobuf encoding of the coin.
+func (c Coin) Marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, c.Denom)
+	b = appendString(b, 2, c.Amount)
+	return b
+}
+
+// Params are the params of version 1, before the deadline bounds, the review
+// timeout action and every later param were added.
+type Params struct {
+	MinBounty            Coin
+	MaxBounty            Coin
+	MaxTitleLength       uint32
+	MaxDescriptionLength uint32
+	ProofTypes           []string
+	AutoApproveThreshold uint32
+	TaskExpiry           uint64
+	ClaimDeadline        uint64
+	SubmissionDeadline   uint64
+}
+
+// Marshal returns the protobuf encoding of the params.
+func (p Params) Marshal() []byte {
+	var b []byte
+	b = appendMessage(b, 1, p.MinBounty.Marshal())
+	b = appendMessage(b, 2, p.MaxBounty.Marshal())
+	b = appendVarint(b, 3, uint64(p.MaxTitleLength))
+	b = appendVarint(b, 4, uint64(p.MaxDescriptionLength))
+	for _, proofType := range p.ProofTypes {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		b = protowire.AppendString(b, proofType)
+	}
+	b = appendVarint(b, 6, uint64(p.AutoApproveThreshold))
+	b = appendVarint(b, 7, p.TaskExpiry)
+	b = appendVarint(b, 8, p.ClaimDeadline)
+	b = appendVarint(b, 9, p.SubmissionDeadline)
+	return b
+}
+
+// Task is a task of version 1. Its bounty is a single coin, and its latest
+// proof is flattened into Proof as "hash:type:timestamp[:data]", or replaced
+// by "REJECTED: reason" once rejected.
+type Task struct {
+	Id          uint64
+	Title       string
+	Description string
+	Bounty      Coin
+	Status      int32
+	Claimant    string
+	Proof       string
+	Approver    string
+	Creator     string
+	CreatedAt   int64
+	UpdatedAt   int64
+}
+
+// Marshal returns the protobuf encoding of the task.
+func (t Task) Marshal() []byte {
+	var b []byte
+	b = appendVarint(b, 1, t.Id)
+	b = appendString(b, 2, t.Title)
+	b = appendString(b, 3, t.Description)
+	b = appendMessage(b, 4, t.Bounty.Marshal())
+	b = appendVarint(b, 5, uint64(t.Status))
+	b = appendString(b, 6, t.Claimant)
+	b = appendString(b, 7, t.Proof)
+	b = appendString(b, 8, t.Approver)
+	b = appendString(b, 9, t.Creator)
+	b = appendVarint(b, 10, uint64(t.CreatedAt))
+	b = appendVarint(b, 11, uint64(t.UpdatedAt))
+	return b
+}
+
+// TaskReward is a task reward of version 1, paid in a single coin.
+type TaskReward struct {
+	TaskId    uint64
+	Claimant  string
+	Amount    Coin
+	Timestamp int64
+	TxHash    string
+}
+
+// Marshal returns the protobuf encoding of the reward.
+func (r TaskReward) Marshal() []byte {
+	var b []byte
+	b = appendVarint(b, 1, r.TaskId)
+	b = appendString(b, 2, r.Claimant)
+	b = appendMessage(b, 3, r.Amount.Marshal())
+	b = appendVarint(b, 4, uint64(r.Timestamp))
+	b = appendString(b, 5, r.TxHash)
+	return b
+}
+
+// appendVarint appends a varint field, which proto3 omits when zero.
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// appendString appends a string field, which proto3 omits when empty.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// appendMessage appends a non-nullable message field, which is always encoded.
+func appendMessage(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, msg)
+}
@@ -0,0 +1,246 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"taskbounty/x/task/types"
+)
+
+// rejectedProofPrefix is how consensus version 1 overwrote Task.Proof when a
+// submission was rejected.
+const rejectedProofPrefix = "REJECTED: "
+
+// recordStore is the part of a collection the migration uses. Tasks and
+// rewards are indexed since version 2, setting a record through its indexed
+// map references it in all of its indexes.
+type recordStore[V any] interface {
+	Walk(ctx context.Context, ranger collections.Ranger[uint64], walkFunc func(key uint64, value V) (bool, error)) error
+	Set(ctx context.Context, key uint64, value V) error
+}
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2:
+//
+//   - the params introduced in version 2 are set to their defaults
+//   - the single coin bounty of tasks and amount of rewards become coins
+//   - the proof flattened into Task.Proof becomes the first Submission of the task
+//   - claimed, submitted and rejected tasks record when they were claimed,
+//     submitted or rejected
+//   - the tasks are marked unescrowed, as version 1 did not escrow bounties
+//   - tasks and rewards are set again, which builds their secondary indexes
+//   - the deadline queue drained by the EndBlocker is built from the tasks
+func MigrateStore(
+	ctx context.Context,
+	params collections.Item[types.Params],
+	tasks recordStore[types.Task],
+	rewards recordStore[types.TaskReward],
+	submissions collections.Map[collections.Pair[uint64, uint64], types.Submission],
+	deadlines collections.KeySet[collections.Triple[int64, uint64, int32]],
+) error {
+	p, err := migrateParams(ctx, params)
+	if err != nil {
+		return err
+	}
+	if err := migrateTasks(ctx, p, tasks, submissions, deadlines); err != nil {
+		return err
+	}
+
+	return migrateRecords(ctx, rewards, func(reward *types.TaskReward) {
+		sanitizeCoins(&reward.Amount)
+	})
+}
+
+// migrateParams sets the params introduced in version 2 to their defaults.
+// The deadline bounds are widened where needed so that the deadlines already
+// set by governance stay valid.
+func migrateParams(ctx context.Context, params collections.Item[types.Params]) (types.Params, error) {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	defaults := types.DefaultParams()
+	p.ReviewTimeoutAction = defaults.ReviewTimeoutAction
+	p.MaxSubmissionAttempts = defaults.MaxSubmissionAttempts
+	p.DisputeWindow = defaults.DisputeWindow
+	p.MaxDeadlinesPerBlock = defaults.MaxDeadlinesPerBlock
+	p.MaxDeadlineRetries = defaults.MaxDeadlineRetries
+	p.MinTaskExpiry, p.MaxTaskExpiry = widenBounds(p.TaskExpiry, defaults.MinTaskExpiry, defaults.MaxTaskExpiry)
+	p.MinClaimDeadline, p.MaxClaimDeadline = widenBounds(p.ClaimDeadline, defaults.MinClaimDeadline, defaults.MaxClaimDeadline)
+	p.MinSubmissionDeadline, p.MaxSubmissionDeadline = widenBounds(p.SubmissionDeadline, defaults.MinSubmissionDeadline, defaults.MaxSubmissionDeadline)
+
+	if err := p.Validate(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid migrated params: %w", err)
+	}
+
+	return p, params.Set(ctx, p)
+}
+
+// widenBounds returns the [min, max] bounds stretched to include value.
+func widenBounds(value, min, max uint64) (uint64, uint64) {
+	if value == 0 {
+		return min, max
+	}
+	if value < min {
+		min = value
+	}
+	if max != 0 && value > max {
+		max = value
+	}
+
+	return min, max
+}
+
+// migrateTasks migrates every task, turns its proof into its first
+// Submission and queues its pending deadline.
+func migrateTasks(
+	ctx context.Context,
+	params types.Params,
+	tasks recordStore[types.Task],
+	submissions collections.Map[collections.Pair[uint64, uint64], types.Submission],
+	deadlines collections.KeySet[collections.Triple[int64, uint64, int32]],
+) error {
+	return migrateRecords(ctx, tasks, func(task *types.Task) {
+		sanitizeCoins(&task.Bounty)
+		if task.Proof != "" && task.Claimant != "" {
+			task.Attempt = 1
+		}
+
+		// version 1 only checked deadlines lazily when a message touched the
+		// task, and ran them from its last update, which was the claim, the
+		// submission or the rejection of a claimed, submitted or rejected task
+		switch task.Status {
+		case types.TASK_STATUS_CLAIMED:
+			task.ClaimedAt = task.UpdatedAt
+		case types.TASK_STATUS_SUBMITTED:
+			task.SubmittedAt = task.UpdatedAt
+		case types.TASK_STATUS_REJECTED:
+			task.RejectedAt = task.UpdatedAt
+		}
+
+		// version 1 never moved the bounty of a task into the task module
+		// account, so paying it out or refunding it from there would spend
+		// the escrow of other tasks
+		task.Unescrowed = true
+	}, func(task types.Task) error {
+		if submission, ok := migrateSubmission(task); ok {
+			if err := submissions.Set(ctx, collections.Join(task.Id, submission.Attempt), submission); err != nil {
+				return err
+			}
+		}
+
+		kind, ok := task.PendingDeadline()
+		if !ok {
+			return nil
+		}
+		at, ok := task.DeadlineAt(params, kind)
+		if !ok {
+			return nil
+		}
+		return deadlines.Set(ctx, collections.Join3(at.Unix(), task.Id, int32(kind)))
+	})
+}
+
+// migrateSubmission turns the proof of a task into its first Submission.
+// Version 1 only kept the latest proof of a task, flattened into Task.Proof as
+// "hash:type:timestamp[:data]" or replaced by "REJECTED: reason" once
+// rejected. The proof of a rejected submission is lost in version 1, so only
+// its rejection reason is carried over.
+func migrateSubmission(task types.Task) (types.Submission, bool) {
+	if task.Proof == "" || task.Claimant == "" {
+		return types.Submission{}, false
+	}
+
+	submission := types.Submission{
+		TaskId:      task.Id,
+		Attempt:     task.Attempt,
+		Submitter:   task.Claimant,
+		SubmittedAt: task.UpdatedAt,
+		Outcome:     outcomeFromStatus(task.Status),
+	}
+
+	if reason, ok := strings.CutPrefix(task.Proof, rejectedProofPrefix); ok {
+		submission.Outcome = types.SUBMISSION_OUTCOME_REJECTED
+		submission.ReviewNote = reason
+		submission.ReviewedAt = task.UpdatedAt
+		return submission, true
+	}
+
+	// proofs that do not follow the flattened format are kept verbatim
+	proof, err := types.ParseTaskProof(task.Proof)
+	if err != nil {
+		proof = types.TaskProof{Data: task.Proof}
+	} else {
+		submission.SubmittedAt = proof.Timestamp
+	}
+	submission.Proof = proof
+	if submission.Outcome != types.SUBMISSION_OUTCOME_PENDING {
+		submission.Reviewer = task.Approver
+		submission.ReviewedAt = task.UpdatedAt
+	}
+
+	return submission, true
+}
+
+// migrateRecords applies migrate to every record of store and sets it again,
+// then calls each of after with the migrated record. The records are
+// collected before being written so that the store is not modified while it
+// is iterated.
+func migrateRecords[V any](ctx context.Context, store recordStore[V], migrate func(value *V), after ...func(value V) error) error {
+	var (
+		keys   []uint64
+		values []V
+	)
+	err := store.Walk(ctx, nil, func(key uint64, value V) (bool, error) {
+		migrate(&value)
+		keys = append(keys, key)
+		values = append(values, value)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err := store.Set(ctx, key, values[i]); err != nil {
+			return err
+		}
+		for _, fn := range after {
+			if err := fn(values[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sanitizeCoins drops the zero and unset coins of coins. Version 1 stored a
+// single coin, which decodes as coins holding only that coin, but coins cannot
+// hold a zero or unset coin.
+func sanitizeCoins(coins *sdk.Coins) {
+	var sanitized sdk.Coins
+	for _, coin := range *coins {
+		if !coin.Amount.IsNil() && coin.IsPositive() {
+			sanitized = append(sanitized, coin)
+		}
+	}
+	*coins = sanitized
+}
+
+// outcomeFromStatus derives the review outcome of the latest submission from
+// the status of its task.
+func outcomeFromStatus(status types.TaskStatus) types.SubmissionOutcome {
+	switch status {
+	case types.TASK_STATUS_APPROVED, types.TASK_STATUS_CLOSED:
+		return types.SUBMISSION_OUTCOME_APPROVED
+	case types.TASK_STATUS_REJECTED:
+		return types.SUBMISSION_OUTCOME_REJECTED
+	default:
+		return types.SUBMISSION_OUTCOME_PENDING
+	}
+}
@@ -0,0 +1,212 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"taskbounty/x/task/keeper"
+	v1 "taskbounty/x/task/migrations/v1"
+	v2 "taskbounty/x/task/migrations/v2"
+	module "taskbounty/x/task/module"
+	"taskbounty/x/task/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		storeService,
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	creator := sdk.AccAddress([]byte("creatorAddr_________________")).String()
+	claimant := sdk.AccAddress([]byte("claimantAddr________________")).String()
+
+	// version 1 state, encoded as version 1 stored it
+	store := storeService.OpenKVStore(ctx)
+	v1Params := v1.Params{
+		MinBounty:            v1.NewCoin("stake", 1000),
+		MaxBounty:            v1.NewCoin("stake", 1000000),
+		MaxTitleLength:       100,
+		MaxDescriptionLength: 1000,
+		ProofTypes:           []string{"ipfs", "url", "text"},
+		AutoApproveThreshold: 5,
+		TaskExpiry:           86400 * 120,
+		ClaimDeadline:        600,
+		SubmissionDeadline:   0,
+	}
+	require.NoError(t, store.Set(v1.ParamsKey, v1Params.Marshal()))
+
+	// the proof of version 1 tasks is flattened into Task.Proof
+	bounty := v1.NewCoin("stake", 5000)
+	v1Tasks := []v1.Task{
+		{Id: 0, Title: "Open", Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_OPEN), CreatedAt: 100, UpdatedAt: 100},
+		{Id: 1, Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_SUBMITTED), Claimant: claimant, Proof: "bafy:ipfs:150:pr#1", UpdatedAt: 150},
+		{Id: 2, Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_APPROVED), Claimant: claimant, Approver: creator, Proof: "https://example.com/pr/2:url:160", UpdatedAt: 170},
+		{Id: 3, Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_REJECTED), Claimant: claimant, Proof: "REJECTED: missing tests", UpdatedAt: 180},
+		{Id: 4, Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_SUBMITTED), Claimant: claimant, Proof: "not a flattened proof", UpdatedAt: 190},
+		{Id: 5, Creator: creator, Bounty: bounty, Status: int32(types.TASK_STATUS_CLAIMED), Claimant: claimant, UpdatedAt: 200},
+	}
+	for _, task := range v1Tasks {
+		require.NoError(t, store.Set(v1.TaskKey(task.Id), task.Marshal()))
+	}
+	v1Reward := v1.TaskReward{TaskId: 2, Claimant: claimant, Amount: bounty, Timestamp: 170, TxHash: "ABCD"}
+	require.NoError(t, store.Set(v1.TaskRewardKey(v1Reward.TaskId), v1Reward.Marshal()))
+
+	require.NoError(t, v2.MigrateStore(ctx, k.Params, k.Task, k.TaskReward, k.Submission, k.DeadlineQueue))
+
+	// new params get their defaults, bounds are widened to keep the v1 deadlines valid
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	require.Equal(t, sdk.NewInt64Coin("stake", 1000), params.MinBounty)
+	require.Equal(t, []string{"ipfs", "url", "text"}, params.ProofTypes)
+	require.Equal(t, types.REVIEW_TIMEOUT_ACTION_APPROVE, params.ReviewTimeoutAction)
+	require.Equal(t, types.DefaultParams().MaxSubmissionAttempts, params.MaxSubmissionAttempts)
+	require.Equal(t, types.DefaultParams().DisputeWindow, params.DisputeWindow)
+	require.Equal(t, types.DefaultParams().MaxDeadlinesPerBlock, params.MaxDeadlinesPerBlock)
+	require.Equal(t, types.DefaultParams().MaxDeadlineRetries, params.MaxDeadlineRetries)
+	require.Equal(t, uint64(3600), params.MinTaskExpiry)
+	require.Equal(t, uint64(86400*120), params.MaxTaskExpiry)
+	require.Equal(t, uint64(600), params.MinClaimDeadline)
+	require.Equal(t, types.DefaultParams().MaxClaimDeadline, params.MaxClaimDeadline)
+	require.Equal(t, types.DefaultParams().MinSubmissionDeadline, params.MinSubmissionDeadline)
+	require.Empty(t, params.Arbiters)
+
+	// the single coin bounty decodes as coins, and every task is unescrowed,
+	// as version 1 never escrowed a bounty
+	bountyCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 5000))
+	for _, v1Task := range v1Tasks {
+		task, err := k.Task.Get(ctx, v1Task.Id)
+		require.NoError(t, err)
+		require.Equal(t, bountyCoins, task.Bounty)
+		require.Equal(t, v1Task.Creator, task.Creator)
+		require.Equal(t, v1Task.Proof, task.Proof)
+		require.True(t, task.Unescrowed)
+	}
+
+	// claimed, submitted and rejected tasks start their deadlines and dispute
+	// windows from their last update,
+	// tasks with a proof are on their first attempt
+	task, err := k.Task.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Open", task.Title)
+	require.Zero(t, task.Attempt)
+	task, err = k.Task.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), task.SubmittedAt)
+	require.Equal(t, uint64(1), task.Attempt)
+	task, err = k.Task.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(180), task.RejectedAt)
+	task, err = k.Task.Get(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), task.ClaimedAt)
+	require.Zero(t, task.Attempt)
+
+	// only open and claimed tasks are queued, submission deadlines are disabled
+	var queued []collections.Triple[int64, uint64, int32]
+	require.NoError(t, k.DeadlineQueue.Walk(ctx, nil, func(key collections.Triple[int64, uint64, int32]) (bool, error) {
+		queued = append(queued, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Triple[int64, uint64, int32]{
+		collections.Join3(int64(800), uint64(5), int32(types.DeadlineClaim)),
+		collections.Join3(int64(100+86400*120), uint64(0), int32(types.DeadlineTaskExpiry)),
+	}, queued)
+
+	_, err = k.Submission.Get(ctx, collections.Join(uint64(0), uint64(1)))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	tests := []struct {
+		id       uint64
+		expected types.Submission
+	}{
+		{
+			id: 1,
+			expected: types.Submission{
+				TaskId: 1, Attempt: 1, Submitter: claimant, SubmittedAt: 150,
+				Proof:   types.TaskProof{Hash: "bafy", Type: "ipfs", Timestamp: 150, Data: "pr#1"},
+				Outcome: types.SUBMISSION_OUTCOME_PENDING,
+			},
+		},
+		{
+			id: 2,
+			expected: types.Submission{
+				TaskId: 2, Attempt: 1, Submitter: claimant, SubmittedAt: 160,
+				Proof:   types.TaskProof{Hash: "https://example.com/pr/2", Type: "url", Timestamp: 160},
+				Outcome: types.SUBMISSION_OUTCOME_APPROVED, Reviewer: creator, ReviewedAt: 170,
+			},
+		},
+		{
+			id: 3,
+			expected: types.Submission{
+				TaskId: 3, Attempt: 1, Submitter: claimant, SubmittedAt: 180,
+				Outcome: types.SUBMISSION_OUTCOME_REJECTED, ReviewNote: "missing tests", ReviewedAt: 180,
+			},
+		},
+		{
+			id: 4,
+			expected: types.Submission{
+				TaskId: 4, Attempt: 1, Submitter: claimant, SubmittedAt: 190,
+				Proof:   types.TaskProof{Data: "not a flattened proof"},
+				Outcome: types.SUBMISSION_OUTCOME_PENDING,
+			},
+		},
+	}
+	for _, tc := range tests {
+		submission, err := k.Submission.Get(ctx, collections.Join(tc.id, uint64(1)))
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, submission)
+	}
+
+	// the secondary indexes of tasks and rewards are built
+	qs := keeper.NewQueryServerImpl(k)
+	byCreator, err := qs.TasksByCreator(ctx, &types.QueryTasksByCreatorRequest{Creator: creator})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Task, len(v1Tasks))
+
+	byClaimant, err := qs.TasksByClaimant(ctx, &types.QueryTasksByClaimantRequest{Claimant: claimant})
+	require.NoError(t, err)
+	require.Len(t, byClaimant.Task, len(v1Tasks)-1)
+
+	byStatus, err := qs.TasksByStatus(ctx, &types.QueryTasksByStatusRequest{Status: types.TASK_STATUS_SUBMITTED})
+	require.NoError(t, err)
+	require.Len(t, byStatus.Task, 2)
+
+	approved, err := k.Task.Indexes.Approver.MatchExact(ctx, creator)
+	require.NoError(t, err)
+	ids, err := approved.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, ids)
+
+	ids, err = k.Task.Indexes.Bounty.MatchExact(ctx, "stake", math.NewInt(5000))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2, 3, 4, 5}, ids)
+
+	rewards, err := qs.RewardsByClaimant(ctx, &types.QueryRewardsByClaimantRequest{Claimant: claimant})
+	require.NoError(t, err)
+	require.Equal(t, []types.TaskReward{{
+		TaskId: 2, Claimant: claimant, Amount: bountyCoins, Timestamp: 170, TxHash: "ABCD",
+	}}, rewards.TaskReward)
+}
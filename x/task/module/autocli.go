@@ -58,6 +58,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete task",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod: "ResolveDispute",
+					Skip:      true, // skipped because its note field clashes with the --note tx flag, see resolve-dispute
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
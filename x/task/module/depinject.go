@@ -1,12 +1,17 @@
 package task
 
 import (
+	"context"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"taskbounty/x/task/keeper"
@@ -33,8 +38,17 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper     types.AuthKeeper
+	BankKeeper     types.BankKeeper
+	FeeGrantKeeper feegrantkeeper.Keeper
+	// the provided feegrant keeper only gets its bank keeper inside the
+	// feegrant module, it needs one to create the grantee account
+	FeeGrantBankKeeper feegrant.BankKeeper
+	NFTKeeper          types.NFTKeeper
+	// the IBC keepers are created after the app is built, so the app supplies
+	// functions returning its channel and transfer keepers instead
+	ICS4WrapperFn    func() types.ICS4Wrapper    `optional:"true"`
+	TransferKeeperFn func() types.TransferKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -55,8 +69,40 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.BankKeeper,
+		feeGrantKeeper{
+			Keeper:       in.FeeGrantKeeper.SetBankKeeper(in.FeeGrantBankKeeper),
+			addressCodec: in.AddressCodec,
+		},
+		in.NFTKeeper,
+		in.ICS4WrapperFn,
+		in.TransferKeeperFn,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{TaskKeeper: k, Module: m}
 }
+
+// feeGrantKeeper adapts the feegrant keeper to types.FeeGrantKeeper, whose
+// revocation is only exposed through the feegrant msg server.
+type feeGrantKeeper struct {
+	feegrantkeeper.Keeper
+	addressCodec address.Codec
+}
+
+func (k feeGrantKeeper) RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	granterAddr, err := k.addressCodec.BytesToString(granter)
+	if err != nil {
+		return err
+	}
+	granteeAddr, err := k.addressCodec.BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+
+	_, err = feegrantkeeper.NewMsgServerImpl(k.Keeper).RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	})
+	return err
+}
@@ -0,0 +1,217 @@
+package task
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"taskbounty/x/task/keeper"
+	"taskbounty/x/task/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for the task IBC app, which acts
+// on tasks over unordered channels of the task port and reports their status
+// changes back.
+type IBCModule struct {
+	cdc    codec.Codec
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(cdc codec.Codec, k keeper.Keeper) IBCModule {
+	return IBCModule{
+		cdc:    cdc,
+		keeper: k,
+	}
+}
+
+// validateChannelParams checks the port and ordering of a task channel.
+func validateChannelParams(order channeltypes.Order, portID string) error {
+	if portID != types.PortID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, types.PortID)
+	}
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID string,
+	_ string,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := validateChannelParams(order, portID); err != nil {
+		return "", err
+	}
+
+	if version == "" {
+		version = types.Version
+	}
+	if version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID,
+	_ string,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateChannelParams(order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	// disallow user-initiated channel closing for task channels
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful packet is
+// acknowledged with the status change of the task, a failed one with an
+// error acknowledgement, in which case its state changes are discarded.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data types.TaskPacketData
+	if err := im.cdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ibcerrors.ErrUnknownRequest, "cannot unmarshal task packet data"))
+	}
+
+	var (
+		packetAck types.TaskPacketAck
+		err       error
+	)
+	switch p := data.Packet.(type) {
+	case *types.TaskPacketData_CreateTaskPacket:
+		packetAck, err = im.keeper.OnRecvCreateTaskPacket(ctx, packet, *p.CreateTaskPacket)
+	case *types.TaskPacketData_ClaimTaskPacket:
+		packetAck, err = im.keeper.OnRecvClaimTaskPacket(ctx, packet, *p.ClaimTaskPacket)
+	case *types.TaskPacketData_TaskStatusPacket:
+		packetAck, err = im.keeper.OnRecvTaskStatusPacket(ctx, packet, *p.TaskStatusPacket)
+	default:
+		err = errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, p)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	bz, err := im.cdc.MarshalJSON(&packetAck)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal task packet acknowledgement"))
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	var data types.TaskPacketData
+	if err := im.cdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrUnknownRequest, "cannot unmarshal task packet data")
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrUnknownRequest, err.Error())
+	}
+
+	return im.keeper.OnAcknowledgementTaskPacket(ctx, packet, data, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	var data types.TaskPacketData
+	if err := im.cdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrUnknownRequest, "cannot unmarshal task packet data")
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrUnknownRequest, err.Error())
+	}
+
+	return im.keeper.OnTimeoutTaskPacket(ctx, packet, data)
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerTaskID is charged for every task id an authorization checks, as
+// the staking authorization does for its validators.
+const gasCostPerTaskID = uint64(10)
+
+var (
+	_ authz.Authorization = &TaskApprovalAuthorization{}
+	_ authz.Authorization = &TaskClaimAuthorization{}
+)
+
+// NewTaskApprovalAuthorization returns an authorization to approve or reject
+// tasks, optionally limited by maxBounty and taskIDs.
+func NewTaskApprovalAuthorization(authorizationType TaskApprovalType, maxBounty sdk.Coins, taskIDs []uint64) *TaskApprovalAuthorization {
+	return &TaskApprovalAuthorization{
+		AuthorizationType: authorizationType,
+		MaxBounty:         maxBounty,
+		TaskIds:           taskIDs,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TaskApprovalAuthorization) MsgTypeURL() string {
+	if a.AuthorizationType == TASK_APPROVAL_TYPE_REJECT {
+		return sdk.MsgTypeURL(&MsgRejectTask{})
+	}
+	return sdk.MsgTypeURL(&MsgApproveTask{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TaskApprovalAuthorization) ValidateBasic() error {
+	if a.AuthorizationType != TASK_APPROVAL_TYPE_APPROVE && a.AuthorizationType != TASK_APPROVAL_TYPE_REJECT {
+		return authz.ErrUnknownAuthorizationType
+	}
+	return validateTaskAuthorization(a.MaxBounty, a.TaskIds)
+}
+
+// Accept implements Authorization.Accept. The grantee may approve or reject
+// the listed tasks, or any task when none are listed, as long as the message
+// bounds their bounty by the max bounty.
+func (a TaskApprovalAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var (
+		id           uint64
+		msgMaxBounty sdk.Coins
+	)
+	switch msg := msg.(type) {
+	case *MsgApproveTask:
+		if a.AuthorizationType != TASK_APPROVAL_TYPE_APPROVE {
+			return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "authorization does not allow approving tasks")
+		}
+		id, msgMaxBounty = msg.Id, msg.MaxBounty
+	case *MsgRejectTask:
+		if a.AuthorizationType != TASK_APPROVAL_TYPE_REJECT {
+			return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "authorization does not allow rejecting tasks")
+		}
+		id, msgMaxBounty = msg.Id, msg.MaxBounty
+	default:
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if err := acceptTask(ctx, a.MaxBounty, a.TaskIds, id, msgMaxBounty); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// NewTaskClaimAuthorization returns an authorization to claim tasks,
+// optionally limited by maxBounty and taskIDs.
+func NewTaskClaimAuthorization(maxBounty sdk.Coins, taskIDs []uint64) *TaskClaimAuthorization {
+	return &TaskClaimAuthorization{
+		MaxBounty: maxBounty,
+		TaskIds:   taskIDs,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TaskClaimAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgClaimTask{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TaskClaimAuthorization) ValidateBasic() error {
+	return validateTaskAuthorization(a.MaxBounty, a.TaskIds)
+}
+
+// Accept implements Authorization.Accept. The grantee may claim the listed
+// tasks, or any task when none are listed, as long as the message bounds their
+// bounty by the max bounty.
+func (a TaskClaimAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	claim, ok := msg.(*MsgClaimTask)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if err := acceptTask(ctx, a.MaxBounty, a.TaskIds, claim.Id, claim.MaxBounty); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func validateTaskAuthorization(maxBounty sdk.Coins, taskIDs []uint64) error {
+	if err := maxBounty.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max bounty: %s", err)
+	}
+
+	seen := make(map[uint64]bool, len(taskIDs))
+	for _, id := range taskIDs {
+		if seen[id] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate task id %d", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// acceptTask checks that task id is allowed by taskIDs and that msgMaxBounty,
+// which the msg server checks against the bounty of the task, does not exceed
+// maxBounty in any denom. Authorizations cannot read the task store, so a
+// message without a max bounty is not authorized when maxBounty is set.
+func acceptTask(ctx context.Context, maxBounty sdk.Coins, taskIDs []uint64, id uint64, msgMaxBounty sdk.Coins) error {
+	if len(taskIDs) > 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+		allowed := false
+		for _, taskID := range taskIDs {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerTaskID, "task authorization")
+			if taskID == id {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "task %d is not authorized", id)
+		}
+	}
+
+	if maxBounty.Empty() {
+		return nil
+	}
+	if msgMaxBounty.Empty() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "max bounty must be set to at most the authorized %s", maxBounty)
+	}
+	if !msgMaxBounty.IsAllLTE(maxBounty) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "max bounty %s exceeds the authorized %s", msgMaxBounty, maxBounty)
+	}
+
+	return nil
+}
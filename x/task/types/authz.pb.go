@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: taskbounty/task/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaskApprovalType is the task message a TaskApprovalAuthorization grants.
+type TaskApprovalType int32
+
+const (
+	TASK_APPROVAL_TYPE_UNSPECIFIED TaskApprovalType = 0
+	// MsgApproveTask
+	TASK_APPROVAL_TYPE_APPROVE TaskApprovalType = 1
+	// MsgRejectTask
+	TASK_APPROVAL_TYPE_REJECT TaskApprovalType = 2
+)
+
+var TaskApprovalType_name = map[int32]string{
+	0: "TASK_APPROVAL_TYPE_UNSPECIFIED",
+	1: "TASK_APPROVAL_TYPE_APPROVE",
+	2: "TASK_APPROVAL_TYPE_REJECT",
+}
+
+var TaskApprovalType_value = map[string]int32{
+	"TASK_APPROVAL_TYPE_UNSPECIFIED": 0,
+	"TASK_APPROVAL_TYPE_APPROVE":     1,
+	"TASK_APPROVAL_TYPE_REJECT":      2,
+}
+
+func (x TaskApprovalType) String() string {
+	return proto.EnumName(TaskApprovalType_name, int32(x))
+}
+
+func (TaskApprovalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_17d07532b803d380, []int{0}
+}
+
+// TaskApprovalAuthorization lets the grantee approve or reject submissions
+// on behalf of the granter, who must be allowed to review the task. Grants
+// stop at the expiration of the authz grant.
+type TaskApprovalAuthorization struct {
+	AuthorizationType TaskApprovalType `protobuf:"varint,1,opt,name=authorization_type,json=authorizationType,proto3,enum=taskbounty.task.v1.TaskApprovalType" json:"authorization_type,omitempty"`
+	// only messages whose max_bounty is set and does not exceed max_bounty in
+	// any of its denoms, so that the message fails on tasks with a larger
+	// bounty. Any message when empty.
+	MaxBounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_bounty,json=maxBounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bounty"`
+	// only these tasks, any task when empty
+	TaskIds []uint64 `protobuf:"varint,3,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+}
+
+func (m *TaskApprovalAuthorization) Reset()         { *m = TaskApprovalAuthorization{} }
+func (m *TaskApprovalAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TaskApprovalAuthorization) ProtoMessage()    {}
+func (*TaskApprovalAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17d07532b803d380, []int{0}
+}
+func (m *TaskApprovalAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskApprovalAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskApprovalAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskApprovalAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskApprovalAuthorization.Merge(m, src)
+}
+func (m *TaskApprovalAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskApprovalAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskApprovalAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskApprovalAuthorization proto.InternalMessageInfo
+
+func (m *TaskApprovalAuthorization) GetAuthorizationType() TaskApprovalType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return TASK_APPROVAL_TYPE_UNSPECIFIED
+}
+
+func (m *TaskApprovalAuthorization) GetMaxBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBounty
+	}
+	return nil
+}
+
+func (m *TaskApprovalAuthorization) GetTaskIds() []uint64 {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+// TaskClaimAuthorization lets the grantee, typically a bot, claim tasks on
+// behalf of the granter, who becomes the claimant. Grants stop at the
+// expiration of the authz grant.
+type TaskClaimAuthorization struct {
+	// only messages whose max_bounty is set and does not exceed max_bounty in
+	// any of its denoms, so that the message fails on tasks with a larger
+	// bounty. Any message when empty.
+	MaxBounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_bounty,json=maxBounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bounty"`
+	// only these tasks, any task when empty
+	TaskIds []uint64 `protobuf:"varint,2,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+}
+
+func (m *TaskClaimAuthorization) Reset()         { *m = TaskClaimAuthorization{} }
+func (m *TaskClaimAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TaskClaimAuthorization) ProtoMessage()    {}
+func (*TaskClaimAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17d07532b803d380, []int{1}
+}
+func (m *TaskClaimAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskClaimAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskClaimAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskClaimAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskClaimAuthorization.Merge(m, src)
+}
+func (m *TaskClaimAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskClaimAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskClaimAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskClaimAuthorization proto.InternalMessageInfo
+
+func (m *TaskClaimAuthorization) GetMaxBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBounty
+	}
+	return nil
+}
+
+func (m *TaskClaimAuthorization) GetTaskIds() []uint64 {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("taskbounty.task.v1.TaskApprovalType", TaskApprovalType_name, TaskApprovalType_value)
+	proto.RegisterType((*TaskApprovalAuthorization)(nil), "taskbounty.task.v1.TaskApprovalAuthorization")
+	proto.RegisterType((*TaskClaimAuthorization)(nil), "taskbounty.task.v1.TaskClaimAuthorization")
+}
+
+func init() { proto.RegisterFile("taskbounty/task/v1/authz.proto", fileDescriptor_17d07532b803d380) }
+
+var fileDescriptor_17d07532b803d380 = []byte{
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x49, 0x2c, 0xce,
+	0x4e, 0xca, 0x2f, 0xcd, 0x2b, 0xa9, 0xd4, 0x07, 0x31, 0xf5, 0xcb, 0x0c, 0xf5, 0x13, 0x4b, 0x4b,
+	0x32, 0xaa, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x10, 0xf2, 0x7a, 0x20, 0xa6, 0x5e,
+	0x99, 0xa1, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x93, 0x92, 0x4b,
+	0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
+	0x49, 0x34, 0xd4, 0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xca, 0x4b, 0x42, 0xe4, 0xe3, 0xc1, 0x3c, 0x7d,
+	0x08, 0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0xf7,
+	0x99, 0xb8, 0x24, 0x43, 0x12, 0x8b, 0xb3, 0x1d, 0x0b, 0x0a, 0x8a, 0xf2, 0xcb, 0x12, 0x73, 0x1c,
+	0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32, 0xab, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x84, 0x82, 0xb9, 0x84,
+	0x12, 0x91, 0x05, 0xe2, 0x4b, 0x2a, 0x0b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0xf8, 0x8c, 0x54,
+	0xf4, 0x30, 0x9d, 0xac, 0x87, 0x6c, 0x54, 0x48, 0x65, 0x41, 0x6a, 0x90, 0x20, 0x8a, 0x7e, 0x90,
+	0x90, 0x50, 0x03, 0x23, 0x17, 0x57, 0x6e, 0x62, 0x45, 0x3c, 0x44, 0xab, 0x04, 0x93, 0x02, 0xb3,
+	0x06, 0xb7, 0x91, 0xa4, 0x1e, 0xd4, 0xb1, 0x20, 0x9f, 0xe9, 0x41, 0x7d, 0xa6, 0xe7, 0x9c, 0x9f,
+	0x99, 0xe7, 0xe4, 0x76, 0xe2, 0x9e, 0x3c, 0xc3, 0xaa, 0xfb, 0xf2, 0x1a, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x50, 0x9f, 0x41, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0x7d, 0x90,
+	0xcb, 0x8a, 0xc1, 0x1a, 0x8a, 0x67, 0x3d, 0xdf, 0xa0, 0xc5, 0x93, 0x93, 0x9a, 0x9e, 0x98, 0x5c,
+	0x19, 0x0f, 0x0a, 0x9b, 0xe2, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x71, 0xe6, 0x26, 0x56, 0x38,
+	0x81, 0xed, 0x14, 0x92, 0xe4, 0xe2, 0x00, 0xb9, 0x38, 0x3e, 0x33, 0xa5, 0x58, 0x82, 0x59, 0x81,
+	0x59, 0x83, 0x25, 0x88, 0x1d, 0xc4, 0xf7, 0x4c, 0x29, 0xb6, 0xf2, 0x3f, 0xb5, 0x45, 0x57, 0x09,
+	0xea, 0x16, 0x48, 0x04, 0xc1, 0x1c, 0x83, 0x12, 0x34, 0x5d, 0xcf, 0x37, 0x68, 0xa9, 0x20, 0xc5,
+	0x29, 0xce, 0x30, 0x54, 0x6a, 0x61, 0xe2, 0x12, 0x03, 0xc9, 0x3a, 0xe7, 0x24, 0x66, 0xe6, 0xa2,
+	0x06, 0x2f, 0x5a, 0x48, 0x30, 0x0e, 0x70, 0x48, 0x30, 0xa1, 0x86, 0x84, 0x0f, 0xf1, 0x21, 0xa1,
+	0x88, 0x16, 0x12, 0x98, 0x7e, 0xd5, 0xaa, 0xe6, 0x12, 0x40, 0x4f, 0x1c, 0x42, 0x4a, 0x5c, 0x72,
+	0x21, 0x8e, 0xc1, 0xde, 0xf1, 0x8e, 0x01, 0x01, 0x41, 0xfe, 0x61, 0x8e, 0x3e, 0xf1, 0x21, 0x91,
+	0x01, 0xae, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c,
+	0x42, 0x72, 0x5c, 0x52, 0x58, 0xd4, 0x40, 0x78, 0xae, 0x02, 0x8c, 0x42, 0xb2, 0x5c, 0x92, 0x58,
+	0xe4, 0x83, 0x5c, 0xbd, 0x5c, 0x9d, 0x43, 0x04, 0x98, 0xa4, 0x58, 0x3a, 0x16, 0xcb, 0x31, 0x38,
+	0x19, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
+	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x38, 0x92, 0xcb, 0x2b,
+	0x20, 0x39, 0x13, 0x1c, 0x7e, 0x49, 0x6c, 0xe0, 0xfc, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
+	0x79, 0x1e, 0xed, 0x81, 0xb9, 0x03, 0x00, 0x00,
+}
+
+func (m *TaskApprovalAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskApprovalAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskApprovalAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		dAtA2 := make([]byte, len(m.TaskIds)*10)
+		var j1 int
+		for _, num := range m.TaskIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxBounty) > 0 {
+		for iNdEx := len(m.MaxBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskClaimAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskClaimAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskClaimAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		dAtA4 := make([]byte, len(m.TaskIds)*10)
+		var j3 int
+		for _, num := range m.TaskIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthz(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxBounty) > 0 {
+		for iNdEx := len(m.MaxBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskApprovalAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	if len(m.MaxBounty) > 0 {
+		for _, e := range m.MaxBounty {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.TaskIds) > 0 {
+		l = 0
+		for _, e := range m.TaskIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TaskClaimAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxBounty) > 0 {
+		for _, e := range m.MaxBounty {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.TaskIds) > 0 {
+		l = 0
+		for _, e := range m.TaskIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskApprovalAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskApprovalAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskApprovalAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= TaskApprovalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBounty = append(m.MaxBounty, types.Coin{})
+			if err := m.MaxBounty[len(m.MaxBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TaskIds = append(m.TaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TaskIds) == 0 {
+					m.TaskIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TaskIds = append(m.TaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskClaimAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskClaimAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskClaimAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBounty = append(m.MaxBounty, types.Coin{})
+			if err := m.MaxBounty[len(m.MaxBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TaskIds = append(m.TaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TaskIds) == 0 {
+					m.TaskIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TaskIds = append(m.TaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"cosmossdk.io/x/feegrant"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
@@ -15,10 +18,33 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgSubmitTask{},
 		&MsgApproveTask{},
 		&MsgRejectTask{},
+		&MsgReviewTask{},
+		&MsgDisputeTask{},
+		&MsgResolveDispute{},
+		&MsgUnclaimTask{},
+		&MsgReopenTask{},
+		&MsgCloseTask{},
+		&MsgSetTaskApprovers{},
+		&MsgSendCreateTask{},
+		&MsgSendClaimTask{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&TaskApprovalAuthorization{},
+		&TaskClaimAuthorization{},
+	)
+
+	registrar.RegisterImplementations((*feegrant.FeeAllowanceI)(nil),
+		&TaskFeeAllowance{},
+	)
+
+	// certificates are packed into the data of x/nft tokens
+	registrar.RegisterImplementations((*proto.Message)(nil),
+		&TaskCertificate{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -8,5 +8,7 @@ import (
 
 // x/task module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidVersion       = errors.Register(ModuleName, 1101, "invalid task channel version")
+	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1102, "invalid packet timeout")
 )
@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -17,9 +21,37 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
+// FeeGrantKeeper defines the expected interface for the FeeGrant module.
+type FeeGrantKeeper interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error
+}
+
+// NFTKeeper defines the expected interface for the NFT module.
+type NFTKeeper interface {
+	HasClass(ctx context.Context, classID string) bool
+	SaveClass(ctx context.Context, class nft.Class) error
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+}
+
+// ICS4Wrapper defines the expected interface to send packets over task channels.
+type ICS4Wrapper interface {
+	SendPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error)
+}
+
+// TransferKeeper defines the expected interface to look up the denom traces
+// of the IBC vouchers allowed as bounties.
+type TransferKeeper interface {
+	GetDenomFromIBCDenom(ctx sdk.Context, ibcDenom string) (ibctransfertypes.Denom, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ feegrant.FeeAllowanceI = &TaskFeeAllowance{}
+
+// NewTaskFeeAllowance returns an allowance paying up to spendLimit of fees for
+// submitting task id.
+func NewTaskFeeAllowance(id uint64, spendLimit sdk.Coins) *TaskFeeAllowance {
+	return &TaskFeeAllowance{
+		Limits: []TaskSpendLimit{{TaskId: id, SpendLimit: spendLimit}},
+	}
+}
+
+// Accept implements FeeAllowanceI.Accept. Every message must be a
+// MsgSubmitTask of the same listed task, and the fee is
+// deducted from that task's spend limit. The allowance is never removed here,
+// the module revokes it once the task leaves the claimant.
+func (a *TaskFeeAllowance) Accept(_ context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	id, err := feeAllowanceTaskID(msgs)
+	if err != nil {
+		return false, err
+	}
+
+	i := a.limitIndex(id)
+	if i < 0 {
+		return false, errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "task %d is not sponsored", id)
+	}
+
+	left, isNeg := a.Limits[i].SpendLimit.SafeSub(fee...)
+	if isNeg {
+		return false, errorsmod.Wrapf(feegrant.ErrFeeLimitExceeded, "fee allowance of task %d", id)
+	}
+	a.Limits[i].SpendLimit = left
+
+	return false, nil
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic.
+func (a *TaskFeeAllowance) ValidateBasic() error {
+	if len(a.Limits) == 0 {
+		return errorsmod.Wrap(feegrant.ErrNoMessages, "fee allowance must sponsor at least one task")
+	}
+
+	seen := make(map[uint64]bool, len(a.Limits))
+	for _, limit := range a.Limits {
+		if seen[limit.TaskId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate task id %d", limit.TaskId)
+		}
+		seen[limit.TaskId] = true
+
+		if err := limit.SpendLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit of task %d: %s", limit.TaskId, err)
+		}
+	}
+
+	return nil
+}
+
+// ExpiresAt implements FeeAllowanceI.ExpiresAt. The allowance has no expiry of
+// its own, it lives as long as the claim.
+func (a *TaskFeeAllowance) ExpiresAt() (*time.Time, error) {
+	return nil, nil
+}
+
+// SetLimit sponsors spendLimit of fees for task id, replacing any previous
+// limit of that task.
+func (a *TaskFeeAllowance) SetLimit(id uint64, spendLimit sdk.Coins) {
+	if i := a.limitIndex(id); i >= 0 {
+		a.Limits[i].SpendLimit = spendLimit
+		return
+	}
+	a.Limits = append(a.Limits, TaskSpendLimit{TaskId: id, SpendLimit: spendLimit})
+}
+
+// RemoveLimit stops sponsoring task id and reports whether it was sponsored.
+func (a *TaskFeeAllowance) RemoveLimit(id uint64) bool {
+	i := a.limitIndex(id)
+	if i < 0 {
+		return false
+	}
+	a.Limits = append(a.Limits[:i], a.Limits[i+1:]...)
+	return true
+}
+
+func (a *TaskFeeAllowance) limitIndex(id uint64) int {
+	for i, limit := range a.Limits {
+		if limit.TaskId == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// feeAllowanceTaskID returns the task all msgs act on, which must be a
+// MsgSubmitTask. The allowance is only granted once the task is claimed, so it
+// never pays for the claim.
+func feeAllowanceTaskID(msgs []sdk.Msg) (uint64, error) {
+	if len(msgs) == 0 {
+		return 0, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "no messages")
+	}
+
+	var id uint64
+	for i, msg := range msgs {
+		submit, ok := msg.(*MsgSubmitTask)
+		if !ok {
+			return 0, errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "message %s is not allowed", sdk.MsgTypeURL(msg))
+		}
+
+		if i > 0 && submit.Id != id {
+			return 0, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "messages must act on a single task")
+		}
+		id = submit.Id
+	}
+
+	return id, nil
+}
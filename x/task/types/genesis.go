@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
@@ -12,17 +16,187 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	taskIdMap := make(map[uint64]bool)
+	tasks := make(map[uint64]Task)
 	taskCount := gs.GetTaskCount()
 	for _, elem := range gs.TaskList {
-		if _, ok := taskIdMap[elem.Id]; ok {
+		if _, ok := tasks[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for task")
 		}
 		if elem.Id >= taskCount {
 			return fmt.Errorf("task id should be lower or equal than the last id")
 		}
-		taskIdMap[elem.Id] = true
+		tasks[elem.Id] = elem
+	}
+
+	// archived tasks are closed tasks moved out of the task store, so records
+	// of a task may reference either list
+	for _, elem := range gs.ArchivedTaskList {
+		if _, ok := tasks[elem.Id]; ok {
+			return fmt.Errorf("duplicated id %d for archived task", elem.Id)
+		}
+		if elem.Id >= taskCount {
+			return fmt.Errorf("archived task id should be lower or equal than the last id")
+		}
+		if elem.Status != TASK_STATUS_CLOSED {
+			return fmt.Errorf("archived task %d is not closed", elem.Id)
+		}
+		tasks[elem.Id] = elem
+	}
+
+	// deleting a task keeps the records of its disputes, reviews, submissions
+	// and history as its audit trail, so only their task id is checked
+	disputes := make(map[[2]uint64]bool)
+	for _, elem := range gs.TaskDisputeList {
+		key := [2]uint64{elem.TaskId, elem.Seq}
+		if disputes[key] {
+			return fmt.Errorf("duplicated dispute %d for task %d", elem.Seq, elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("dispute %d references unknown task %d", elem.Seq, elem.TaskId)
+		}
+		disputes[key] = true
+	}
+
+	// a paid task is approved, or closed once the creator closes it or an
+	// arbiter splits its bounty, and its reward outlives the task when it is
+	// deleted, which its history must show happened after it was paid
+	rewards := make(map[uint64]bool)
+	for _, elem := range gs.TaskRewardList {
+		if rewards[elem.TaskId] {
+			return fmt.Errorf("duplicated reward for task %d", elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("reward references unknown task %d", elem.TaskId)
+		}
+		if task, ok := tasks[elem.TaskId]; ok {
+			if task.Status != TASK_STATUS_APPROVED && task.Status != TASK_STATUS_CLOSED {
+				return fmt.Errorf("reward references task %d which is not approved or closed", elem.TaskId)
+			}
+			if elem.Claimant != task.Claimant {
+				return fmt.Errorf("reward claimant %s does not match claimant of task %d", elem.Claimant, elem.TaskId)
+			}
+		} else if !gs.isDeletedAfterPayout(elem.TaskId) {
+			return fmt.Errorf("reward references task %d which was not deleted after it was paid", elem.TaskId)
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid reward amount for task %d: %w", elem.TaskId, err)
+		}
+		rewards[elem.TaskId] = true
+	}
+
+	// refunds of deleted tasks outlive their task, so only the id is checked
+	refunds := make(map[uint64]bool)
+	for _, elem := range gs.TaskRefundList {
+		if refunds[elem.TaskId] {
+			return fmt.Errorf("duplicated refund for task %d", elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("refund references unknown task %d", elem.TaskId)
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid refund amount for task %d: %w", elem.TaskId, err)
+		}
+		refunds[elem.TaskId] = true
+	}
+
+	reviews := make(map[string]bool)
+	for _, elem := range gs.TaskReviewList {
+		key := fmt.Sprintf("%d/%s", elem.TaskId, elem.Reviewer)
+		if reviews[key] {
+			return fmt.Errorf("duplicated review by %s for task %d", elem.Reviewer, elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("review references unknown task %d", elem.TaskId)
+		}
+		reviews[key] = true
+	}
+
+	submissions := make(map[[2]uint64]bool)
+	for _, elem := range gs.SubmissionList {
+		key := [2]uint64{elem.TaskId, elem.Attempt}
+		if submissions[key] {
+			return fmt.Errorf("duplicated submission %d for task %d", elem.Attempt, elem.TaskId)
+		}
+		if elem.Attempt == 0 {
+			return fmt.Errorf("submission attempts of task %d start at 1", elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("submission %d references unknown task %d", elem.Attempt, elem.TaskId)
+		}
+		submissions[key] = true
+	}
+
+	transitions := make(map[[2]uint64]bool)
+	for _, elem := range gs.TaskHistoryList {
+		key := [2]uint64{elem.TaskId, elem.Seq}
+		if transitions[key] {
+			return fmt.Errorf("duplicated transition %d for task %d", elem.Seq, elem.TaskId)
+		}
+		if elem.TaskId >= taskCount {
+			return fmt.Errorf("transition %d references unknown task %d", elem.Seq, elem.TaskId)
+		}
+		transitions[key] = true
+	}
+
+	// remote tasks live on other chains, only their keys are checked
+	remoteTasks := make(map[string]bool)
+	for _, elem := range gs.RemoteTaskList {
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel of remote task %d: %w", elem.TaskId, err)
+		}
+		key := fmt.Sprintf("%s/%d", elem.ChannelId, elem.TaskId)
+		if remoteTasks[key] {
+			return fmt.Errorf("duplicated remote task %d on channel %s", elem.TaskId, elem.ChannelId)
+		}
+		remoteTasks[key] = true
+	}
+
+	// remote senders map the remote accounts of this chain back to the chain
+	// their senders act from
+	remoteSenders := make(map[string]bool)
+	for _, elem := range gs.RemoteSenderList {
+		if elem.Address == "" {
+			return fmt.Errorf("remote sender %s has no address", elem.Sender)
+		}
+		if remoteSenders[elem.Address] {
+			return fmt.Errorf("duplicated remote sender %s", elem.Address)
+		}
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel of remote sender %s: %w", elem.Address, err)
+		}
+		if err := validateSender(elem.Sender); err != nil {
+			return fmt.Errorf("invalid remote sender %s: %w", elem.Address, err)
+		}
+		remoteSenders[elem.Address] = true
 	}
 
 	return gs.Params.Validate()
 }
+
+// isDeletedAfterPayout reports whether the history of task id shows it was
+// approved, or closed by an arbiter splitting its bounty, before it was
+// deleted.
+func (gs GenesisState) isDeletedAfterPayout(id uint64) bool {
+	var paid bool
+	var paidSeq uint64
+	for _, elem := range gs.TaskHistoryList {
+		if elem.TaskId != id {
+			continue
+		}
+		if elem.To == TASK_STATUS_APPROVED || (elem.From == TASK_STATUS_DISPUTED && elem.To == TASK_STATUS_CLOSED) {
+			if !paid || elem.Seq < paidSeq {
+				paid, paidSeq = true, elem.Seq
+			}
+		}
+	}
+	if !paid {
+		return false
+	}
+
+	for _, elem := range gs.TaskHistoryList {
+		if elem.TaskId == id && elem.To == TASK_STATUS_UNDEFINED && elem.Seq > paidSeq {
+			return true
+		}
+	}
+	return false
+}
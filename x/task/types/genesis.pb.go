@@ -27,9 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the task module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params    Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TaskList  []Task `protobuf:"bytes,2,rep,name=task_list,json=taskList,proto3" json:"task_list"`
-	TaskCount uint64 `protobuf:"varint,3,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	Params           Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TaskList         []Task           `protobuf:"bytes,2,rep,name=task_list,json=taskList,proto3" json:"task_list"`
+	TaskCount        uint64           `protobuf:"varint,3,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	TaskRewardList   []TaskReward     `protobuf:"bytes,4,rep,name=task_reward_list,json=taskRewardList,proto3" json:"task_reward_list"`
+	TaskRefundList   []TaskRefund     `protobuf:"bytes,5,rep,name=task_refund_list,json=taskRefundList,proto3" json:"task_refund_list"`
+	TaskReviewList   []TaskReview     `protobuf:"bytes,6,rep,name=task_review_list,json=taskReviewList,proto3" json:"task_review_list"`
+	TaskDisputeList  []TaskDispute    `protobuf:"bytes,7,rep,name=task_dispute_list,json=taskDisputeList,proto3" json:"task_dispute_list"`
+	SubmissionList   []Submission     `protobuf:"bytes,8,rep,name=submission_list,json=submissionList,proto3" json:"submission_list"`
+	TaskHistoryList  []TaskTransition `protobuf:"bytes,9,rep,name=task_history_list,json=taskHistoryList,proto3" json:"task_history_list"`
+	ArchivedTaskList []Task           `protobuf:"bytes,10,rep,name=archived_task_list,json=archivedTaskList,proto3" json:"archived_task_list"`
+	RemoteTaskList   []RemoteTask     `protobuf:"bytes,11,rep,name=remote_task_list,json=remoteTaskList,proto3" json:"remote_task_list"`
+	RemoteSenderList []RemoteSender   `protobuf:"bytes,12,rep,name=remote_sender_list,json=remoteSenderList,proto3" json:"remote_sender_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +95,69 @@ func (m *GenesisState) GetTaskCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTaskRewardList() []TaskReward {
+	if m != nil {
+		return m.TaskRewardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskRefundList() []TaskRefund {
+	if m != nil {
+		return m.TaskRefundList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskReviewList() []TaskReview {
+	if m != nil {
+		return m.TaskReviewList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskDisputeList() []TaskDispute {
+	if m != nil {
+		return m.TaskDisputeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubmissionList() []Submission {
+	if m != nil {
+		return m.SubmissionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskHistoryList() []TaskTransition {
+	if m != nil {
+		return m.TaskHistoryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedTaskList() []Task {
+	if m != nil {
+		return m.ArchivedTaskList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteTaskList() []RemoteTask {
+	if m != nil {
+		return m.RemoteTaskList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteSenderList() []RemoteSender {
+	if m != nil {
+		return m.RemoteSenderList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "taskbounty.task.v1.GenesisState")
 }
@@ -93,24 +165,38 @@ func init() {
 func init() { proto.RegisterFile("taskbounty/task/v1/genesis.proto", fileDescriptor_f559d27766a90ec3) }
 
 var fileDescriptor_f559d27766a90ec3 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x49, 0x2c, 0xce,
-	0x4e, 0xca, 0x2f, 0xcd, 0x2b, 0xa9, 0xd4, 0x07, 0x31, 0xf5, 0xcb, 0x0c, 0xf5, 0xd3, 0x53, 0xf3,
-	0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x10, 0x2a, 0xf4, 0x40,
-	0x4c, 0xbd, 0x32, 0x43, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x26,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x79, 0x2c, 0xc6, 0x17,
-	0x24, 0x16, 0x25, 0xe6, 0x42, 0x4d, 0x97, 0x92, 0xc5, 0xa2, 0x00, 0x6c, 0x0b, 0x58, 0x5a, 0x69,
-	0x25, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x39, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xb6, 0x5c, 0x6c,
-	0x10, 0xfd, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x98, 0xce, 0xd3, 0x0b, 0x00,
-	0xab, 0x70, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x4d,
-	0x42, 0xd6, 0x5c, 0x9c, 0x20, 0x45, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x12, 0xd8, 0x4c, 0x08, 0x49, 0x2c, 0xce, 0x76, 0x62, 0x01, 0xe9, 0x0f, 0xe2, 0x00,
-	0x89, 0xf9, 0x64, 0x16, 0x97, 0x08, 0xc9, 0x72, 0x71, 0x81, 0x35, 0x27, 0x83, 0xd4, 0x4a, 0x30,
-	0x2b, 0x30, 0x6a, 0xb0, 0x04, 0x81, 0x8d, 0x73, 0x06, 0x09, 0x38, 0x19, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x38, 0x92, 0x27, 0x2b, 0x20, 0xde, 0x2c, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xd2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x76, 0x02, 0x4f,
-	0x56, 0x86, 0x01, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x56, 0xba, 0xd5, 0x9d, 0xb6, 0xce, 0x42, 0xa2, 0xaa, 0xb4, 0x34, 0xda, 0xa9,
+	0xe2, 0x90, 0xa8, 0xe3, 0x88, 0xb8, 0x14, 0x24, 0x38, 0x8c, 0x09, 0xd2, 0x9e, 0xb8, 0x4c, 0x6e,
+	0x6b, 0x3a, 0xab, 0x24, 0x8e, 0x6c, 0x27, 0xa3, 0x0f, 0xc0, 0x9d, 0xc7, 0xe0, 0xc8, 0x63, 0xec,
+	0xb8, 0x23, 0x27, 0x84, 0xda, 0x03, 0xaf, 0x81, 0xfc, 0xb7, 0xd3, 0x64, 0xd0, 0x50, 0x2e, 0xd1,
+	0x5f, 0x5f, 0xbe, 0xff, 0xef, 0xfb, 0x2c, 0xd9, 0xc8, 0x53, 0x44, 0x2e, 0x26, 0x3c, 0x8d, 0xd5,
+	0x32, 0xd0, 0x63, 0x90, 0x0d, 0x82, 0x39, 0x8d, 0xa9, 0x64, 0xd2, 0x4f, 0x04, 0x57, 0x1c, 0xe3,
+	0xc2, 0xe1, 0xeb, 0xd1, 0xcf, 0x06, 0xdd, 0x13, 0x12, 0xb1, 0x98, 0x07, 0xf0, 0x35, 0xb6, 0xee,
+	0xa3, 0x39, 0x9f, 0x73, 0x18, 0x03, 0x3d, 0x59, 0xb5, 0xb7, 0x05, 0x9f, 0x90, 0xe9, 0x82, 0xaa,
+	0x7f, 0x1a, 0x04, 0x89, 0x6c, 0x7c, 0xf7, 0x74, 0x8b, 0x01, 0x6a, 0xc0, 0xef, 0xb3, 0xcf, 0xfb,
+	0xe8, 0xf0, 0x95, 0xe9, 0x3b, 0x52, 0x44, 0x51, 0xfc, 0x1c, 0x35, 0xcc, 0x7e, 0xc7, 0xf1, 0x9c,
+	0x7e, 0xeb, 0xbc, 0xeb, 0xff, 0xdd, 0xdf, 0x7f, 0x0b, 0x8e, 0x61, 0xf3, 0xf6, 0x47, 0xaf, 0xf6,
+	0xf5, 0xd7, 0xb7, 0x27, 0x4e, 0x68, 0x97, 0xf0, 0x33, 0xd4, 0xd4, 0xa6, 0xab, 0x8f, 0x4c, 0xaa,
+	0xce, 0x03, 0x6f, 0xaf, 0xdf, 0x3a, 0xef, 0x6c, 0x23, 0x8c, 0x89, 0x5c, 0x0c, 0xeb, 0x7a, 0x3f,
+	0x3c, 0xd0, 0xda, 0x05, 0x93, 0x0a, 0x9f, 0x22, 0x04, 0xcb, 0x53, 0xed, 0xed, 0xec, 0x79, 0x4e,
+	0xbf, 0x1e, 0x02, 0xee, 0x85, 0x16, 0xf0, 0x25, 0x6a, 0xc3, 0x6f, 0x41, 0x6f, 0x88, 0x98, 0x99,
+	0x88, 0x3a, 0x44, 0xb8, 0x55, 0x11, 0x21, 0x58, 0x6d, 0xd0, 0x91, 0xda, 0x28, 0x10, 0x57, 0xf0,
+	0x3e, 0xa4, 0xb1, 0xe5, 0x3d, 0xdc, 0xc5, 0xd3, 0xd6, 0xfb, 0x3c, 0xad, 0xfc, 0xc1, 0xcb, 0x18,
+	0xbd, 0x31, 0xbc, 0xc6, 0x2e, 0x9e, 0xb6, 0xde, 0xe7, 0x69, 0x05, 0x78, 0xef, 0xd0, 0x09, 0xf0,
+	0x66, 0x4c, 0x26, 0xa9, 0xa2, 0x06, 0xb8, 0x0f, 0xc0, 0x5e, 0x15, 0xf0, 0xa5, 0xf1, 0x5a, 0xe2,
+	0xb1, 0x2a, 0x24, 0x40, 0xbe, 0x41, 0xc7, 0x32, 0x9d, 0x44, 0x4c, 0x4a, 0xc6, 0x63, 0x03, 0x3c,
+	0xa8, 0x6e, 0x38, 0xda, 0x58, 0xf3, 0x86, 0xc5, 0x32, 0xe0, 0xc6, 0xb6, 0xe1, 0x35, 0x93, 0x8a,
+	0x8b, 0xa5, 0x01, 0x36, 0x01, 0x78, 0x56, 0xd5, 0x70, 0x2c, 0x48, 0x2c, 0x99, 0x2a, 0xa0, 0x50,
+	0xf2, 0xb5, 0x21, 0x00, 0xf5, 0x02, 0x61, 0x22, 0xa6, 0xd7, 0x2c, 0xa3, 0xb3, 0xab, 0xe2, 0x32,
+	0xa1, 0xff, 0xba, 0x4c, 0xed, 0x7c, 0x73, 0x9c, 0x5f, 0xaa, 0x4b, 0xd4, 0x16, 0x34, 0xe2, 0x8a,
+	0x96, 0x58, 0xad, 0xea, 0x33, 0x87, 0xe0, 0x2d, 0x11, 0x8f, 0xc4, 0x46, 0xb1, 0x67, 0xc6, 0x96,
+	0x27, 0x69, 0x3c, 0xa3, 0xc2, 0x10, 0x0f, 0x81, 0xe8, 0x55, 0x13, 0x47, 0x60, 0xce, 0x5b, 0x8a,
+	0x92, 0xa6, 0xa9, 0xc3, 0xc1, 0xed, 0xca, 0x75, 0xee, 0x56, 0xae, 0xf3, 0x73, 0xe5, 0x3a, 0x5f,
+	0xd6, 0x6e, 0xed, 0x6e, 0xed, 0xd6, 0xbe, 0xaf, 0xdd, 0xda, 0xfb, 0xc7, 0xa5, 0x07, 0xfc, 0xc9,
+	0x3c, 0x61, 0xb5, 0x4c, 0xa8, 0x9c, 0x34, 0xe0, 0x05, 0x3f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff,
+	0x48, 0xb9, 0xfa, 0xe5, 0x83, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +219,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoteSenderList) > 0 {
+		for iNdEx := len(m.RemoteSenderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteSenderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RemoteTaskList) > 0 {
+		for iNdEx := len(m.RemoteTaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ArchivedTaskList) > 0 {
+		for iNdEx := len(m.ArchivedTaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TaskHistoryList) > 0 {
+		for iNdEx := len(m.TaskHistoryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskHistoryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SubmissionList) > 0 {
+		for iNdEx := len(m.SubmissionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmissionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TaskDisputeList) > 0 {
+		for iNdEx := len(m.TaskDisputeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskDisputeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TaskReviewList) > 0 {
+		for iNdEx := len(m.TaskReviewList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskReviewList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TaskRefundList) > 0 {
+		for iNdEx := len(m.TaskRefundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskRefundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TaskRewardList) > 0 {
+		for iNdEx := len(m.TaskRewardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskRewardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TaskCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TaskCount))
 		i--
@@ -193,6 +405,60 @@ func (m *GenesisState) Size() (n int) {
 	if m.TaskCount != 0 {
 		n += 1 + sovGenesis(uint64(m.TaskCount))
 	}
+	if len(m.TaskRewardList) > 0 {
+		for _, e := range m.TaskRewardList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskRefundList) > 0 {
+		for _, e := range m.TaskRefundList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskReviewList) > 0 {
+		for _, e := range m.TaskReviewList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskDisputeList) > 0 {
+		for _, e := range m.TaskDisputeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubmissionList) > 0 {
+		for _, e := range m.SubmissionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskHistoryList) > 0 {
+		for _, e := range m.TaskHistoryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedTaskList) > 0 {
+		for _, e := range m.ArchivedTaskList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemoteTaskList) > 0 {
+		for _, e := range m.RemoteTaskList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemoteSenderList) > 0 {
+		for _, e := range m.RemoteSenderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -317,6 +583,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRewardList = append(m.TaskRewardList, TaskReward{})
+			if err := m.TaskRewardList[len(m.TaskRewardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRefundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRefundList = append(m.TaskRefundList, TaskRefund{})
+			if err := m.TaskRefundList[len(m.TaskRefundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReviewList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskReviewList = append(m.TaskReviewList, TaskReview{})
+			if err := m.TaskReviewList[len(m.TaskReviewList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDisputeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskDisputeList = append(m.TaskDisputeList, TaskDispute{})
+			if err := m.TaskDisputeList[len(m.TaskDisputeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmissionList = append(m.SubmissionList, Submission{})
+			if err := m.SubmissionList[len(m.SubmissionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskHistoryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskHistoryList = append(m.TaskHistoryList, TaskTransition{})
+			if err := m.TaskHistoryList[len(m.TaskHistoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedTaskList = append(m.ArchivedTaskList, Task{})
+			if err := m.ArchivedTaskList[len(m.ArchivedTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteTaskList = append(m.RemoteTaskList, RemoteTask{})
+			if err := m.RemoteTaskList[len(m.RemoteTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteSenderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteSenderList = append(m.RemoteSenderList, RemoteSender{})
+			if err := m.RemoteSenderList[len(m.RemoteSenderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
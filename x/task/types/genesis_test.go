@@ -3,12 +3,18 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"taskbounty/x/task/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	claimant := sdk.AccAddress([]byte("claimantAddr________________")).String()
+	bounty := sdk.NewCoins(types.DefaultParams().MinBounty)
+	remoteSender := sdk.MustBech32ifyAddressBytes("osmo", []byte("remoteSender________________"))
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,7 +27,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{TaskList: []types.Task{{Id: 0}, {Id: 1}}, TaskCount: 2}, valid: true,
+			genState: &types.GenesisState{Params: types.DefaultParams(), TaskList: []types.Task{{Id: 0}, {Id: 1}}, TaskCount: 2}, valid: true,
 		}, {
 			desc: "duplicated task",
 			genState: &types.GenesisState{
@@ -46,6 +52,318 @@ func TestGenesisState_Validate(t *testing.T) {
 				TaskCount: 0,
 			},
 			valid: false,
+		}, {
+			desc: "valid auxiliary state",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TaskList:        []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_APPROVED}, {Id: 1, Claimant: claimant, Status: types.TASK_STATUS_CLOSED}},
+				TaskCount:       3,
+				TaskRewardList:  []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}, {TaskId: 1, Claimant: claimant, Amount: bounty}},
+				TaskRefundList:  []types.TaskRefund{{TaskId: 1, Amount: bounty}, {TaskId: 2, Amount: bounty}},
+				TaskDisputeList: []types.TaskDispute{{TaskId: 1, Seq: 1, Resolution: types.DISPUTE_RESOLUTION_SPLIT}},
+				SubmissionList:  []types.Submission{{TaskId: 0, Attempt: 1}, {TaskId: 1, Attempt: 1}},
+				TaskReviewList:  []types.TaskReview{{TaskId: 0, Reviewer: claimant}},
+				TaskHistoryList: []types.TaskTransition{{TaskId: 0, Seq: 0}, {TaskId: 0, Seq: 1}, {TaskId: 2, Seq: 0}},
+			},
+			valid: true,
+		}, {
+			desc: "reward beyond task count",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 1, Claimant: claimant, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "reward of task deleted after approval",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+				TaskHistoryList: []types.TaskTransition{
+					{TaskId: 0, Seq: 0, From: types.TASK_STATUS_SUBMITTED, To: types.TASK_STATUS_APPROVED},
+					{TaskId: 0, Seq: 1, From: types.TASK_STATUS_APPROVED, To: types.TASK_STATUS_CLOSED},
+					{TaskId: 0, Seq: 2, From: types.TASK_STATUS_CLOSED, To: types.TASK_STATUS_UNDEFINED},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "reward of task deleted after a split",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+				TaskHistoryList: []types.TaskTransition{
+					{TaskId: 0, Seq: 0, From: types.TASK_STATUS_DISPUTED, To: types.TASK_STATUS_CLOSED},
+					{TaskId: 0, Seq: 1, From: types.TASK_STATUS_CLOSED, To: types.TASK_STATUS_UNDEFINED},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "reward without task",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "reward of task deleted without approval",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+				TaskHistoryList: []types.TaskTransition{
+					{TaskId: 0, Seq: 0, From: types.TASK_STATUS_OPEN, To: types.TASK_STATUS_UNDEFINED},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "reward of approved task missing from the task list",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+				TaskHistoryList: []types.TaskTransition{
+					{TaskId: 0, Seq: 0, From: types.TASK_STATUS_SUBMITTED, To: types.TASK_STATUS_APPROVED},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "reward for task that is not approved",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_SUBMITTED}},
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "reward for task closed after approval",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_CLOSED}},
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: true,
+		}, {
+			desc: "reward for archived task",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				TaskCount:        1,
+				ArchivedTaskList: []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_CLOSED}},
+				TaskRewardList:   []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: true,
+		}, {
+			desc: "reward for open task",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0, Status: types.TASK_STATUS_OPEN}},
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "reward claimant mismatch",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_APPROVED}},
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: "other", Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "reward amount not sorted",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				TaskList:  []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_APPROVED}},
+				TaskCount: 1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: sdk.Coins{
+					sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("stake", 500),
+				}}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated reward",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_APPROVED}},
+				TaskCount:      1,
+				TaskRewardList: []types.TaskReward{{TaskId: 0, Claimant: claimant, Amount: bounty}, {TaskId: 0, Claimant: claimant, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "refund beyond task count",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskRefundList: []types.TaskRefund{{TaskId: 1, Amount: bounty}},
+			},
+			valid: false,
+		}, {
+			desc: "submission beyond task count",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				SubmissionList: []types.Submission{{TaskId: 1, Attempt: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "records of deleted task",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TaskCount:       1,
+				SubmissionList:  []types.Submission{{TaskId: 0, Attempt: 1}},
+				TaskReviewList:  []types.TaskReview{{TaskId: 0, Reviewer: claimant}},
+				TaskDisputeList: []types.TaskDispute{{TaskId: 0, Seq: 1}},
+			},
+			valid: true,
+		}, {
+			desc: "submission attempt zero",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskList:       []types.Task{{Id: 0}},
+				TaskCount:      1,
+				SubmissionList: []types.Submission{{TaskId: 0, Attempt: 0}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated dispute",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TaskList:        []types.Task{{Id: 0}},
+				TaskCount:       1,
+				TaskDisputeList: []types.TaskDispute{{TaskId: 0, Seq: 1}, {TaskId: 0, Seq: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "review beyond task count",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				TaskCount:      1,
+				TaskReviewList: []types.TaskReview{{TaskId: 1, Reviewer: claimant}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated transition",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TaskCount:       1,
+				TaskHistoryList: []types.TaskTransition{{TaskId: 0, Seq: 0}, {TaskId: 0, Seq: 0}},
+			},
+			valid: false,
+		}, {
+			desc: "transition for unknown task",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TaskCount:       1,
+				TaskHistoryList: []types.TaskTransition{{TaskId: 1, Seq: 0}},
+			},
+			valid: false,
+		}, {
+			desc: "records of archived task",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				TaskCount:        1,
+				ArchivedTaskList: []types.Task{{Id: 0, Claimant: claimant, Status: types.TASK_STATUS_CLOSED}},
+				SubmissionList:   []types.Submission{{TaskId: 0, Attempt: 1}},
+			},
+			valid: true,
+		}, {
+			desc: "archived task that is not closed",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				TaskCount:        1,
+				ArchivedTaskList: []types.Task{{Id: 0, Status: types.TASK_STATUS_OPEN}},
+			},
+			valid: false,
+		}, {
+			desc: "archived task also in task list",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				TaskList:         []types.Task{{Id: 0, Status: types.TASK_STATUS_CLOSED}},
+				TaskCount:        1,
+				ArchivedTaskList: []types.Task{{Id: 0, Status: types.TASK_STATUS_CLOSED}},
+			},
+			valid: false,
+		}, {
+			desc: "archived task beyond task count",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				TaskCount:        1,
+				ArchivedTaskList: []types.Task{{Id: 1, Status: types.TASK_STATUS_CLOSED}},
+			},
+			valid: false,
+		}, {
+			desc: "remote tasks",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RemoteTaskList: []types.RemoteTask{
+					{ChannelId: "channel-0", TaskId: 0, Status: types.TASK_STATUS_OPEN},
+					{ChannelId: "channel-1", TaskId: 0, Status: types.TASK_STATUS_CLAIMED},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated remote task",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RemoteTaskList: []types.RemoteTask{
+					{ChannelId: "channel-0", TaskId: 0, Status: types.TASK_STATUS_OPEN},
+					{ChannelId: "channel-0", TaskId: 0, Status: types.TASK_STATUS_CLAIMED},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "remote task with invalid channel",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RemoteTaskList: []types.RemoteTask{{ChannelId: "", TaskId: 0}},
+			},
+			valid: false,
+		}, {
+			desc: "valid remote senders",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RemoteSenderList: []types.RemoteSender{
+					{Address: "cosmos1remote0", ChannelId: "channel-0", Sender: remoteSender},
+					{Address: "cosmos1remote1", ChannelId: "channel-1", Sender: remoteSender},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated remote sender",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RemoteSenderList: []types.RemoteSender{
+					{Address: "cosmos1remote0", ChannelId: "channel-0", Sender: remoteSender},
+					{Address: "cosmos1remote0", ChannelId: "channel-1", Sender: remoteSender},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "remote sender with invalid channel",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				RemoteSenderList: []types.RemoteSender{{Address: "cosmos1remote0", Sender: remoteSender}},
+			},
+			valid: false,
+		}, {
+			desc: "remote sender without sender",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				RemoteSenderList: []types.RemoteSender{{Address: "cosmos1remote0", ChannelId: "channel-0"}},
+			},
+			valid: false,
+		}, {
+			desc: "remote sender with invalid address",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				RemoteSenderList: []types.RemoteSender{{Address: "cosmos1remote0", ChannelId: "channel-0", Sender: "osmo1sender"}},
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
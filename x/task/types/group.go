@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// NewMsgProposeApproveTask returns a group proposal that approves the
+// submission of task id on behalf of the groupPolicy approver. Nominating an
+// x/group policy address as approver lets its members review submissions
+// collectively: the proposal is executed once the decision policy accepts it,
+// so the task's submission deadline should leave room for the voting period.
+func NewMsgProposeApproveTask(groupPolicy string, proposers []string, id uint64, exec group.Exec, title, summary string) (*group.MsgSubmitProposal, error) {
+	return group.NewMsgSubmitProposal(groupPolicy, proposers, []sdk.Msg{NewMsgApproveTask(groupPolicy, id)}, "", exec, title, summary)
+}
+
+// NewMsgProposeRejectTask returns a group proposal that rejects the
+// submission of task id with reason on behalf of the groupPolicy approver.
+func NewMsgProposeRejectTask(groupPolicy string, proposers []string, id uint64, reason string, exec group.Exec, title, summary string) (*group.MsgSubmitProposal, error) {
+	return group.NewMsgSubmitProposal(groupPolicy, proposers, []sdk.Msg{NewMsgRejectTask(groupPolicy, id, reason)}, "", exec, title, summary)
+}
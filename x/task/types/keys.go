@@ -13,12 +13,38 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// PortID is the port of the task IBC app
+	PortID = ModuleName
+
+	// Version is the version of the task IBC app
+	Version = "task-1"
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_task")
 
 var (
-	TaskKey      = collections.NewPrefix("task/value/")
-	TaskCountKey = collections.NewPrefix("task/count/")
+	TaskKey         = collections.NewPrefix("task/value/")
+	TaskCountKey    = collections.NewPrefix("task/count/")
+	TaskRefundKey   = collections.NewPrefix("task/refund/")
+	TaskReviewKey   = collections.NewPrefix("task/review/")
+	TaskDisputeKey  = collections.NewPrefix("task/dispute/")
+	SubmissionKey   = collections.NewPrefix("task/submission/")
+	TaskHistoryKey  = collections.NewPrefix("task/history/")
+	ArchivedTaskKey = collections.NewPrefix("task/archive/")
+	RemoteTaskKey   = collections.NewPrefix("task/remote/")
+	RemoteSenderKey = collections.NewPrefix("task/remote_sender/")
+	// secondary indexes of Task and TaskReward, keyed by (reference, task id)
+	TaskByCreatorKey        = collections.NewPrefix("task/index/creator/")
+	TaskByClaimantKey       = collections.NewPrefix("task/index/claimant/")
+	TaskByApproverKey       = collections.NewPrefix("task/index/approver/")
+	TaskByStatusKey         = collections.NewPrefix("task/index/status/")
+	TaskByBountyKey         = collections.NewPrefix("task/index/bounty/")
+	TaskByCreatedAtKey      = collections.NewPrefix("task/index/created_at/")
+	TaskRewardByClaimantKey = collections.NewPrefix("task/index/reward_claimant/")
+	// DeadlineQueueKey orders pending task deadlines by (unix time, task id, kind)
+	DeadlineQueueKey = collections.NewPrefix("task/deadline/")
+	// DeadlineRetryKey counts the failed attempts of a deadline by (task id, kind)
+	DeadlineRetryKey = collections.NewPrefix("task/deadline_retry/")
 )
@@ -4,7 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func NewMsgCreateTask(creator string, title string, description string, bounty sdk.Coin) *MsgCreateTask {
+func NewMsgCreateTask(creator string, title string, description string, bounty sdk.Coins) *MsgCreateTask {
 	return &MsgCreateTask{
 		Creator:     creator,
 		Title:       title,
@@ -13,7 +13,7 @@ func NewMsgCreateTask(creator string, title string, description string, bounty s
 	}
 }
 
-func NewMsgUpdateTask(creator string, id uint64, title string, description string, bounty sdk.Coin) *MsgUpdateTask {
+func NewMsgUpdateTask(creator string, id uint64, title string, description string, bounty sdk.Coins) *MsgUpdateTask {
 	return &MsgUpdateTask{
 		Creator:     creator,
 		Id:          id,
@@ -45,11 +45,10 @@ func NewMsgSubmitTask(claimant string, id uint64, proof TaskProof) *MsgSubmitTas
 	}
 }
 
-func NewMsgApproveTask(approver string, id uint64, txHash string) *MsgApproveTask {
+func NewMsgApproveTask(approver string, id uint64) *MsgApproveTask {
 	return &MsgApproveTask{
 		Approver: approver,
 		Id:       id,
-		TxHash:   txHash,
 	}
 }
 
@@ -59,4 +58,82 @@ func NewMsgRejectTask(rejecter string, id uint64, reason string) *MsgRejectTask
 		Id:       id,
 		Reason:   reason,
 	}
-}
\ No newline at end of file
+}
+func NewMsgReviewTask(reviewer string, id uint64, decision ReviewDecision, comment string) *MsgReviewTask {
+	return &MsgReviewTask{
+		Reviewer: reviewer,
+		Id:       id,
+		Decision: decision,
+		Comment:  comment,
+	}
+}
+
+func NewMsgDisputeTask(claimant string, id uint64, reason string) *MsgDisputeTask {
+	return &MsgDisputeTask{
+		Claimant: claimant,
+		Id:       id,
+		Reason:   reason,
+	}
+}
+
+func NewMsgResolveDispute(arbiter string, id uint64, resolution DisputeResolution, claimantWeight, creatorWeight uint64, note string) *MsgResolveDispute {
+	return &MsgResolveDispute{
+		Arbiter:        arbiter,
+		Id:             id,
+		Resolution:     resolution,
+		ClaimantWeight: claimantWeight,
+		CreatorWeight:  creatorWeight,
+		Note:           note,
+	}
+}
+
+func NewMsgUnclaimTask(claimant string, id uint64, reason string) *MsgUnclaimTask {
+	return &MsgUnclaimTask{
+		Claimant: claimant,
+		Id:       id,
+		Reason:   reason,
+	}
+}
+
+func NewMsgReopenTask(creator string, id uint64, reason string) *MsgReopenTask {
+	return &MsgReopenTask{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func NewMsgCloseTask(creator string, id uint64) *MsgCloseTask {
+	return &MsgCloseTask{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func NewMsgSetTaskApprovers(creator string, id uint64, approvers []string) *MsgSetTaskApprovers {
+	return &MsgSetTaskApprovers{
+		Creator:   creator,
+		Id:        id,
+		Approvers: approvers,
+	}
+}
+
+func NewMsgSendCreateTask(creator string, channelID string, timeoutTimestamp uint64, title string, description string, bounty sdk.Coins) *MsgSendCreateTask {
+	return &MsgSendCreateTask{
+		Creator:          creator,
+		ChannelId:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Title:            title,
+		Description:      description,
+		Bounty:           bounty,
+	}
+}
+
+func NewMsgSendClaimTask(claimant string, channelID string, timeoutTimestamp uint64, taskID uint64) *MsgSendClaimTask {
+	return &MsgSendClaimTask{
+		Claimant:         claimant,
+		ChannelId:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		TaskId:           taskID,
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// CertificateClassID is the x/nft class of the completion certificates minted
+// to claimants when their task is approved.
+const CertificateClassID = "taskbounty"
+
+// CertificateClass returns the x/nft class of completion certificates.
+func CertificateClass() nft.Class {
+	return nft.Class{
+		Id:          CertificateClassID,
+		Name:        "TaskBounty Certificates",
+		Symbol:      "TASKCERT",
+		Description: "Non-transferable proof of contribution, minted to the claimant of every approved task",
+	}
+}
+
+// CertificateID returns the x/nft id of the completion certificate of a task.
+func CertificateID(taskID uint64) string {
+	return fmt.Sprintf("task-%d", taskID)
+}
+
+// NewCertificateNFT returns the x/nft token carrying the certificate.
+func NewCertificateNFT(certificate TaskCertificate) (nft.NFT, error) {
+	data, err := codectypes.NewAnyWithValue(&certificate)
+	if err != nil {
+		return nft.NFT{}, err
+	}
+
+	return nft.NFT{
+		ClassId: CertificateClassID,
+		Id:      CertificateID(certificate.TaskId),
+		Data:    data,
+	}, nil
+}
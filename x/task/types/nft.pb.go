@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: taskbounty/task/v1/nft.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaskCertificate is the data of the non-transferable x/nft token minted to
+// the claimant of an approved task under the taskbounty class, as verifiable
+// proof of their contribution.
+type TaskCertificate struct {
+	TaskId uint64                                   `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title  string                                   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Bounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+	// hash of the approved proof
+	ProofHash string `protobuf:"bytes,4,opt,name=proof_hash,json=proofHash,proto3" json:"proof_hash,omitempty"`
+	// block time at which the task was approved
+	ApprovedAt int64 `protobuf:"varint,5,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+}
+
+func (m *TaskCertificate) Reset()         { *m = TaskCertificate{} }
+func (m *TaskCertificate) String() string { return proto.CompactTextString(m) }
+func (*TaskCertificate) ProtoMessage()    {}
+func (*TaskCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50cc866e553b6b73, []int{0}
+}
+func (m *TaskCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCertificate.Merge(m, src)
+}
+func (m *TaskCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCertificate proto.InternalMessageInfo
+
+func (m *TaskCertificate) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *TaskCertificate) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TaskCertificate) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bounty
+	}
+	return nil
+}
+
+func (m *TaskCertificate) GetProofHash() string {
+	if m != nil {
+		return m.ProofHash
+	}
+	return ""
+}
+
+func (m *TaskCertificate) GetApprovedAt() int64 {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TaskCertificate)(nil), "taskbounty.task.v1.TaskCertificate")
+}
+
+func init() { proto.RegisterFile("taskbounty/task/v1/nft.proto", fileDescriptor_50cc866e553b6b73) }
+
+var fileDescriptor_50cc866e553b6b73 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe3, 0xaf, 0x7f, 0x3e, 0xd5, 0x1d, 0x90, 0xa2, 0x4a, 0x0d, 0x15, 0xb8, 0x11, 0x53,
+	0x16, 0x6c, 0x02, 0x4f, 0x40, 0xbb, 0xc0, 0x1a, 0x31, 0xb1, 0x54, 0x4e, 0xe2, 0x36, 0x56, 0x69,
+	0x6e, 0x14, 0xdf, 0x46, 0xf4, 0x2d, 0x78, 0x0e, 0x9e, 0xa4, 0x63, 0x47, 0x26, 0x40, 0xad, 0x78,
+	0x0f, 0x94, 0xb8, 0x08, 0x26, 0x9f, 0x7b, 0xac, 0x7b, 0xee, 0x4f, 0x87, 0x9e, 0xa1, 0x34, 0xcb,
+	0x18, 0xd6, 0x39, 0x6e, 0x44, 0x2d, 0x45, 0x15, 0x8a, 0x7c, 0x8e, 0xbc, 0x28, 0x01, 0xc1, 0x75,
+	0x7f, 0x7f, 0x79, 0x2d, 0x79, 0x15, 0x8e, 0x58, 0x02, 0x66, 0x05, 0x46, 0xc4, 0xd2, 0x28, 0x51,
+	0x85, 0xb1, 0x42, 0x19, 0x8a, 0x04, 0x74, 0x6e, 0x77, 0x46, 0x83, 0x05, 0x2c, 0xa0, 0x91, 0xa2,
+	0x56, 0xd6, 0xbd, 0xf8, 0x22, 0xf4, 0xe4, 0x41, 0x9a, 0xe5, 0x54, 0x95, 0xa8, 0xe7, 0x3a, 0x91,
+	0xa8, 0xdc, 0x21, 0xfd, 0x5f, 0x87, 0xce, 0x74, 0xea, 0x11, 0x9f, 0x04, 0xed, 0xa8, 0x5b, 0x8f,
+	0xf7, 0xa9, 0x3b, 0xa0, 0x1d, 0xd4, 0xf8, 0xa4, 0xbc, 0x7f, 0x3e, 0x09, 0x7a, 0x91, 0x1d, 0xdc,
+	0x84, 0x76, 0x2d, 0x8a, 0xd7, 0xf2, 0x5b, 0x41, 0xff, 0xfa, 0x94, 0x5b, 0x12, 0x5e, 0x93, 0xf0,
+	0x23, 0x09, 0x9f, 0x82, 0xce, 0x27, 0x57, 0xdb, 0xf7, 0xb1, 0xf3, 0xfa, 0x31, 0x0e, 0x16, 0x1a,
+	0xb3, 0x75, 0xcc, 0x13, 0x58, 0x89, 0x23, 0xb6, 0x7d, 0x2e, 0x4d, 0xba, 0x14, 0xb8, 0x29, 0x94,
+	0x69, 0x16, 0x4c, 0x74, 0x8c, 0x76, 0xcf, 0x29, 0x2d, 0x4a, 0x80, 0xf9, 0x2c, 0x93, 0x26, 0xf3,
+	0xda, 0xcd, 0xfd, 0x5e, 0xe3, 0xdc, 0x49, 0x93, 0xb9, 0x63, 0xda, 0x97, 0x45, 0x51, 0x42, 0xa5,
+	0xd2, 0x99, 0x44, 0xaf, 0xe3, 0x93, 0xa0, 0x15, 0xd1, 0x1f, 0xeb, 0x16, 0x27, 0xe1, 0x76, 0xcf,
+	0xc8, 0x6e, 0xcf, 0xc8, 0xe7, 0x9e, 0x91, 0x97, 0x03, 0x73, 0x76, 0x07, 0xe6, 0xbc, 0x1d, 0x98,
+	0xf3, 0x38, 0xfc, 0xd3, 0xf4, 0xb3, 0xed, 0xba, 0x01, 0x88, 0xbb, 0x4d, 0x43, 0x37, 0xdf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x87, 0xcd, 0x1c, 0x57, 0x8b, 0x01, 0x00, 0x00,
+}
+
+func (m *TaskCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.ApprovedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProofHash) > 0 {
+		i -= len(m.ProofHash)
+		copy(dAtA[i:], m.ProofHash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ProofHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNft(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovNft(uint64(m.TaskId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	l = len(m.ProofHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.ApprovedAt != 0 {
+		n += 1 + sovNft(uint64(m.ApprovedAt))
+	}
+	return n
+}
+
+func sovNft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNft(x uint64) (n int) {
+	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			m.ApprovedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNft(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNft
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNft
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNft
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNft        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNft          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNft = fmt.Errorf("proto: unexpected end of group")
+)
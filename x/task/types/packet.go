@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// DefaultPacketTimeout is the timeout of task packets sent without one.
+const DefaultPacketTimeout = 10 * time.Minute
+
+// RemoteAccount returns the account acting on this chain for sender of the
+// chain at the other end of channelID. It escrows the bounty of the tasks the
+// sender creates here and claims tasks on their behalf, so it must be funded,
+// e.g. through an ICS-20 transfer, before a task is created.
+func RemoteAccount(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID), []byte(sender))
+}
+
+// validateRemoteAddress checks that addr is a bech32 address. Addresses of
+// the other chain carry its own prefix, so any prefix is accepted.
+func validateRemoteAddress(addr string) error {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return err
+	}
+
+	return sdk.VerifyAddressFormat(bz)
+}
+
+func validateSender(sender string) error {
+	if sender == "" {
+		return errors.New("sender cannot be empty")
+	}
+	if err := validateRemoteAddress(sender); err != nil {
+		return fmt.Errorf("invalid sender %s: %w", sender, err)
+	}
+
+	return nil
+}
+
+func validateStatus(status TaskStatus) error {
+	if _, ok := TaskStatus_name[int32(status)]; !ok {
+		return fmt.Errorf("unknown task status %d", status)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless checks of the packet data.
+func (p CreateTaskPacketData) ValidateBasic() error {
+	if err := validateSender(p.Sender); err != nil {
+		return err
+	}
+	if p.Bounty.Empty() || !p.Bounty.IsValid() {
+		return errors.New("bounty must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless checks of the packet data.
+func (p ClaimTaskPacketData) ValidateBasic() error {
+	return validateSender(p.Sender)
+}
+
+// ValidateBasic performs stateless checks of the packet data. The transition
+// must be one the task history of the sending chain can hold: only the first
+// transition creates the task, and the actor, if any, is an address.
+func (p TaskStatusPacketData) ValidateBasic() error {
+	transition := p.Transition
+	if err := validateStatus(transition.From); err != nil {
+		return err
+	}
+	if err := validateStatus(transition.To); err != nil {
+		return err
+	}
+	if transition.From == transition.To {
+		return fmt.Errorf("transition of task %d does not change its status", transition.TaskId)
+	}
+	if (transition.Seq == 0) != (transition.From == TASK_STATUS_UNDEFINED) {
+		return fmt.Errorf("only the first transition of task %d can create it", transition.TaskId)
+	}
+	if transition.Actor != "" {
+		if err := validateRemoteAddress(transition.Actor); err != nil {
+			return fmt.Errorf("invalid actor %s: %w", transition.Actor, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless checks of the packet data.
+func (p TaskPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *TaskPacketData_CreateTaskPacket:
+		return packet.CreateTaskPacket.ValidateBasic()
+	case *TaskPacketData_ClaimTaskPacket:
+		return packet.ClaimTaskPacket.ValidateBasic()
+	case *TaskPacketData_TaskStatusPacket:
+		return packet.TaskStatusPacket.ValidateBasic()
+	default:
+		return fmt.Errorf("unrecognized %s packet type: %T", ModuleName, packet)
+	}
+}
+
+// Sender returns the sender of the packet on the sending chain, empty for
+// status packets, which the chain sends itself.
+func (p TaskPacketData) Sender() string {
+	switch packet := p.Packet.(type) {
+	case *TaskPacketData_CreateTaskPacket:
+		return packet.CreateTaskPacket.Sender
+	case *TaskPacketData_ClaimTaskPacket:
+		return packet.ClaimTaskPacket.Sender
+	default:
+		return ""
+	}
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 )
 
 // NewParams creates a new Params instance.
@@ -19,22 +21,38 @@ func NewParams() Params {
 		TaskExpiry:            86400 * 30,
 		ClaimDeadline:         86400 * 7,
 		SubmissionDeadline:    86400 * 14,
+		ReviewTimeoutAction:   REVIEW_TIMEOUT_ACTION_APPROVE,
+		MinTaskExpiry:         3600,
+		MaxTaskExpiry:         86400 * 90,
+		MinClaimDeadline:      3600,
+		MaxClaimDeadline:      86400 * 30,
+		MinSubmissionDeadline: 3600,
+		MaxSubmissionDeadline: 86400 * 30,
+		MaxSubmissionAttempts: 3,
+		DisputeWindow:         86400 * 7,
+		MaxDeadlinesPerBlock:  100,
+		MaxDeadlineRetries:    60,
 	}
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
-	if p.MinBounty.IsZero() || p.MinBounty.IsNegative() {
-		return fmt.Errorf("min bounty must be positive")
-	}
-	if p.MaxBounty.IsZero() || p.MaxBounty.IsNegative() {
-		return fmt.Errorf("max bounty must be positive")
+	if err := validateBountyBounds(p.MinBounty, p.MaxBounty); err != nil {
+		return err
 	}
-	if p.MinBounty.Amount.GT(p.MaxBounty.Amount) {
-		return fmt.Errorf("min bounty cannot be greater than max bounty")
-	}
-	if p.MinBounty.Denom != p.MaxBounty.Denom {
-		return fmt.Errorf("min and max bounty must have the same denom")
+	denoms := map[string]bool{p.MinBounty.Denom: true}
+	for _, bountyDenom := range p.BountyDenoms {
+		if err := validateBountyBounds(bountyDenom.MinBounty, bountyDenom.MaxBounty); err != nil {
+			return fmt.Errorf("bounty denom %s: %w", bountyDenom.MinBounty.Denom, err)
+		}
+		denom := bountyDenom.MinBounty.Denom
+		if err := validateBountyDenom(denom); err != nil {
+			return err
+		}
+		if denoms[denom] {
+			return fmt.Errorf("duplicate bounty denom %s", denom)
+		}
+		denoms[denom] = true
 	}
 	if p.MaxTitleLength == 0 {
 		return fmt.Errorf("max title length must be positive")
@@ -48,6 +66,108 @@ func (p Params) Validate() error {
 	if p.AutoApproveThreshold == 0 {
 		return fmt.Errorf("auto approve threshold must be positive")
 	}
+	if p.ReviewTimeoutAction != REVIEW_TIMEOUT_ACTION_APPROVE && p.ReviewTimeoutAction != REVIEW_TIMEOUT_ACTION_REJECT {
+		return fmt.Errorf("review timeout action must be approve or reject")
+	}
+	if err := validateDeadlineBounds("task expiry", p.TaskExpiry, p.MinTaskExpiry, p.MaxTaskExpiry); err != nil {
+		return err
+	}
+	if err := validateDeadlineBounds("claim deadline", p.ClaimDeadline, p.MinClaimDeadline, p.MaxClaimDeadline); err != nil {
+		return err
+	}
+	if err := validateDeadlineBounds("submission deadline", p.SubmissionDeadline, p.MinSubmissionDeadline, p.MaxSubmissionDeadline); err != nil {
+		return err
+	}
+	if p.DisputeWindow == 0 {
+		return fmt.Errorf("dispute window must be positive")
+	}
+	if p.MaxDeadlinesPerBlock == 0 {
+		return fmt.Errorf("max deadlines per block must be positive")
+	}
+	if p.MaxDeadlineRetries == 0 {
+		return fmt.Errorf("max deadline retries must be positive")
+	}
+
+	seen := make(map[string]bool, len(p.Arbiters))
+	for _, arbiter := range p.Arbiters {
+		if _, err := sdk.AccAddressFromBech32(arbiter); err != nil {
+			return fmt.Errorf("invalid arbiter address %s: %s", arbiter, err)
+		}
+		if seen[arbiter] {
+			return fmt.Errorf("duplicate arbiter %s", arbiter)
+		}
+		seen[arbiter] = true
+	}
+
+	return nil
+}
+
+// BountyLimits returns the min and max bounty in denom, and whether bounties
+// can be paid in it at all. The denom of MinBounty is always allowed, others
+// must be listed in BountyDenoms.
+func (p Params) BountyLimits(denom string) (minBounty, maxBounty sdk.Coin, ok bool) {
+	if denom == p.MinBounty.Denom {
+		return p.MinBounty, p.MaxBounty, true
+	}
+	for _, bountyDenom := range p.BountyDenoms {
+		if bountyDenom.MinBounty.Denom == denom {
+			return bountyDenom.MinBounty, bountyDenom.MaxBounty, true
+		}
+	}
+
+	return sdk.Coin{}, sdk.Coin{}, false
+}
+
+// validateBountyBounds checks that min and max bounty are positive amounts of
+// the same denom and that min does not exceed max.
+func validateBountyBounds(minBounty, maxBounty sdk.Coin) error {
+	if minBounty.Amount.IsNil() || minBounty.IsZero() || minBounty.IsNegative() {
+		return fmt.Errorf("min bounty must be positive")
+	}
+	if maxBounty.Amount.IsNil() || maxBounty.IsZero() || maxBounty.IsNegative() {
+		return fmt.Errorf("max bounty must be positive")
+	}
+	if minBounty.Amount.GT(maxBounty.Amount) {
+		return fmt.Errorf("min bounty cannot be greater than max bounty")
+	}
+	if minBounty.Denom != maxBounty.Denom {
+		return fmt.Errorf("min and max bounty must have the same denom")
+	}
+
+	return nil
+}
+
+// validateBountyDenom checks the denom of an allowed bounty. IBC vouchers must
+// carry the hash of their denom trace, as in ibc/{hash}.
+func validateBountyDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid bounty denom %s: %w", denom, err)
+	}
+
+	hash, ok := strings.CutPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+	if !ok {
+		return nil
+	}
+	if _, err := ibctransfertypes.ParseHexHash(hash); err != nil {
+		return fmt.Errorf("invalid denom trace hash of bounty denom %s: %w", denom, err)
+	}
+
+	return nil
+}
+
+// validateDeadlineBounds checks that min does not exceed max and that the
+// default deadline, when enabled, lies within the bounds.
+func validateDeadlineBounds(name string, value, min, max uint64) error {
+	if max != 0 && min > max {
+		return fmt.Errorf("min %s cannot be greater than max %s", name, name)
+	}
+	if value != 0 && !deadlineWithinBounds(value, min, max) {
+		return fmt.Errorf("%s must be between min and max %s", name, name)
+	}
 
 	return nil
 }
+
+func deadlineWithinBounds(value, min, max uint64) bool {
+	return value >= min && (max == 0 || value <= max)
+}
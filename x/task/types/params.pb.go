@@ -25,11 +25,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReviewTimeoutAction is applied to a submission the creator did not review
+// before the submission deadline.
+type ReviewTimeoutAction int32
+
+const (
+	REVIEW_TIMEOUT_ACTION_UNSPECIFIED ReviewTimeoutAction = 0
+	// pay the bounty out to the claimant
+	REVIEW_TIMEOUT_ACTION_APPROVE ReviewTimeoutAction = 1
+	// reject the submission
+	REVIEW_TIMEOUT_ACTION_REJECT ReviewTimeoutAction = 2
+)
+
+var ReviewTimeoutAction_name = map[int32]string{
+	0: "REVIEW_TIMEOUT_ACTION_UNSPECIFIED",
+	1: "REVIEW_TIMEOUT_ACTION_APPROVE",
+	2: "REVIEW_TIMEOUT_ACTION_REJECT",
+}
+
+var ReviewTimeoutAction_value = map[string]int32{
+	"REVIEW_TIMEOUT_ACTION_UNSPECIFIED": 0,
+	"REVIEW_TIMEOUT_ACTION_APPROVE":     1,
+	"REVIEW_TIMEOUT_ACTION_REJECT":      2,
+}
+
+func (x ReviewTimeoutAction) String() string {
+	return proto.EnumName(ReviewTimeoutAction_name, int32(x))
+}
+
+func (ReviewTimeoutAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55437bd3f072ca1d, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
-	// minimum bounty amount for a task
+	// minimum amount of the coin of a task bounty in the denom of min_bounty
 	MinBounty types.Coin `protobuf:"bytes,1,opt,name=min_bounty,json=minBounty,proto3" json:"min_bounty"`
-	// maximum bounty amount for a task
+	// maximum amount of the coin of a task bounty in the denom of max_bounty
 	MaxBounty            types.Coin `protobuf:"bytes,2,opt,name=max_bounty,json=maxBounty,proto3" json:"max_bounty"`
 	MaxTitleLength       uint32     `protobuf:"varint,3,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
 	MaxDescriptionLength uint32     `protobuf:"varint,4,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
@@ -41,6 +73,33 @@ type Params struct {
 	ClaimDeadline uint64 `protobuf:"varint,8,opt,name=claim_deadline,json=claimDeadline,proto3" json:"claim_deadline,omitempty"`
 	// seconds
 	SubmissionDeadline uint64 `protobuf:"varint,9,opt,name=submission_deadline,json=submissionDeadline,proto3" json:"submission_deadline,omitempty"`
+	// what happens to a submission that is not reviewed before the submission deadline
+	ReviewTimeoutAction ReviewTimeoutAction `protobuf:"varint,10,opt,name=review_timeout_action,json=reviewTimeoutAction,proto3,enum=taskbounty.task.v1.ReviewTimeoutAction" json:"review_timeout_action,omitempty"`
+	// bounds of the per-task deadlines in seconds, a zero max means no upper bound
+	MinTaskExpiry         uint64 `protobuf:"varint,11,opt,name=min_task_expiry,json=minTaskExpiry,proto3" json:"min_task_expiry,omitempty"`
+	MaxTaskExpiry         uint64 `protobuf:"varint,12,opt,name=max_task_expiry,json=maxTaskExpiry,proto3" json:"max_task_expiry,omitempty"`
+	MinClaimDeadline      uint64 `protobuf:"varint,13,opt,name=min_claim_deadline,json=minClaimDeadline,proto3" json:"min_claim_deadline,omitempty"`
+	MaxClaimDeadline      uint64 `protobuf:"varint,14,opt,name=max_claim_deadline,json=maxClaimDeadline,proto3" json:"max_claim_deadline,omitempty"`
+	MinSubmissionDeadline uint64 `protobuf:"varint,15,opt,name=min_submission_deadline,json=minSubmissionDeadline,proto3" json:"min_submission_deadline,omitempty"`
+	MaxSubmissionDeadline uint64 `protobuf:"varint,16,opt,name=max_submission_deadline,json=maxSubmissionDeadline,proto3" json:"max_submission_deadline,omitempty"`
+	// addresses, including x/group policy addresses, allowed to resolve disputes
+	Arbiters []string `protobuf:"bytes,17,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	// how many proofs a claimant may submit for a task, counting resubmissions
+	// after a rejection. 0 means no limit.
+	MaxSubmissionAttempts uint32 `protobuf:"varint,18,opt,name=max_submission_attempts,json=maxSubmissionAttempts,proto3" json:"max_submission_attempts,omitempty"`
+	// whether closed tasks are moved out of the task store into the archive
+	ArchiveClosedTasks bool `protobuf:"varint,19,opt,name=archive_closed_tasks,json=archiveClosedTasks,proto3" json:"archive_closed_tasks,omitempty"`
+	// denoms bounties can be paid in besides the denom of min_bounty, such as
+	// ibc/... vouchers, each within its own bounds
+	BountyDenoms []BountyDenom `protobuf:"bytes,20,rep,name=bounty_denoms,json=bountyDenoms,proto3" json:"bounty_denoms"`
+	// seconds the claimant has to dispute a rejection, the creator can only
+	// reopen a rejected task once it has passed
+	DisputeWindow uint64 `protobuf:"varint,21,opt,name=dispute_window,json=disputeWindow,proto3" json:"dispute_window,omitempty"`
+	// deadlines the EndBlocker applies at most per block, the rest stay queued
+	// for the following blocks
+	MaxDeadlinesPerBlock uint32 `protobuf:"varint,22,opt,name=max_deadlines_per_block,json=maxDeadlinesPerBlock,proto3" json:"max_deadlines_per_block,omitempty"`
+	// times a deadline that failed to apply is retried before it is dropped
+	MaxDeadlineRetries uint32 `protobuf:"varint,23,opt,name=max_deadline_retries,json=maxDeadlineRetries,proto3" json:"max_deadline_retries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,41 +198,220 @@ func (m *Params) GetSubmissionDeadline() uint64 {
 	return 0
 }
 
+func (m *Params) GetReviewTimeoutAction() ReviewTimeoutAction {
+	if m != nil {
+		return m.ReviewTimeoutAction
+	}
+	return REVIEW_TIMEOUT_ACTION_UNSPECIFIED
+}
+
+func (m *Params) GetMinTaskExpiry() uint64 {
+	if m != nil {
+		return m.MinTaskExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTaskExpiry() uint64 {
+	if m != nil {
+		return m.MaxTaskExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetMinClaimDeadline() uint64 {
+	if m != nil {
+		return m.MinClaimDeadline
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClaimDeadline() uint64 {
+	if m != nil {
+		return m.MaxClaimDeadline
+	}
+	return 0
+}
+
+func (m *Params) GetMinSubmissionDeadline() uint64 {
+	if m != nil {
+		return m.MinSubmissionDeadline
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubmissionDeadline() uint64 {
+	if m != nil {
+		return m.MaxSubmissionDeadline
+	}
+	return 0
+}
+
+func (m *Params) GetArbiters() []string {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSubmissionAttempts() uint32 {
+	if m != nil {
+		return m.MaxSubmissionAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetArchiveClosedTasks() bool {
+	if m != nil {
+		return m.ArchiveClosedTasks
+	}
+	return false
+}
+
+func (m *Params) GetBountyDenoms() []BountyDenom {
+	if m != nil {
+		return m.BountyDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetDisputeWindow() uint64 {
+	if m != nil {
+		return m.DisputeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDeadlinesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxDeadlinesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDeadlineRetries() uint32 {
+	if m != nil {
+		return m.MaxDeadlineRetries
+	}
+	return 0
+}
+
+// BountyDenom allows bounty coins in the denom of its min and max bounty.
+type BountyDenom struct {
+	// minimum bounty amount in the denom
+	MinBounty types.Coin `protobuf:"bytes,1,opt,name=min_bounty,json=minBounty,proto3" json:"min_bounty"`
+	// maximum bounty amount in the denom
+	MaxBounty types.Coin `protobuf:"bytes,2,opt,name=max_bounty,json=maxBounty,proto3" json:"max_bounty"`
+}
+
+func (m *BountyDenom) Reset()         { *m = BountyDenom{} }
+func (m *BountyDenom) String() string { return proto.CompactTextString(m) }
+func (*BountyDenom) ProtoMessage()    {}
+func (*BountyDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55437bd3f072ca1d, []int{1}
+}
+func (m *BountyDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyDenom.Merge(m, src)
+}
+func (m *BountyDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyDenom proto.InternalMessageInfo
+
+func (m *BountyDenom) GetMinBounty() types.Coin {
+	if m != nil {
+		return m.MinBounty
+	}
+	return types.Coin{}
+}
+
+func (m *BountyDenom) GetMaxBounty() types.Coin {
+	if m != nil {
+		return m.MaxBounty
+	}
+	return types.Coin{}
+}
+
 func init() {
+	proto.RegisterEnum("taskbounty.task.v1.ReviewTimeoutAction", ReviewTimeoutAction_name, ReviewTimeoutAction_value)
 	proto.RegisterType((*Params)(nil), "taskbounty.task.v1.Params")
+	proto.RegisterType((*BountyDenom)(nil), "taskbounty.task.v1.BountyDenom")
 }
 
 func init() { proto.RegisterFile("taskbounty/task/v1/params.proto", fileDescriptor_55437bd3f072ca1d) }
 
 var fileDescriptor_55437bd3f072ca1d = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x18, 0x85, 0x6b, 0x5a, 0x0a, 0xf5, 0xd5, 0xbd, 0x82, 0x70, 0x05, 0xe1, 0x0e, 0x69, 0x41, 0x42,
-	0x8a, 0x18, 0x62, 0x05, 0x98, 0x18, 0x90, 0x28, 0x97, 0x8d, 0x01, 0x55, 0x9d, 0x58, 0x22, 0x27,
-	0x31, 0xad, 0x45, 0xec, 0xdf, 0x8a, 0xdd, 0x28, 0x7d, 0x05, 0x26, 0x1e, 0x81, 0x47, 0xe0, 0x31,
-	0xee, 0xd8, 0x11, 0x09, 0x09, 0xa1, 0x76, 0x80, 0xc7, 0x40, 0xb6, 0x13, 0x8a, 0xc4, 0xc2, 0x12,
-	0xfd, 0x3a, 0xe7, 0x7c, 0xc7, 0x8a, 0xfd, 0xe3, 0xa9, 0xa1, 0xfa, 0x43, 0x0e, 0x1b, 0x69, 0xb6,
-	0xc4, 0x8e, 0xa4, 0x49, 0x89, 0xa2, 0x35, 0x15, 0x3a, 0x51, 0x35, 0x18, 0x08, 0x82, 0x63, 0x20,
-	0xb1, 0x63, 0xd2, 0xa4, 0x17, 0xb7, 0xa9, 0xe0, 0x12, 0x88, 0xfb, 0xfa, 0xd8, 0x45, 0x54, 0x80,
-	0x16, 0xa0, 0x49, 0x4e, 0x35, 0x23, 0x4d, 0x9a, 0x33, 0x43, 0x53, 0x52, 0x00, 0x97, 0x9d, 0x7f,
-	0xbe, 0x82, 0x15, 0xb8, 0x91, 0xd8, 0xc9, 0xab, 0x0f, 0xbf, 0x0d, 0xf1, 0xf8, 0xad, 0x3b, 0x2d,
-	0x78, 0x81, 0xb1, 0xe0, 0x32, 0xf3, 0x27, 0x85, 0x68, 0x86, 0xe2, 0x93, 0x27, 0xf7, 0x13, 0xdf,
-	0x9a, 0xd8, 0xd6, 0xa4, 0x6b, 0x4d, 0x5e, 0x01, 0x97, 0xf3, 0xd1, 0xd5, 0xf7, 0xe9, 0x60, 0x31,
-	0x11, 0x5c, 0xce, 0x1d, 0xe1, 0x78, 0xda, 0xf6, 0xfc, 0xb5, 0xff, 0xe5, 0x69, 0xdb, 0xf1, 0x31,
-	0xbe, 0x65, 0x79, 0xc3, 0x4d, 0xc5, 0xb2, 0x8a, 0xc9, 0x95, 0x59, 0x87, 0xc3, 0x19, 0x8a, 0x4f,
-	0x17, 0x67, 0x82, 0xb6, 0x4b, 0x2b, 0xbf, 0x71, 0x6a, 0xf0, 0x0c, 0xdf, 0xb5, 0xc9, 0x92, 0xe9,
-	0xa2, 0xe6, 0xca, 0x70, 0x90, 0x7d, 0x7e, 0xe4, 0xf2, 0xe7, 0x82, 0xb6, 0x97, 0x47, 0xb3, 0xa3,
-	0xa6, 0xf8, 0x44, 0xd5, 0x00, 0xef, 0x33, 0xb3, 0x55, 0x4c, 0x87, 0xd7, 0x67, 0xc3, 0x78, 0xb2,
-	0xc0, 0x4e, 0x5a, 0x5a, 0xc5, 0xd6, 0xd2, 0x8d, 0x81, 0x8c, 0x2a, 0x55, 0x43, 0xc3, 0x32, 0xb3,
-	0xae, 0x99, 0x5e, 0x43, 0x55, 0x86, 0x63, 0x5f, 0x6b, 0xdd, 0x97, 0xde, 0x5c, 0xf6, 0x9e, 0xad,
-	0xb5, 0xaf, 0x92, 0xb1, 0x56, 0xf1, 0x7a, 0x1b, 0xde, 0x98, 0xa1, 0x78, 0xb4, 0xc0, 0x56, 0x7a,
-	0xed, 0x94, 0xe0, 0x11, 0x3e, 0x2b, 0x2a, 0xca, 0x45, 0x56, 0x32, 0x5a, 0x56, 0x5c, 0xb2, 0xf0,
-	0xa6, 0xcb, 0x9c, 0x3a, 0xf5, 0xb2, 0x13, 0x03, 0x82, 0xef, 0xe8, 0x4d, 0x2e, 0xb8, 0xd6, 0xf6,
-	0x7f, 0xfe, 0x64, 0x27, 0x2e, 0x1b, 0x1c, 0xad, 0x1e, 0x78, 0xfe, 0xe0, 0xd7, 0xe7, 0x29, 0xfa,
-	0xf8, 0xf3, 0xcb, 0xe3, 0xf0, 0xaf, 0x0d, 0x6a, 0xfd, 0x0e, 0xf9, 0x27, 0x9d, 0xa7, 0x57, 0xfb,
-	0x08, 0xed, 0xf6, 0x11, 0xfa, 0xb1, 0x8f, 0xd0, 0xa7, 0x43, 0x34, 0xd8, 0x1d, 0xa2, 0xc1, 0xd7,
-	0x43, 0x34, 0x78, 0x77, 0xef, 0x5f, 0xc6, 0x5d, 0x4b, 0x3e, 0x76, 0x7b, 0xf1, 0xf4, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x14, 0x2d, 0xe2, 0x71, 0x97, 0x02, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x4f, 0x6f, 0xdb, 0x36,
+	0x18, 0xc6, 0xad, 0xc6, 0xcb, 0x12, 0xba, 0x49, 0x5d, 0x26, 0x69, 0xb4, 0x60, 0x93, 0x95, 0x02,
+	0xdd, 0x84, 0x62, 0x90, 0xe6, 0xec, 0xcf, 0xa1, 0x87, 0x01, 0x8e, 0xa3, 0x01, 0x2e, 0xb6, 0xc6,
+	0x50, 0xd5, 0x16, 0xd8, 0x0e, 0x04, 0x25, 0x71, 0x31, 0x51, 0x4b, 0x14, 0x48, 0xda, 0x51, 0x3e,
+	0xc1, 0x86, 0x9d, 0x06, 0xec, 0x0b, 0x0c, 0xd8, 0x17, 0xd8, 0x69, 0x9f, 0xa1, 0xc7, 0x1e, 0x77,
+	0x1a, 0x86, 0xe4, 0xb0, 0x7d, 0x8c, 0x81, 0x94, 0x1c, 0x2b, 0xb5, 0x0e, 0xbb, 0xf5, 0x12, 0x30,
+	0xcf, 0xf3, 0x7b, 0x5e, 0xfb, 0x7d, 0xf9, 0xc7, 0xa0, 0x27, 0xb1, 0x78, 0x19, 0xb1, 0x59, 0x26,
+	0x2f, 0x3c, 0xb5, 0xf4, 0xe6, 0x7d, 0x2f, 0xc7, 0x1c, 0xa7, 0xc2, 0xcd, 0x39, 0x93, 0x0c, 0xc2,
+	0x25, 0xe0, 0xaa, 0xa5, 0x3b, 0xef, 0x1f, 0xdc, 0xc5, 0x29, 0xcd, 0x98, 0xa7, 0xff, 0x96, 0xd8,
+	0x81, 0x15, 0x33, 0x91, 0x32, 0xe1, 0x45, 0x58, 0x10, 0x6f, 0xde, 0x8f, 0x88, 0xc4, 0x7d, 0x2f,
+	0x66, 0x34, 0xab, 0xfc, 0xdd, 0x33, 0x76, 0xc6, 0xf4, 0xd2, 0x53, 0xab, 0x52, 0xbd, 0xff, 0xc7,
+	0x26, 0x58, 0x1f, 0xeb, 0x4f, 0x83, 0x5f, 0x02, 0x90, 0xd2, 0x0c, 0x95, 0x9f, 0x64, 0x1a, 0xb6,
+	0xe1, 0x74, 0x8e, 0xde, 0x73, 0xcb, 0xaa, 0xae, 0xaa, 0xea, 0x56, 0x55, 0xdd, 0x21, 0xa3, 0xd9,
+	0x71, 0xfb, 0xd5, 0x5f, 0xbd, 0x56, 0xb0, 0x99, 0xd2, 0xec, 0x58, 0x27, 0x74, 0x1e, 0x17, 0x8b,
+	0xfc, 0xad, 0xff, 0x9b, 0xc7, 0x45, 0x95, 0x77, 0x40, 0x57, 0xe5, 0x25, 0x95, 0x53, 0x82, 0xa6,
+	0x24, 0x3b, 0x93, 0x13, 0x73, 0xcd, 0x36, 0x9c, 0xad, 0x60, 0x3b, 0xc5, 0x45, 0xa8, 0xe4, 0xaf,
+	0xb5, 0x0a, 0x3f, 0x03, 0xf7, 0x14, 0x99, 0x10, 0x11, 0x73, 0x9a, 0x4b, 0xca, 0xb2, 0x05, 0xdf,
+	0xd6, 0xfc, 0x6e, 0x8a, 0x8b, 0x93, 0xa5, 0x59, 0xa5, 0x7a, 0xa0, 0x93, 0x73, 0xc6, 0xbe, 0x47,
+	0xf2, 0x22, 0x27, 0xc2, 0x7c, 0xc7, 0x5e, 0x73, 0x36, 0x03, 0xa0, 0xa5, 0x50, 0x29, 0xaa, 0x2c,
+	0x9e, 0x49, 0x86, 0x70, 0x9e, 0x73, 0x36, 0x27, 0x48, 0x4e, 0x38, 0x11, 0x13, 0x36, 0x4d, 0xcc,
+	0xf5, 0xb2, 0xac, 0x72, 0x07, 0xa5, 0x19, 0x2e, 0x3c, 0x55, 0x56, 0xed, 0x0a, 0x22, 0x45, 0x4e,
+	0xf9, 0x85, 0xf9, 0xae, 0x6d, 0x38, 0xed, 0x00, 0x28, 0xc9, 0xd7, 0x0a, 0x7c, 0x00, 0xb6, 0xe3,
+	0x29, 0xa6, 0x29, 0x4a, 0x08, 0x4e, 0xa6, 0x34, 0x23, 0xe6, 0x86, 0x66, 0xb6, 0xb4, 0x7a, 0x52,
+	0x89, 0xd0, 0x03, 0x3b, 0x62, 0x16, 0xa5, 0x54, 0x08, 0xd5, 0xcf, 0x35, 0xbb, 0xa9, 0x59, 0xb8,
+	0xb4, 0xae, 0x03, 0xdf, 0x81, 0x3d, 0x4e, 0xe6, 0x94, 0x9c, 0x23, 0x49, 0x53, 0xc2, 0x66, 0x12,
+	0xe1, 0x58, 0xb5, 0x6b, 0x02, 0xdb, 0x70, 0xb6, 0x8f, 0x3e, 0x72, 0x57, 0xcf, 0x8d, 0x1b, 0xe8,
+	0x40, 0x58, 0xf2, 0x03, 0x8d, 0x07, 0x3b, 0x7c, 0x55, 0x84, 0x1f, 0x82, 0x3b, 0xea, 0x30, 0xd4,
+	0x3b, 0xeb, 0x94, 0xdf, 0x3a, 0xa5, 0x59, 0xb8, 0x6c, 0x4e, 0x71, 0x6a, 0xd3, 0x6a, 0xdc, 0xed,
+	0x8a, 0xc3, 0x45, 0x8d, 0xfb, 0x18, 0x40, 0x55, 0xef, 0x8d, 0x41, 0x6c, 0x69, 0xb4, 0x9b, 0xd2,
+	0x6c, 0x78, 0x63, 0x16, 0x8a, 0xc6, 0xc5, 0x9b, 0xf4, 0x76, 0x45, 0xe3, 0xe2, 0x26, 0xfd, 0x05,
+	0xd8, 0x57, 0xb5, 0x9b, 0xa6, 0x77, 0x47, 0x47, 0xf6, 0x52, 0x9a, 0x3d, 0x5d, 0x1d, 0xa0, 0xca,
+	0xe1, 0xa2, 0x31, 0xd7, 0xad, 0x72, 0xb8, 0x68, 0xc8, 0x1d, 0x80, 0x0d, 0xcc, 0x23, 0x2a, 0x09,
+	0x17, 0xe6, 0x5d, 0x7d, 0x8a, 0xae, 0xff, 0x6f, 0xa8, 0x89, 0xa5, 0x24, 0x69, 0x2e, 0x85, 0x09,
+	0xf5, 0x21, 0xba, 0x59, 0x73, 0x50, 0x99, 0xf0, 0x13, 0xb0, 0x8b, 0x79, 0x3c, 0xa1, 0x73, 0x82,
+	0xe2, 0x29, 0x13, 0x24, 0xd1, 0x23, 0x15, 0xe6, 0x8e, 0x6d, 0x38, 0x1b, 0x01, 0xac, 0xbc, 0xa1,
+	0xb6, 0xd4, 0x58, 0x05, 0x7c, 0x0c, 0xb6, 0xca, 0xcd, 0x45, 0x09, 0xc9, 0x58, 0x2a, 0xcc, 0x5d,
+	0x7b, 0xcd, 0xe9, 0x1c, 0xf5, 0x9a, 0xb6, 0xbd, 0xbc, 0x61, 0x27, 0x8a, 0xab, 0xee, 0xdd, 0xed,
+	0x68, 0x29, 0x09, 0x75, 0x44, 0x13, 0x2a, 0xf2, 0x99, 0x24, 0xe8, 0x9c, 0x66, 0x09, 0x3b, 0x37,
+	0xf7, 0xca, 0x4d, 0xac, 0xd4, 0x17, 0x5a, 0x84, 0x9f, 0x97, 0xcd, 0x2d, 0xa6, 0x24, 0x50, 0x4e,
+	0x38, 0x8a, 0xa6, 0x2c, 0x7e, 0x69, 0xde, 0xab, 0x5d, 0xbc, 0xca, 0x1d, 0x13, 0x7e, 0xac, 0x3c,
+	0xd5, 0x5b, 0x3d, 0x86, 0x38, 0x91, 0x9c, 0x12, 0x61, 0xee, 0xeb, 0x0c, 0xac, 0x65, 0x82, 0xd2,
+	0x79, 0x74, 0xf8, 0xef, 0xaf, 0x3d, 0xe3, 0xa7, 0x7f, 0x7e, 0x7f, 0x68, 0xd6, 0x1e, 0xc7, 0xa2,
+	0x7c, 0x1e, 0xcb, 0xd7, 0xea, 0xfe, 0x2f, 0x06, 0xe8, 0xd4, 0xda, 0x7a, 0xdb, 0xaf, 0xd7, 0xa3,
+	0xb6, 0xfa, 0xca, 0x0f, 0x7f, 0x30, 0xc0, 0x4e, 0xc3, 0x1d, 0x83, 0x0f, 0xc0, 0x61, 0xe0, 0x3f,
+	0x1f, 0xf9, 0x2f, 0x50, 0x38, 0xfa, 0xc6, 0x3f, 0x7d, 0x16, 0xa2, 0xc1, 0x30, 0x1c, 0x9d, 0x3e,
+	0x41, 0xcf, 0x9e, 0x3c, 0x1d, 0xfb, 0xc3, 0xd1, 0x57, 0x23, 0xff, 0xa4, 0xdb, 0x82, 0x87, 0xe0,
+	0x83, 0x66, 0x6c, 0x30, 0x1e, 0x07, 0xa7, 0xcf, 0xfd, 0xae, 0x01, 0x6d, 0xf0, 0x7e, 0x33, 0x12,
+	0xf8, 0x8f, 0xfd, 0x61, 0xd8, 0xbd, 0x75, 0xd0, 0xfe, 0xf1, 0x37, 0xab, 0x75, 0xdc, 0x7f, 0x75,
+	0x69, 0x19, 0xaf, 0x2f, 0x2d, 0xe3, 0xef, 0x4b, 0xcb, 0xf8, 0xf9, 0xca, 0x6a, 0xbd, 0xbe, 0xb2,
+	0x5a, 0x7f, 0x5e, 0x59, 0xad, 0x6f, 0xf7, 0x57, 0x67, 0xaa, 0x5f, 0xc4, 0x68, 0x5d, 0xff, 0x24,
+	0x7c, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xc1, 0xe2, 0x9c, 0x92, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -227,6 +465,85 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SubmissionDeadline != that1.SubmissionDeadline {
 		return false
 	}
+	if this.ReviewTimeoutAction != that1.ReviewTimeoutAction {
+		return false
+	}
+	if this.MinTaskExpiry != that1.MinTaskExpiry {
+		return false
+	}
+	if this.MaxTaskExpiry != that1.MaxTaskExpiry {
+		return false
+	}
+	if this.MinClaimDeadline != that1.MinClaimDeadline {
+		return false
+	}
+	if this.MaxClaimDeadline != that1.MaxClaimDeadline {
+		return false
+	}
+	if this.MinSubmissionDeadline != that1.MinSubmissionDeadline {
+		return false
+	}
+	if this.MaxSubmissionDeadline != that1.MaxSubmissionDeadline {
+		return false
+	}
+	if len(this.Arbiters) != len(that1.Arbiters) {
+		return false
+	}
+	for i := range this.Arbiters {
+		if this.Arbiters[i] != that1.Arbiters[i] {
+			return false
+		}
+	}
+	if this.MaxSubmissionAttempts != that1.MaxSubmissionAttempts {
+		return false
+	}
+	if this.ArchiveClosedTasks != that1.ArchiveClosedTasks {
+		return false
+	}
+	if len(this.BountyDenoms) != len(that1.BountyDenoms) {
+		return false
+	}
+	for i := range this.BountyDenoms {
+		if !this.BountyDenoms[i].Equal(&that1.BountyDenoms[i]) {
+			return false
+		}
+	}
+	if this.DisputeWindow != that1.DisputeWindow {
+		return false
+	}
+	if this.MaxDeadlinesPerBlock != that1.MaxDeadlinesPerBlock {
+		return false
+	}
+	if this.MaxDeadlineRetries != that1.MaxDeadlineRetries {
+		return false
+	}
+	return true
+}
+func (this *BountyDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BountyDenom)
+	if !ok {
+		that2, ok := that.(BountyDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MinBounty.Equal(&that1.MinBounty) {
+		return false
+	}
+	if !this.MaxBounty.Equal(&that1.MaxBounty) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +566,110 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDeadlineRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeadlineRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaxDeadlinesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeadlinesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DisputeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.BountyDenoms) > 0 {
+		for iNdEx := len(m.BountyDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BountyDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ArchiveClosedTasks {
+		i--
+		if m.ArchiveClosedTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxSubmissionAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubmissionAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arbiters[iNdEx])
+			copy(dAtA[i:], m.Arbiters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Arbiters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.MaxSubmissionDeadline != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubmissionDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinSubmissionDeadline != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinSubmissionDeadline))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxClaimDeadline != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxClaimDeadline))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinClaimDeadline != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinClaimDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxTaskExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTaskExpiry))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinTaskExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTaskExpiry))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ReviewTimeoutAction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReviewTimeoutAction))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.SubmissionDeadline != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SubmissionDeadline))
 		i--
@@ -311,6 +732,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BountyDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MinBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -356,7 +820,68 @@ func (m *Params) Size() (n int) {
 	if m.SubmissionDeadline != 0 {
 		n += 1 + sovParams(uint64(m.SubmissionDeadline))
 	}
-	return n
+	if m.ReviewTimeoutAction != 0 {
+		n += 1 + sovParams(uint64(m.ReviewTimeoutAction))
+	}
+	if m.MinTaskExpiry != 0 {
+		n += 1 + sovParams(uint64(m.MinTaskExpiry))
+	}
+	if m.MaxTaskExpiry != 0 {
+		n += 1 + sovParams(uint64(m.MaxTaskExpiry))
+	}
+	if m.MinClaimDeadline != 0 {
+		n += 1 + sovParams(uint64(m.MinClaimDeadline))
+	}
+	if m.MaxClaimDeadline != 0 {
+		n += 1 + sovParams(uint64(m.MaxClaimDeadline))
+	}
+	if m.MinSubmissionDeadline != 0 {
+		n += 1 + sovParams(uint64(m.MinSubmissionDeadline))
+	}
+	if m.MaxSubmissionDeadline != 0 {
+		n += 2 + sovParams(uint64(m.MaxSubmissionDeadline))
+	}
+	if len(m.Arbiters) > 0 {
+		for _, s := range m.Arbiters {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxSubmissionAttempts != 0 {
+		n += 2 + sovParams(uint64(m.MaxSubmissionAttempts))
+	}
+	if m.ArchiveClosedTasks {
+		n += 3
+	}
+	if len(m.BountyDenoms) > 0 {
+		for _, e := range m.BountyDenoms {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DisputeWindow != 0 {
+		n += 2 + sovParams(uint64(m.DisputeWindow))
+	}
+	if m.MaxDeadlinesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxDeadlinesPerBlock))
+	}
+	if m.MaxDeadlineRetries != 0 {
+		n += 2 + sovParams(uint64(m.MaxDeadlineRetries))
+	}
+	return n
+}
+
+func (m *BountyDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBounty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBounty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
 }
 
 func sovParams(x uint64) (n int) {
@@ -606,6 +1131,417 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewTimeoutAction", wireType)
+			}
+			m.ReviewTimeoutAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewTimeoutAction |= ReviewTimeoutAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskExpiry", wireType)
+			}
+			m.MinTaskExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTaskExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskExpiry", wireType)
+			}
+			m.MaxTaskExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinClaimDeadline", wireType)
+			}
+			m.MinClaimDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinClaimDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimDeadline", wireType)
+			}
+			m.MaxClaimDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubmissionDeadline", wireType)
+			}
+			m.MinSubmissionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSubmissionDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubmissionDeadline", wireType)
+			}
+			m.MaxSubmissionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubmissionDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubmissionAttempts", wireType)
+			}
+			m.MaxSubmissionAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubmissionAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveClosedTasks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchiveClosedTasks = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BountyDenoms = append(m.BountyDenoms, BountyDenom{})
+			if err := m.BountyDenoms[len(m.BountyDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			}
+			m.DisputeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeadlinesPerBlock", wireType)
+			}
+			m.MaxDeadlinesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeadlinesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeadlineRetries", wireType)
+			}
+			m.MaxDeadlineRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeadlineRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -207,6 +207,10 @@ func (m *QueryGetTaskResponse) GetTask() Task {
 // QueryAllTaskRequest defines the QueryAllTaskRequest message.
 type QueryAllTaskRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only tasks matching every set field of the filter are listed
+	Filter *TaskFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order of the listed tasks, by id ascending when unset
+	Sort *TaskSort `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
 func (m *QueryAllTaskRequest) Reset()         { *m = QueryAllTaskRequest{} }
@@ -249,6 +253,20 @@ func (m *QueryAllTaskRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllTaskRequest) GetFilter() *TaskFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *QueryAllTaskRequest) GetSort() *TaskSort {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
 // QueryAllTaskResponse defines the QueryAllTaskResponse message.
 type QueryAllTaskResponse struct {
 	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
@@ -580,933 +598,8075 @@ func (m *QueryGetTaskRewardsByClaimantResponse) GetTaskRewards() []TaskReward {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "taskbounty.task.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "taskbounty.task.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetTaskRequest)(nil), "taskbounty.task.v1.QueryGetTaskRequest")
-	proto.RegisterType((*QueryGetTaskResponse)(nil), "taskbounty.task.v1.QueryGetTaskResponse")
-	proto.RegisterType((*QueryAllTaskRequest)(nil), "taskbounty.task.v1.QueryAllTaskRequest")
-	proto.RegisterType((*QueryAllTaskResponse)(nil), "taskbounty.task.v1.QueryAllTaskResponse")
-	proto.RegisterType((*QueryGetTaskRewardRequest)(nil), "taskbounty.task.v1.QueryGetTaskRewardRequest")
-	proto.RegisterType((*QueryGetTaskRewardResponse)(nil), "taskbounty.task.v1.QueryGetTaskRewardResponse")
-	proto.RegisterType((*QueryAllTaskRewardRequest)(nil), "taskbounty.task.v1.QueryAllTaskRewardRequest")
-	proto.RegisterType((*QueryAllTaskRewardResponse)(nil), "taskbounty.task.v1.QueryAllTaskRewardResponse")
-	proto.RegisterType((*QueryGetTaskRewardsByClaimantRequest)(nil), "taskbounty.task.v1.QueryGetTaskRewardsByClaimantRequest")
-	proto.RegisterType((*QueryGetTaskRewardsByClaimantResponse)(nil), "taskbounty.task.v1.QueryGetTaskRewardsByClaimantResponse")
+// QueryGetTaskRefundRequest defines the QueryGetTaskRefundRequest message.
+type QueryGetTaskRefundRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("taskbounty/task/v1/query.proto", fileDescriptor_3836386d3dfdf39f) }
-
-var fileDescriptor_3836386d3dfdf39f = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0x85, 0x1f, 0x7f, 0x1e, 0x7e, 0x92, 0x38, 0x92, 0x58, 0x37, 0xb0, 0xc5, 0x09,
-	0xff, 0x02, 0xb2, 0x93, 0xc2, 0x45, 0x0f, 0x1e, 0xac, 0x51, 0x12, 0xe3, 0x01, 0x1b, 0x4e, 0x26,
-	0xc6, 0x4c, 0xdb, 0x4d, 0xb3, 0xa1, 0xdd, 0x59, 0x3a, 0x0b, 0xda, 0x10, 0x62, 0xa2, 0x77, 0x63,
-	0xc2, 0xc5, 0x83, 0x2f, 0xc0, 0x83, 0x07, 0x5f, 0x84, 0x07, 0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x03,
-	0x26, 0xbe, 0x0d, 0xb3, 0x33, 0x4f, 0xcb, 0x2e, 0xdd, 0x65, 0x2b, 0xe1, 0x42, 0x86, 0xd9, 0xe7,
-	0xcf, 0xe7, 0xfb, 0x7d, 0x98, 0x07, 0xb0, 0x02, 0x2e, 0xb7, 0xab, 0x62, 0xd7, 0x0b, 0x3a, 0x2c,
-	0x3c, 0xb2, 0xbd, 0x12, 0xdb, 0xd9, 0x75, 0xda, 0x1d, 0xdb, 0x6f, 0x8b, 0x40, 0x10, 0x72, 0xf6,
-	0xdd, 0x0e, 0x8f, 0xf6, 0x5e, 0xc9, 0xbc, 0xce, 0x5b, 0xae, 0x27, 0x98, 0xfa, 0xa9, 0xc3, 0xcc,
-	0xe5, 0x9a, 0x90, 0x2d, 0x21, 0x59, 0x95, 0x4b, 0x47, 0xe7, 0xb3, 0xbd, 0x52, 0xd5, 0x09, 0x78,
-	0x89, 0xf9, 0xbc, 0xe1, 0x7a, 0x3c, 0x70, 0x85, 0x87, 0xb1, 0x53, 0x0d, 0xd1, 0x10, 0xea, 0xc8,
-	0xc2, 0x13, 0xde, 0x4e, 0x37, 0x84, 0x68, 0x34, 0x1d, 0xc6, 0x7d, 0x97, 0x71, 0xcf, 0x13, 0x81,
-	0x4a, 0x91, 0xf8, 0xb5, 0x98, 0x80, 0xe9, 0xf3, 0x36, 0x6f, 0x75, 0x03, 0x66, 0x12, 0x02, 0x14,
-	0xaf, 0xfa, 0x4c, 0xa7, 0x80, 0x3c, 0x0b, 0xa9, 0x36, 0x55, 0x4e, 0xc5, 0xd9, 0xd9, 0x75, 0x64,
-	0x40, 0xb7, 0xe0, 0x46, 0xec, 0x56, 0xfa, 0xc2, 0x93, 0x0e, 0xb9, 0x0f, 0x23, 0xba, 0x76, 0xc1,
-	0x98, 0x35, 0x96, 0x26, 0xd6, 0x4c, 0xbb, 0xdf, 0x04, 0x5b, 0xe7, 0x94, 0xc7, 0x8f, 0x7e, 0x16,
-	0x73, 0x9f, 0xff, 0x7c, 0x5d, 0x36, 0x2a, 0x98, 0x44, 0xe7, 0xb1, 0xea, 0x86, 0x13, 0x6c, 0x71,
-	0xb9, 0x8d, 0xcd, 0xc8, 0x24, 0xe4, 0xdd, 0xba, 0xaa, 0x38, 0x5c, 0xc9, 0xbb, 0x75, 0xfa, 0x04,
-	0xa6, 0xe2, 0x61, 0xd8, 0x7d, 0x0d, 0x86, 0xc3, 0x1e, 0xd8, 0xbb, 0x90, 0xd4, 0x3b, 0x8c, 0x2f,
-	0x0f, 0x87, 0x9d, 0x2b, 0x2a, 0x96, 0xbe, 0xc0, 0x96, 0x0f, 0x9a, 0xcd, 0x68, 0xcb, 0xc7, 0x00,
-	0x67, 0xee, 0x63, 0xc1, 0x05, 0x5b, 0x8f, 0xca, 0x0e, 0x47, 0x65, 0xeb, 0x51, 0xe3, 0xa8, 0xec,
-	0x4d, 0xde, 0x70, 0x30, 0xb7, 0x12, 0xc9, 0xa4, 0x87, 0x06, 0xb2, 0xf6, 0xea, 0xf7, 0xb1, 0x0e,
-	0x0d, 0xca, 0x4a, 0x36, 0x62, 0x50, 0x79, 0x05, 0xb5, 0x98, 0x09, 0xa5, 0x1b, 0xc6, 0xa8, 0x56,
-	0xe0, 0x56, 0xdc, 0xc0, 0x57, 0xbc, 0x5d, 0x4f, 0x73, 0xbb, 0x06, 0x66, 0x52, 0x30, 0xea, 0x78,
-	0x04, 0x13, 0x21, 0xdb, 0xcb, 0xb6, 0xba, 0x46, 0xa7, 0xac, 0x34, 0x39, 0x3a, 0x19, 0x45, 0x41,
-	0xd0, 0xbb, 0xa1, 0x35, 0x24, 0xea, 0xd9, 0x14, 0x25, 0xba, 0xaa, 0x61, 0x7c, 0x31, 0x50, 0xca,
-	0xb9, 0x2e, 0x69, 0x52, 0x86, 0x2e, 0x23, 0xe5, 0xea, 0xa6, 0x54, 0x86, 0xb9, 0x7e, 0xe3, 0x65,
-	0xb9, 0xf3, 0xb0, 0xc9, 0xdd, 0x16, 0xf7, 0x82, 0xae, 0x3d, 0x26, 0x8c, 0xd5, 0xf0, 0x4a, 0x99,
-	0x33, 0x5e, 0xe9, 0xfd, 0x4e, 0x7d, 0x98, 0xcf, 0xa8, 0x81, 0xe2, 0x37, 0xe0, 0xff, 0x88, 0x78,
-	0xf9, 0x4f, 0xea, 0x27, 0xce, 0xd4, 0xcb, 0xb5, 0xf7, 0xa3, 0xf0, 0x9f, 0x6a, 0x49, 0x0e, 0x60,
-	0x44, 0x3f, 0x75, 0xb2, 0x90, 0x54, 0xa6, 0x7f, 0xab, 0x98, 0x8b, 0x99, 0x71, 0x9a, 0x96, 0xd2,
-	0xb7, 0xdf, 0x7f, 0x1f, 0xe6, 0xa7, 0x89, 0xc9, 0x52, 0xb7, 0x1b, 0x79, 0x67, 0xc0, 0x28, 0xca,
-	0x26, 0xe9, 0x85, 0xe3, 0xab, 0xc6, 0x5c, 0xca, 0x0e, 0x44, 0x84, 0x79, 0x85, 0x50, 0x24, 0x33,
-	0x2c, 0x65, 0x7f, 0xb2, 0x7d, 0xb7, 0x7e, 0x40, 0xde, 0xc0, 0xd8, 0x53, 0x57, 0x66, 0x51, 0xc4,
-	0xb7, 0xcf, 0x05, 0x14, 0xe7, 0xd6, 0x08, 0x9d, 0x55, 0x14, 0x26, 0x29, 0xa4, 0x51, 0x90, 0x4f,
-	0x06, 0x5c, 0x8b, 0x4d, 0x9f, 0xac, 0x66, 0x6b, 0x8c, 0xbc, 0x3e, 0xd3, 0x1e, 0x34, 0x1c, 0x91,
-	0xee, 0x28, 0xa4, 0x05, 0x32, 0x97, 0x86, 0x84, 0x7f, 0x63, 0xda, 0x9f, 0x8f, 0x06, 0x4c, 0x76,
-	0x0d, 0xca, 0xe4, 0x4b, 0xda, 0x0e, 0x17, 0xf0, 0x25, 0x3e, 0x73, 0xba, 0xa8, 0xf8, 0x6e, 0x93,
-	0x62, 0x06, 0x1f, 0xf9, 0x66, 0x40, 0x21, 0xed, 0xdd, 0x90, 0xbb, 0x83, 0xb9, 0xd2, 0xff, 0x5c,
-	0xcd, 0x7b, 0x97, 0xc8, 0x44, 0xf4, 0x75, 0x85, 0xbe, 0x4a, 0x56, 0x32, 0xd0, 0x25, 0xdb, 0xef,
-	0x6e, 0x80, 0x83, 0x72, 0xe9, 0xe8, 0xc4, 0x32, 0x8e, 0x4f, 0x2c, 0xe3, 0xd7, 0x89, 0x65, 0x7c,
-	0x38, 0xb5, 0x72, 0xc7, 0xa7, 0x56, 0xee, 0xc7, 0xa9, 0x95, 0x7b, 0x7e, 0x33, 0x52, 0xe5, 0xb5,
-	0xae, 0x13, 0x74, 0x7c, 0x47, 0x56, 0x47, 0xd4, 0xbf, 0xfe, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x76, 0xce, 0xa5, 0x2c, 0xe3, 0x08, 0x00, 0x00,
+func (m *QueryGetTaskRefundRequest) Reset()         { *m = QueryGetTaskRefundRequest{} }
+func (m *QueryGetTaskRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskRefundRequest) ProtoMessage()    {}
+func (*QueryGetTaskRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{12}
+}
+func (m *QueryGetTaskRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTaskRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTaskRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskRefundRequest.Merge(m, src)
+}
+func (m *QueryGetTaskRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskRefundRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetTaskRefundRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListTask Queries a list of Task items.
-	GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error)
-	// ListTask defines the ListTask RPC.
-	ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error)
-	// Queries list of TaskReward items
-	GetTaskReward(ctx context.Context, in *QueryGetTaskRewardRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardResponse, error)
-	// Queries TaskReward
-	ListTaskReward(ctx context.Context, in *QueryAllTaskRewardRequest, opts ...grpc.CallOption) (*QueryAllTaskRewardResponse, error)
-	// Queries TaskReward items by claimant
-	GetTaskRewardsByClaimant(ctx context.Context, in *QueryGetTaskRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardsByClaimantResponse, error)
+func (m *QueryGetTaskRefundRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetTaskRefundResponse defines the QueryGetTaskRefundResponse message.
+type QueryGetTaskRefundResponse struct {
+	TaskRefund TaskRefund `protobuf:"bytes,1,opt,name=task_refund,json=taskRefund,proto3" json:"task_refund"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetTaskRefundResponse) Reset()         { *m = QueryGetTaskRefundResponse{} }
+func (m *QueryGetTaskRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskRefundResponse) ProtoMessage()    {}
+func (*QueryGetTaskRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{13}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetTaskRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTaskRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetTaskRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskRefundResponse.Merge(m, src)
+}
+func (m *QueryGetTaskRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskRefundResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error) {
-	out := new(QueryGetTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetTaskRefundResponse proto.InternalMessageInfo
+
+func (m *QueryGetTaskRefundResponse) GetTaskRefund() TaskRefund {
+	if m != nil {
+		return m.TaskRefund
 	}
-	return out, nil
+	return TaskRefund{}
 }
 
-func (c *queryClient) ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error) {
-	out := new(QueryAllTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAllTaskRefundRequest defines the QueryAllTaskRefundRequest message.
+type QueryAllTaskRefundRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTaskRefundRequest) Reset()         { *m = QueryAllTaskRefundRequest{} }
+func (m *QueryAllTaskRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskRefundRequest) ProtoMessage()    {}
+func (*QueryAllTaskRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{14}
+}
+func (m *QueryAllTaskRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTaskRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskRefundRequest.Merge(m, src)
+}
+func (m *QueryAllTaskRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskRefundRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTaskReward(ctx context.Context, in *QueryGetTaskRewardRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardResponse, error) {
-	out := new(QueryGetTaskRewardResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTaskReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTaskRefundRequest proto.InternalMessageInfo
+
+func (m *QueryAllTaskRefundRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListTaskReward(ctx context.Context, in *QueryAllTaskRewardRequest, opts ...grpc.CallOption) (*QueryAllTaskRewardResponse, error) {
-	out := new(QueryAllTaskRewardResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTaskReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAllTaskRefundResponse defines the QueryAllTaskRefundResponse message.
+type QueryAllTaskRefundResponse struct {
+	TaskRefund []TaskRefund        `protobuf:"bytes,1,rep,name=task_refund,json=taskRefund,proto3" json:"task_refund"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTaskRefundResponse) Reset()         { *m = QueryAllTaskRefundResponse{} }
+func (m *QueryAllTaskRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskRefundResponse) ProtoMessage()    {}
+func (*QueryAllTaskRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{15}
+}
+func (m *QueryAllTaskRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTaskRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskRefundResponse.Merge(m, src)
+}
+func (m *QueryAllTaskRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskRefundResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTaskRewardsByClaimant(ctx context.Context, in *QueryGetTaskRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardsByClaimantResponse, error) {
-	out := new(QueryGetTaskRewardsByClaimantResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTaskRewardsByClaimant", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTaskRefundResponse proto.InternalMessageInfo
+
+func (m *QueryAllTaskRefundResponse) GetTaskRefund() []TaskRefund {
+	if m != nil {
+		return m.TaskRefund
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListTask Queries a list of Task items.
-	GetTask(context.Context, *QueryGetTaskRequest) (*QueryGetTaskResponse, error)
-	// ListTask defines the ListTask RPC.
-	ListTask(context.Context, *QueryAllTaskRequest) (*QueryAllTaskResponse, error)
-	// Queries list of TaskReward items
-	GetTaskReward(context.Context, *QueryGetTaskRewardRequest) (*QueryGetTaskRewardResponse, error)
-	// Queries TaskReward
-	ListTaskReward(context.Context, *QueryAllTaskRewardRequest) (*QueryAllTaskRewardResponse, error)
-	// Queries TaskReward items by claimant
-	GetTaskRewardsByClaimant(context.Context, *QueryGetTaskRewardsByClaimantRequest) (*QueryGetTaskRewardsByClaimantResponse, error)
+func (m *QueryAllTaskRefundResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAllTaskReviewRequest defines the QueryAllTaskReviewRequest message.
+type QueryAllTaskReviewRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllTaskReviewRequest) Reset()         { *m = QueryAllTaskReviewRequest{} }
+func (m *QueryAllTaskReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskReviewRequest) ProtoMessage()    {}
+func (*QueryAllTaskReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{16}
 }
-func (*UnimplementedQueryServer) GetTask(ctx context.Context, req *QueryGetTaskRequest) (*QueryGetTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+func (m *QueryAllTaskReviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListTask(ctx context.Context, req *QueryAllTaskRequest) (*QueryAllTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+func (m *QueryAllTaskReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskReviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetTaskReward(ctx context.Context, req *QueryGetTaskRewardRequest) (*QueryGetTaskRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskReward not implemented")
+func (m *QueryAllTaskReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskReviewRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListTaskReward(ctx context.Context, req *QueryAllTaskRewardRequest) (*QueryAllTaskRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTaskReward not implemented")
+func (m *QueryAllTaskReviewRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetTaskRewardsByClaimant(ctx context.Context, req *QueryGetTaskRewardsByClaimantRequest) (*QueryGetTaskRewardsByClaimantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRewardsByClaimant not implemented")
+func (m *QueryAllTaskReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskReviewRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllTaskReviewRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllTaskReviewRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/GetTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTask(ctx, req.(*QueryGetTaskRequest))
+func (m *QueryAllTaskReviewRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/ListTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListTask(ctx, req.(*QueryAllTaskRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllTaskReviewResponse defines the QueryAllTaskReviewResponse message.
+type QueryAllTaskReviewResponse struct {
+	TaskReview []TaskReview        `protobuf:"bytes,1,rep,name=task_review,json=taskReview,proto3" json:"task_review"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetTaskReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTaskRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTaskReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/GetTaskReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTaskReward(ctx, req.(*QueryGetTaskRewardRequest))
+func (m *QueryAllTaskReviewResponse) Reset()         { *m = QueryAllTaskReviewResponse{} }
+func (m *QueryAllTaskReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskReviewResponse) ProtoMessage()    {}
+func (*QueryAllTaskReviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{17}
+}
+func (m *QueryAllTaskReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllTaskReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskReviewResponse.Merge(m, src)
+}
+func (m *QueryAllTaskReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskReviewResponse.DiscardUnknown(m)
 }
 
-func _Query_ListTaskReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTaskRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListTaskReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/ListTaskReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListTaskReward(ctx, req.(*QueryAllTaskRewardRequest))
+var xxx_messageInfo_QueryAllTaskReviewResponse proto.InternalMessageInfo
+
+func (m *QueryAllTaskReviewResponse) GetTaskReview() []TaskReview {
+	if m != nil {
+		return m.TaskReview
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetTaskRewardsByClaimant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTaskRewardsByClaimantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTaskRewardsByClaimant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Query/GetTaskRewardsByClaimant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTaskRewardsByClaimant(ctx, req.(*QueryGetTaskRewardsByClaimantRequest))
+func (m *QueryAllTaskReviewResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "taskbounty.task.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetTask",
-			Handler:    _Query_GetTask_Handler,
-		},
-		{
-			MethodName: "ListTask",
-			Handler:    _Query_ListTask_Handler,
-		},
-		{
-			MethodName: "GetTaskReward",
-			Handler:    _Query_GetTaskReward_Handler,
-		},
-		{
-			MethodName: "ListTaskReward",
-			Handler:    _Query_ListTaskReward_Handler,
-		},
-		{
-			MethodName: "GetTaskRewardsByClaimant",
-			Handler:    _Query_GetTaskRewardsByClaimant_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "taskbounty/task/v1/query.proto",
+// QueryAllTaskDisputeRequest defines the QueryAllTaskDisputeRequest message.
+type QueryAllTaskDisputeRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllTaskDisputeRequest) Reset()         { *m = QueryAllTaskDisputeRequest{} }
+func (m *QueryAllTaskDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskDisputeRequest) ProtoMessage()    {}
+func (*QueryAllTaskDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{18}
+}
+func (m *QueryAllTaskDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllTaskDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskDisputeRequest.Merge(m, src)
+}
+func (m *QueryAllTaskDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskDisputeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllTaskDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryAllTaskDisputeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllTaskDisputeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllTaskDisputeResponse defines the QueryAllTaskDisputeResponse message.
+type QueryAllTaskDisputeResponse struct {
+	TaskDispute []TaskDispute       `protobuf:"bytes,1,rep,name=task_dispute,json=taskDispute,proto3" json:"task_dispute"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllTaskDisputeResponse) Reset()         { *m = QueryAllTaskDisputeResponse{} }
+func (m *QueryAllTaskDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskDisputeResponse) ProtoMessage()    {}
+func (*QueryAllTaskDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{19}
+}
+func (m *QueryAllTaskDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllTaskDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskDisputeResponse.Merge(m, src)
 }
-
-func (m *QueryGetTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllTaskDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAllTaskDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryAllTaskDisputeResponse) GetTaskDispute() []TaskDispute {
+	if m != nil {
+		return m.TaskDispute
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllTaskDisputeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetSubmissionRequest defines the QueryGetSubmissionRequest message.
+type QueryGetSubmissionRequest struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
-func (m *QueryGetTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetSubmissionRequest) Reset()         { *m = QueryGetSubmissionRequest{} }
+func (m *QueryGetSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSubmissionRequest) ProtoMessage()    {}
+func (*QueryGetSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{20}
+}
+func (m *QueryGetSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSubmissionRequest.Merge(m, src)
 }
-
-func (m *QueryAllTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetSubmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSubmissionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetSubmissionRequest proto.InternalMessageInfo
+
+func (m *QueryGetSubmissionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetSubmissionRequest) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetSubmissionResponse defines the QueryGetSubmissionResponse message.
+type QueryGetSubmissionResponse struct {
+	Submission Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission"`
 }
 
-func (m *QueryAllTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Task) > 0 {
-		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetSubmissionResponse) Reset()         { *m = QueryGetSubmissionResponse{} }
+func (m *QueryGetSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSubmissionResponse) ProtoMessage()    {}
+func (*QueryGetSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{21}
+}
+func (m *QueryGetSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetTaskRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSubmissionResponse.Merge(m, src)
 }
-
-func (m *QueryGetTaskRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetSubmissionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetTaskRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSubmissionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTaskRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSubmissionResponse proto.InternalMessageInfo
+
+func (m *QueryGetSubmissionResponse) GetSubmission() Submission {
+	if m != nil {
+		return m.Submission
 	}
-	return dAtA[:n], nil
+	return Submission{}
 }
 
-func (m *QueryGetTaskRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllSubmissionRequest defines the QueryAllSubmissionRequest message.
+type QueryAllSubmissionRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTaskRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TaskReward.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllSubmissionRequest) Reset()         { *m = QueryAllSubmissionRequest{} }
+func (m *QueryAllSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSubmissionRequest) ProtoMessage()    {}
+func (*QueryAllSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{22}
+}
+func (m *QueryAllSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllTaskRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSubmissionRequest.Merge(m, src)
 }
-
-func (m *QueryAllTaskRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllSubmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSubmissionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllTaskRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllSubmissionRequest proto.InternalMessageInfo
+
+func (m *QueryAllSubmissionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllTaskRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllSubmissionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllTaskRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllSubmissionResponse defines the QueryAllSubmissionResponse message.
+type QueryAllSubmissionResponse struct {
+	Submission []Submission        `protobuf:"bytes,1,rep,name=submission,proto3" json:"submission"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllTaskRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllSubmissionResponse) Reset()         { *m = QueryAllSubmissionResponse{} }
+func (m *QueryAllSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSubmissionResponse) ProtoMessage()    {}
+func (*QueryAllSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{23}
+}
+func (m *QueryAllSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.TaskReward) > 0 {
-		for iNdEx := len(m.TaskReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAllSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSubmissionResponse.Merge(m, src)
+}
+func (m *QueryAllSubmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSubmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSubmissionResponse proto.InternalMessageInfo
+
+func (m *QueryAllSubmissionResponse) GetSubmission() []Submission {
+	if m != nil {
+		return m.Submission
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetTaskRewardsByClaimantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllSubmissionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTaskRewardsByClaimantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTasksByCreatorRequest defines the QueryTasksByCreatorRequest message.
+type QueryTasksByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTaskRewardsByClaimantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTasksByCreatorRequest) Reset()         { *m = QueryTasksByCreatorRequest{} }
+func (m *QueryTasksByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByCreatorRequest) ProtoMessage()    {}
+func (*QueryTasksByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{24}
+}
+func (m *QueryTasksByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTasksByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByCreatorRequest.Merge(m, src)
+}
+func (m *QueryTasksByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByCreatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTaskRewardsByClaimantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTasksByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryTasksByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetTaskRewardsByClaimantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTasksByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetTaskRewardsByClaimantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskRewards) > 0 {
-		for iNdEx := len(m.TaskRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryTasksByCreatorResponse defines the QueryTasksByCreatorResponse message.
+type QueryTasksByCreatorResponse struct {
+	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByCreatorResponse) Reset()         { *m = QueryTasksByCreatorResponse{} }
+func (m *QueryTasksByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByCreatorResponse) ProtoMessage()    {}
+func (*QueryTasksByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{25}
+}
+func (m *QueryTasksByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTasksByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByCreatorResponse.Merge(m, src)
+}
+func (m *QueryTasksByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByCreatorResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTasksByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryTasksByCreatorResponse) GetTask() []Task {
+	if m != nil {
+		return m.Task
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryTasksByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryTasksByClaimantRequest defines the QueryTasksByClaimantRequest message.
+type QueryTasksByClaimantRequest struct {
+	Claimant   string             `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryTasksByClaimantRequest) Reset()         { *m = QueryTasksByClaimantRequest{} }
+func (m *QueryTasksByClaimantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByClaimantRequest) ProtoMessage()    {}
+func (*QueryTasksByClaimantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{26}
+}
+func (m *QueryTasksByClaimantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByClaimantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByClaimantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryTasksByClaimantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByClaimantRequest.Merge(m, src)
+}
+func (m *QueryTasksByClaimantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByClaimantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByClaimantRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTasksByClaimantRequest proto.InternalMessageInfo
+
+func (m *QueryTasksByClaimantRequest) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	var l int
-	_ = l
-	l = m.Task.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTasksByClaimantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Task) > 0 {
-		for _, e := range m.Task {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryTasksByClaimantResponse defines the QueryTasksByClaimantResponse message.
+type QueryTasksByClaimantResponse struct {
+	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByClaimantResponse) Reset()         { *m = QueryTasksByClaimantResponse{} }
+func (m *QueryTasksByClaimantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByClaimantResponse) ProtoMessage()    {}
+func (*QueryTasksByClaimantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{27}
+}
+func (m *QueryTasksByClaimantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByClaimantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByClaimantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTasksByClaimantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByClaimantResponse.Merge(m, src)
+}
+func (m *QueryTasksByClaimantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByClaimantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByClaimantResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTaskRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryTasksByClaimantResponse proto.InternalMessageInfo
+
+func (m *QueryTasksByClaimantResponse) GetTask() []Task {
+	if m != nil {
+		return m.Task
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetTaskRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTasksByClaimantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.TaskReward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllTaskRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryTasksByStatusRequest defines the QueryTasksByStatusRequest message.
+type QueryTasksByStatusRequest struct {
+	Status     TaskStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByStatusRequest) Reset()         { *m = QueryTasksByStatusRequest{} }
+func (m *QueryTasksByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByStatusRequest) ProtoMessage()    {}
+func (*QueryTasksByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{28}
+}
+func (m *QueryTasksByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTasksByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByStatusRequest.Merge(m, src)
+}
+func (m *QueryTasksByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTasksByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryTasksByStatusRequest) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return TASK_STATUS_UNDEFINED
 }
 
-func (m *QueryAllTaskRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTasksByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.TaskReward) > 0 {
-		for _, e := range m.TaskReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryTasksByStatusResponse defines the QueryTasksByStatusResponse message.
+type QueryTasksByStatusResponse struct {
+	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByStatusResponse) Reset()         { *m = QueryTasksByStatusResponse{} }
+func (m *QueryTasksByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByStatusResponse) ProtoMessage()    {}
+func (*QueryTasksByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{29}
+}
+func (m *QueryTasksByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTasksByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByStatusResponse.Merge(m, src)
+}
+func (m *QueryTasksByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTasksByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryTasksByStatusResponse) GetTask() []Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *QueryTasksByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsByClaimantRequest defines the QueryRewardsByClaimantRequest message.
+type QueryRewardsByClaimantRequest struct {
+	Claimant   string             `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsByClaimantRequest) Reset()         { *m = QueryRewardsByClaimantRequest{} }
+func (m *QueryRewardsByClaimantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsByClaimantRequest) ProtoMessage()    {}
+func (*QueryRewardsByClaimantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{30}
+}
+func (m *QueryRewardsByClaimantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsByClaimantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsByClaimantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsByClaimantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsByClaimantRequest.Merge(m, src)
+}
+func (m *QueryRewardsByClaimantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsByClaimantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsByClaimantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsByClaimantRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsByClaimantRequest) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
+func (m *QueryRewardsByClaimantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsByClaimantResponse defines the QueryRewardsByClaimantResponse message.
+type QueryRewardsByClaimantResponse struct {
+	TaskReward []TaskReward        `protobuf:"bytes,1,rep,name=task_reward,json=taskReward,proto3" json:"task_reward"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsByClaimantResponse) Reset()         { *m = QueryRewardsByClaimantResponse{} }
+func (m *QueryRewardsByClaimantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsByClaimantResponse) ProtoMessage()    {}
+func (*QueryRewardsByClaimantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{31}
+}
+func (m *QueryRewardsByClaimantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsByClaimantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsByClaimantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsByClaimantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsByClaimantResponse.Merge(m, src)
+}
+func (m *QueryRewardsByClaimantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsByClaimantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsByClaimantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsByClaimantResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsByClaimantResponse) GetTaskReward() []TaskReward {
+	if m != nil {
+		return m.TaskReward
+	}
+	return nil
+}
+
+func (m *QueryRewardsByClaimantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTaskHistoryRequest defines the QueryTaskHistoryRequest message.
+type QueryTaskHistoryRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTaskHistoryRequest) Reset()         { *m = QueryTaskHistoryRequest{} }
+func (m *QueryTaskHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskHistoryRequest) ProtoMessage()    {}
+func (*QueryTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{32}
+}
+func (m *QueryTaskHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskHistoryRequest.Merge(m, src)
+}
+func (m *QueryTaskHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTaskHistoryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryTaskHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTaskHistoryResponse defines the QueryTaskHistoryResponse message.
+type QueryTaskHistoryResponse struct {
+	TaskTransition []TaskTransition    `protobuf:"bytes,1,rep,name=task_transition,json=taskTransition,proto3" json:"task_transition"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTaskHistoryResponse) Reset()         { *m = QueryTaskHistoryResponse{} }
+func (m *QueryTaskHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskHistoryResponse) ProtoMessage()    {}
+func (*QueryTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{33}
+}
+func (m *QueryTaskHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskHistoryResponse.Merge(m, src)
+}
+func (m *QueryTaskHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTaskHistoryResponse) GetTaskTransition() []TaskTransition {
+	if m != nil {
+		return m.TaskTransition
+	}
+	return nil
+}
+
+func (m *QueryTaskHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetArchivedTaskRequest defines the QueryGetArchivedTaskRequest message.
+type QueryGetArchivedTaskRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetArchivedTaskRequest) Reset()         { *m = QueryGetArchivedTaskRequest{} }
+func (m *QueryGetArchivedTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetArchivedTaskRequest) ProtoMessage()    {}
+func (*QueryGetArchivedTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{34}
+}
+func (m *QueryGetArchivedTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetArchivedTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetArchivedTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetArchivedTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetArchivedTaskRequest.Merge(m, src)
+}
+func (m *QueryGetArchivedTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetArchivedTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetArchivedTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetArchivedTaskRequest proto.InternalMessageInfo
+
+func (m *QueryGetArchivedTaskRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetArchivedTaskResponse defines the QueryGetArchivedTaskResponse message.
+type QueryGetArchivedTaskResponse struct {
+	Task Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task"`
+}
+
+func (m *QueryGetArchivedTaskResponse) Reset()         { *m = QueryGetArchivedTaskResponse{} }
+func (m *QueryGetArchivedTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetArchivedTaskResponse) ProtoMessage()    {}
+func (*QueryGetArchivedTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{35}
+}
+func (m *QueryGetArchivedTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetArchivedTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetArchivedTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetArchivedTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetArchivedTaskResponse.Merge(m, src)
+}
+func (m *QueryGetArchivedTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetArchivedTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetArchivedTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetArchivedTaskResponse proto.InternalMessageInfo
+
+func (m *QueryGetArchivedTaskResponse) GetTask() Task {
+	if m != nil {
+		return m.Task
+	}
+	return Task{}
+}
+
+// QueryAllArchivedTaskRequest defines the QueryAllArchivedTaskRequest message.
+type QueryAllArchivedTaskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllArchivedTaskRequest) Reset()         { *m = QueryAllArchivedTaskRequest{} }
+func (m *QueryAllArchivedTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllArchivedTaskRequest) ProtoMessage()    {}
+func (*QueryAllArchivedTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{36}
+}
+func (m *QueryAllArchivedTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllArchivedTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllArchivedTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllArchivedTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllArchivedTaskRequest.Merge(m, src)
+}
+func (m *QueryAllArchivedTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllArchivedTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllArchivedTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllArchivedTaskRequest proto.InternalMessageInfo
+
+func (m *QueryAllArchivedTaskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllArchivedTaskResponse defines the QueryAllArchivedTaskResponse message.
+type QueryAllArchivedTaskResponse struct {
+	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllArchivedTaskResponse) Reset()         { *m = QueryAllArchivedTaskResponse{} }
+func (m *QueryAllArchivedTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllArchivedTaskResponse) ProtoMessage()    {}
+func (*QueryAllArchivedTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{37}
+}
+func (m *QueryAllArchivedTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllArchivedTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllArchivedTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllArchivedTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllArchivedTaskResponse.Merge(m, src)
+}
+func (m *QueryAllArchivedTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllArchivedTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllArchivedTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllArchivedTaskResponse proto.InternalMessageInfo
+
+func (m *QueryAllArchivedTaskResponse) GetTask() []Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *QueryAllArchivedTaskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetRemoteTaskRequest defines the QueryGetRemoteTaskRequest message.
+type QueryGetRemoteTaskRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TaskId    uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *QueryGetRemoteTaskRequest) Reset()         { *m = QueryGetRemoteTaskRequest{} }
+func (m *QueryGetRemoteTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteTaskRequest) ProtoMessage()    {}
+func (*QueryGetRemoteTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{38}
+}
+func (m *QueryGetRemoteTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemoteTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRemoteTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteTaskRequest.Merge(m, src)
+}
+func (m *QueryGetRemoteTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRemoteTaskRequest proto.InternalMessageInfo
+
+func (m *QueryGetRemoteTaskRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetRemoteTaskRequest) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+// QueryGetRemoteTaskResponse defines the QueryGetRemoteTaskResponse message.
+type QueryGetRemoteTaskResponse struct {
+	RemoteTask RemoteTask `protobuf:"bytes,1,opt,name=remote_task,json=remoteTask,proto3" json:"remote_task"`
+}
+
+func (m *QueryGetRemoteTaskResponse) Reset()         { *m = QueryGetRemoteTaskResponse{} }
+func (m *QueryGetRemoteTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteTaskResponse) ProtoMessage()    {}
+func (*QueryGetRemoteTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{39}
+}
+func (m *QueryGetRemoteTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemoteTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRemoteTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteTaskResponse.Merge(m, src)
+}
+func (m *QueryGetRemoteTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRemoteTaskResponse proto.InternalMessageInfo
+
+func (m *QueryGetRemoteTaskResponse) GetRemoteTask() RemoteTask {
+	if m != nil {
+		return m.RemoteTask
+	}
+	return RemoteTask{}
+}
+
+// QueryAllRemoteTaskRequest defines the QueryAllRemoteTaskRequest message.
+type QueryAllRemoteTaskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRemoteTaskRequest) Reset()         { *m = QueryAllRemoteTaskRequest{} }
+func (m *QueryAllRemoteTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRemoteTaskRequest) ProtoMessage()    {}
+func (*QueryAllRemoteTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{40}
+}
+func (m *QueryAllRemoteTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRemoteTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRemoteTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRemoteTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRemoteTaskRequest.Merge(m, src)
+}
+func (m *QueryAllRemoteTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRemoteTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRemoteTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRemoteTaskRequest proto.InternalMessageInfo
+
+func (m *QueryAllRemoteTaskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRemoteTaskResponse defines the QueryAllRemoteTaskResponse message.
+type QueryAllRemoteTaskResponse struct {
+	RemoteTask []RemoteTask        `protobuf:"bytes,1,rep,name=remote_task,json=remoteTask,proto3" json:"remote_task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRemoteTaskResponse) Reset()         { *m = QueryAllRemoteTaskResponse{} }
+func (m *QueryAllRemoteTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRemoteTaskResponse) ProtoMessage()    {}
+func (*QueryAllRemoteTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3836386d3dfdf39f, []int{41}
+}
+func (m *QueryAllRemoteTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRemoteTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRemoteTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRemoteTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRemoteTaskResponse.Merge(m, src)
+}
+func (m *QueryAllRemoteTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRemoteTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRemoteTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRemoteTaskResponse proto.InternalMessageInfo
+
+func (m *QueryAllRemoteTaskResponse) GetRemoteTask() []RemoteTask {
+	if m != nil {
+		return m.RemoteTask
+	}
+	return nil
+}
+
+func (m *QueryAllRemoteTaskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "taskbounty.task.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "taskbounty.task.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetTaskRequest)(nil), "taskbounty.task.v1.QueryGetTaskRequest")
+	proto.RegisterType((*QueryGetTaskResponse)(nil), "taskbounty.task.v1.QueryGetTaskResponse")
+	proto.RegisterType((*QueryAllTaskRequest)(nil), "taskbounty.task.v1.QueryAllTaskRequest")
+	proto.RegisterType((*QueryAllTaskResponse)(nil), "taskbounty.task.v1.QueryAllTaskResponse")
+	proto.RegisterType((*QueryGetTaskRewardRequest)(nil), "taskbounty.task.v1.QueryGetTaskRewardRequest")
+	proto.RegisterType((*QueryGetTaskRewardResponse)(nil), "taskbounty.task.v1.QueryGetTaskRewardResponse")
+	proto.RegisterType((*QueryAllTaskRewardRequest)(nil), "taskbounty.task.v1.QueryAllTaskRewardRequest")
+	proto.RegisterType((*QueryAllTaskRewardResponse)(nil), "taskbounty.task.v1.QueryAllTaskRewardResponse")
+	proto.RegisterType((*QueryGetTaskRewardsByClaimantRequest)(nil), "taskbounty.task.v1.QueryGetTaskRewardsByClaimantRequest")
+	proto.RegisterType((*QueryGetTaskRewardsByClaimantResponse)(nil), "taskbounty.task.v1.QueryGetTaskRewardsByClaimantResponse")
+	proto.RegisterType((*QueryGetTaskRefundRequest)(nil), "taskbounty.task.v1.QueryGetTaskRefundRequest")
+	proto.RegisterType((*QueryGetTaskRefundResponse)(nil), "taskbounty.task.v1.QueryGetTaskRefundResponse")
+	proto.RegisterType((*QueryAllTaskRefundRequest)(nil), "taskbounty.task.v1.QueryAllTaskRefundRequest")
+	proto.RegisterType((*QueryAllTaskRefundResponse)(nil), "taskbounty.task.v1.QueryAllTaskRefundResponse")
+	proto.RegisterType((*QueryAllTaskReviewRequest)(nil), "taskbounty.task.v1.QueryAllTaskReviewRequest")
+	proto.RegisterType((*QueryAllTaskReviewResponse)(nil), "taskbounty.task.v1.QueryAllTaskReviewResponse")
+	proto.RegisterType((*QueryAllTaskDisputeRequest)(nil), "taskbounty.task.v1.QueryAllTaskDisputeRequest")
+	proto.RegisterType((*QueryAllTaskDisputeResponse)(nil), "taskbounty.task.v1.QueryAllTaskDisputeResponse")
+	proto.RegisterType((*QueryGetSubmissionRequest)(nil), "taskbounty.task.v1.QueryGetSubmissionRequest")
+	proto.RegisterType((*QueryGetSubmissionResponse)(nil), "taskbounty.task.v1.QueryGetSubmissionResponse")
+	proto.RegisterType((*QueryAllSubmissionRequest)(nil), "taskbounty.task.v1.QueryAllSubmissionRequest")
+	proto.RegisterType((*QueryAllSubmissionResponse)(nil), "taskbounty.task.v1.QueryAllSubmissionResponse")
+	proto.RegisterType((*QueryTasksByCreatorRequest)(nil), "taskbounty.task.v1.QueryTasksByCreatorRequest")
+	proto.RegisterType((*QueryTasksByCreatorResponse)(nil), "taskbounty.task.v1.QueryTasksByCreatorResponse")
+	proto.RegisterType((*QueryTasksByClaimantRequest)(nil), "taskbounty.task.v1.QueryTasksByClaimantRequest")
+	proto.RegisterType((*QueryTasksByClaimantResponse)(nil), "taskbounty.task.v1.QueryTasksByClaimantResponse")
+	proto.RegisterType((*QueryTasksByStatusRequest)(nil), "taskbounty.task.v1.QueryTasksByStatusRequest")
+	proto.RegisterType((*QueryTasksByStatusResponse)(nil), "taskbounty.task.v1.QueryTasksByStatusResponse")
+	proto.RegisterType((*QueryRewardsByClaimantRequest)(nil), "taskbounty.task.v1.QueryRewardsByClaimantRequest")
+	proto.RegisterType((*QueryRewardsByClaimantResponse)(nil), "taskbounty.task.v1.QueryRewardsByClaimantResponse")
+	proto.RegisterType((*QueryTaskHistoryRequest)(nil), "taskbounty.task.v1.QueryTaskHistoryRequest")
+	proto.RegisterType((*QueryTaskHistoryResponse)(nil), "taskbounty.task.v1.QueryTaskHistoryResponse")
+	proto.RegisterType((*QueryGetArchivedTaskRequest)(nil), "taskbounty.task.v1.QueryGetArchivedTaskRequest")
+	proto.RegisterType((*QueryGetArchivedTaskResponse)(nil), "taskbounty.task.v1.QueryGetArchivedTaskResponse")
+	proto.RegisterType((*QueryAllArchivedTaskRequest)(nil), "taskbounty.task.v1.QueryAllArchivedTaskRequest")
+	proto.RegisterType((*QueryAllArchivedTaskResponse)(nil), "taskbounty.task.v1.QueryAllArchivedTaskResponse")
+	proto.RegisterType((*QueryGetRemoteTaskRequest)(nil), "taskbounty.task.v1.QueryGetRemoteTaskRequest")
+	proto.RegisterType((*QueryGetRemoteTaskResponse)(nil), "taskbounty.task.v1.QueryGetRemoteTaskResponse")
+	proto.RegisterType((*QueryAllRemoteTaskRequest)(nil), "taskbounty.task.v1.QueryAllRemoteTaskRequest")
+	proto.RegisterType((*QueryAllRemoteTaskResponse)(nil), "taskbounty.task.v1.QueryAllRemoteTaskResponse")
+}
+
+func init() { proto.RegisterFile("taskbounty/task/v1/query.proto", fileDescriptor_3836386d3dfdf39f) }
+
+var fileDescriptor_3836386d3dfdf39f = []byte{
+	// 1628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0xcf, 0x24, 0xf9, 0x26, 0x64, 0xf2, 0x25, 0x29, 0x53, 0x24, 0xb6, 0x6e, 0xb2, 0x01, 0x43,
+	0x12, 0x4a, 0x88, 0x9d, 0x04, 0x8a, 0x68, 0xa5, 0x56, 0x22, 0x05, 0x02, 0x55, 0x0f, 0xe0, 0x70,
+	0xea, 0x25, 0xf2, 0xee, 0x9a, 0x60, 0x91, 0xb5, 0x17, 0xcf, 0x6c, 0x68, 0xb4, 0x4a, 0xab, 0x96,
+	0x7f, 0xa0, 0x12, 0x87, 0xfe, 0xa0, 0x95, 0x5a, 0x89, 0x4a, 0xad, 0x5a, 0x55, 0x9c, 0x38, 0xf7,
+	0x50, 0xa9, 0x1c, 0x91, 0x7a, 0xe9, 0xa9, 0xaa, 0xa0, 0x52, 0xff, 0x8d, 0xca, 0xe3, 0xe7, 0xb5,
+	0xbd, 0x1e, 0xdb, 0xc3, 0xd6, 0x91, 0x72, 0x01, 0xfb, 0xed, 0x7b, 0xf3, 0x3e, 0xef, 0xf3, 0xde,
+	0x8c, 0xe7, 0x3d, 0x05, 0x57, 0x99, 0x49, 0x6f, 0xd7, 0xdc, 0xb6, 0xc3, 0x76, 0x74, 0xff, 0x51,
+	0xdf, 0x5e, 0xd6, 0xef, 0xb4, 0x2d, 0x6f, 0x47, 0x6b, 0x79, 0x2e, 0x73, 0x09, 0x89, 0x7e, 0xd7,
+	0xfc, 0x47, 0x6d, 0x7b, 0x59, 0x39, 0x64, 0x36, 0x6d, 0xc7, 0xd5, 0xf9, 0xbf, 0x81, 0x9a, 0x72,
+	0xaa, 0xee, 0xd2, 0xa6, 0x4b, 0xf5, 0x9a, 0x49, 0xad, 0xc0, 0x5e, 0xdf, 0x5e, 0xae, 0x59, 0xcc,
+	0x5c, 0xd6, 0x5b, 0xe6, 0xa6, 0xed, 0x98, 0xcc, 0x76, 0x1d, 0xd0, 0x3d, 0xbc, 0xe9, 0x6e, 0xba,
+	0xfc, 0x51, 0xf7, 0x9f, 0x40, 0x3a, 0xb5, 0xe9, 0xba, 0x9b, 0x5b, 0x96, 0x6e, 0xb6, 0x6c, 0xdd,
+	0x74, 0x1c, 0x97, 0x71, 0x13, 0x0a, 0xbf, 0xce, 0x08, 0x60, 0xb6, 0xcc, 0xfa, 0x6d, 0x8b, 0xe5,
+	0x2a, 0x78, 0x66, 0x33, 0x5c, 0x61, 0x5a, 0xa0, 0xc0, 0x03, 0xe2, 0x3f, 0xab, 0x87, 0x31, 0xb9,
+	0xee, 0xc3, 0xbe, 0xc6, 0x6d, 0x0c, 0xeb, 0x4e, 0xdb, 0xa2, 0x4c, 0xbd, 0x81, 0x5f, 0x4e, 0x48,
+	0x69, 0xcb, 0x75, 0xa8, 0x45, 0xde, 0xc2, 0x23, 0xc1, 0xda, 0x15, 0x74, 0x14, 0x9d, 0x1c, 0x5f,
+	0x51, 0xb4, 0x34, 0x4b, 0x5a, 0x60, 0xb3, 0x3a, 0xf6, 0xe4, 0xcf, 0x99, 0x81, 0xef, 0xff, 0x79,
+	0x74, 0x0a, 0x19, 0x60, 0xa4, 0xce, 0xc2, 0xaa, 0x6b, 0x16, 0xbb, 0x61, 0xd2, 0xdb, 0xe0, 0x8c,
+	0x4c, 0xe0, 0x41, 0xbb, 0xc1, 0x57, 0x1c, 0x36, 0x06, 0xed, 0x86, 0xfa, 0x2e, 0x3e, 0x9c, 0x54,
+	0x03, 0xef, 0x2b, 0x78, 0xd8, 0xf7, 0x01, 0xbe, 0x2b, 0x22, 0xdf, 0xbe, 0xfe, 0xea, 0xb0, 0xef,
+	0xd9, 0xe0, 0xba, 0xea, 0x6f, 0x08, 0x7c, 0x5e, 0xd8, 0xda, 0x8a, 0xfb, 0xbc, 0x8c, 0x71, 0x94,
+	0x1f, 0x58, 0x71, 0x4e, 0x0b, 0x92, 0xa9, 0xf9, 0xc9, 0xd4, 0x82, 0x62, 0x80, 0x64, 0x6a, 0xd7,
+	0xcc, 0x4d, 0x0b, 0x6c, 0x8d, 0x98, 0x25, 0x39, 0x87, 0x47, 0x6e, 0xda, 0x5b, 0xcc, 0xf2, 0x2a,
+	0x83, 0x7c, 0x8d, 0x6a, 0x16, 0xaa, 0xcb, 0x5c, 0xcb, 0x00, 0x6d, 0xb2, 0x84, 0x87, 0xa9, 0xeb,
+	0xb1, 0xca, 0x10, 0xb7, 0x9a, 0xca, 0xb2, 0x5a, 0x77, 0x3d, 0x66, 0x70, 0x4d, 0xf5, 0x3e, 0x02,
+	0x5a, 0xba, 0x91, 0xa4, 0x68, 0x19, 0x92, 0xa5, 0x85, 0xac, 0x25, 0xc2, 0x0f, 0xa0, 0xcf, 0x17,
+	0x86, 0x1f, 0x38, 0x8c, 0xc7, 0xaf, 0x2e, 0xe0, 0x57, 0x92, 0xb9, 0xba, 0x6b, 0x7a, 0x8d, 0xac,
+	0xc4, 0xd6, 0xb1, 0x22, 0x52, 0x86, 0x38, 0x2e, 0xe1, 0x71, 0x1f, 0xdb, 0x86, 0xc7, 0xc5, 0x90,
+	0x93, 0x4c, 0x3e, 0x03, 0x63, 0x08, 0x0a, 0xb3, 0xae, 0x44, 0xad, 0x03, 0xa2, 0x2e, 0x4d, 0x71,
+	0x44, 0x25, 0xa5, 0x5d, 0xfd, 0x09, 0x41, 0x28, 0x3d, 0x5e, 0xb2, 0x42, 0x19, 0xea, 0x27, 0x94,
+	0xf2, 0xb2, 0xb4, 0x8a, 0x4f, 0xa4, 0x89, 0xa7, 0xab, 0x3b, 0xef, 0x6c, 0x99, 0x76, 0xd3, 0x74,
+	0x58, 0x48, 0x8f, 0x82, 0x0f, 0xd4, 0x41, 0xc4, 0xc9, 0x19, 0x33, 0xba, 0xef, 0x6a, 0x0b, 0xcf,
+	0x16, 0xac, 0x01, 0xc1, 0xaf, 0xe1, 0xff, 0xc7, 0x82, 0xa7, 0x2f, 0x14, 0xfd, 0x78, 0x14, 0x3d,
+	0x4d, 0xd7, 0xd6, 0xcd, 0xb6, 0x23, 0x5f, 0x5b, 0x81, 0x72, 0x2a, 0x21, 0xbe, 0xb8, 0xb8, 0xb6,
+	0x7c, 0xad, 0x64, 0x42, 0x7c, 0x49, 0xba, 0xb6, 0xe2, 0x88, 0xf6, 0xae, 0xb6, 0xf2, 0x43, 0x19,
+	0xea, 0x27, 0x94, 0xf2, 0x6a, 0x8b, 0xf6, 0x72, 0xb2, 0x6d, 0x5b, 0x77, 0x33, 0xb2, 0xd4, 0xc3,
+	0xd1, 0x60, 0x89, 0x1c, 0x05, 0x5e, 0x53, 0x1c, 0xf9, 0xe2, 0x62, 0x8e, 0x7c, 0xad, 0x24, 0x47,
+	0xbe, 0xa4, 0x3c, 0x8e, 0x58, 0x12, 0xed, 0x45, 0x9b, 0xb6, 0xda, 0xcc, 0xda, 0x6b, 0x92, 0x1e,
+	0x21, 0xfc, 0xaa, 0xd0, 0x2d, 0xb0, 0x74, 0x05, 0x36, 0x6a, 0x23, 0x90, 0x03, 0x4d, 0x33, 0x59,
+	0x34, 0x81, 0x79, 0x7c, 0xa7, 0x82, 0xa8, 0x3c, 0xa2, 0x2e, 0x45, 0x5b, 0x7e, 0xbd, 0x5d, 0x6b,
+	0xda, 0x94, 0xda, 0xae, 0x93, 0xc5, 0x53, 0x05, 0x8f, 0x9a, 0x8c, 0x59, 0xcd, 0x16, 0xe3, 0x2e,
+	0x87, 0x8d, 0xf0, 0x55, 0xad, 0x45, 0x87, 0x41, 0x7c, 0x19, 0x88, 0xfb, 0x22, 0xc6, 0xb4, 0x2b,
+	0xcd, 0x3b, 0x0b, 0x22, 0xdb, 0xb0, 0x38, 0x22, 0xbb, 0x78, 0xdd, 0x17, 0x43, 0x2d, 0x2b, 0xa5,
+	0x3f, 0xc6, 0xea, 0x5e, 0x22, 0xb2, 0xa1, 0x7e, 0x22, 0x2b, 0x2f, 0x9b, 0x1f, 0x02, 0x58, 0xbf,
+	0x7a, 0xfc, 0x2f, 0x85, 0x67, 0x99, 0xcc, 0xf5, 0x42, 0x8e, 0x2a, 0x78, 0xb4, 0x1e, 0x48, 0xe0,
+	0x5b, 0x13, 0xbe, 0x96, 0xc6, 0xd6, 0x97, 0xe1, 0x06, 0xe8, 0x05, 0xb0, 0x1f, 0x6e, 0x4e, 0x1f,
+	0xf7, 0x82, 0x93, 0xff, 0x16, 0x97, 0x46, 0xd0, 0x03, 0x84, 0xa7, 0xc4, 0x18, 0xf6, 0x03, 0x43,
+	0x0f, 0x10, 0x6c, 0x31, 0x40, 0xb7, 0xce, 0x4c, 0xd6, 0x0e, 0x5b, 0x14, 0xff, 0xe6, 0x4d, 0xb9,
+	0x80, 0xb3, 0x33, 0x91, 0x7d, 0xbc, 0x83, 0x19, 0x68, 0x97, 0xc6, 0xdd, 0x17, 0x28, 0x59, 0xdd,
+	0x21, 0xba, 0xfd, 0xc0, 0xdc, 0x3d, 0x84, 0xa7, 0x39, 0xb6, 0x7e, 0x6e, 0x7a, 0x65, 0x7e, 0x7f,
+	0xaa, 0x59, 0x28, 0xf6, 0xe9, 0x45, 0xf9, 0x0e, 0x3e, 0xd2, 0xcd, 0xe9, 0x15, 0x9b, 0x32, 0xd7,
+	0x07, 0xbf, 0xb7, 0x47, 0xfa, 0x63, 0x84, 0x2b, 0x69, 0x9f, 0xc0, 0xcf, 0x75, 0x3c, 0xc9, 0xf9,
+	0x61, 0x9e, 0xe9, 0x50, 0x9b, 0x45, 0xa7, 0xba, 0x9a, 0xc5, 0xd1, 0x8d, 0xae, 0x26, 0xf0, 0x34,
+	0xc1, 0x12, 0xd2, 0xf2, 0xb8, 0x5a, 0x84, 0xf3, 0x6b, 0xcd, 0x62, 0x17, 0xbc, 0xfa, 0x2d, 0x7b,
+	0xdb, 0x6a, 0xe4, 0x75, 0xf5, 0x06, 0x1c, 0x35, 0x29, 0xf5, 0xff, 0xd0, 0xdd, 0x5b, 0xd1, 0x05,
+	0x47, 0x04, 0xa1, 0xac, 0x1b, 0x79, 0xf7, 0x98, 0x4c, 0xf9, 0xd9, 0x0f, 0x9b, 0x7d, 0x3d, 0xba,
+	0x33, 0x19, 0x56, 0xd3, 0x65, 0x56, 0x9c, 0x82, 0x69, 0x8c, 0xeb, 0xb7, 0x4c, 0xc7, 0xb1, 0xb6,
+	0x36, 0x20, 0x1b, 0x63, 0xc6, 0x18, 0x48, 0xae, 0x36, 0xc8, 0x11, 0x3c, 0xca, 0xeb, 0xcb, 0x6e,
+	0xc0, 0x15, 0x6a, 0xc4, 0x7f, 0xbd, 0x9a, 0x68, 0xa7, 0xe2, 0x8b, 0x46, 0xdb, 0xd6, 0xe3, 0xd2,
+	0x8d, 0x58, 0xca, 0x84, 0xdb, 0x36, 0x32, 0x0e, 0xb7, 0xad, 0xd7, 0x95, 0xc4, 0xdb, 0xa9, 0x34,
+	0xf2, 0xbd, 0x68, 0xa7, 0x64, 0x42, 0x19, 0xea, 0x27, 0x94, 0xd2, 0xb2, 0xb9, 0xf2, 0x8b, 0x82,
+	0xff, 0xc7, 0xe1, 0x92, 0x5d, 0x3c, 0x12, 0x8c, 0xd2, 0xc8, 0x9c, 0x08, 0x4e, 0x7a, 0x6a, 0xa7,
+	0xcc, 0x17, 0xea, 0x05, 0x0e, 0x55, 0xf5, 0x93, 0xdf, 0xff, 0xbe, 0x3f, 0x38, 0x45, 0x14, 0x3d,
+	0x73, 0x7a, 0x48, 0xee, 0x21, 0x3c, 0x0a, 0xcd, 0x34, 0xc9, 0x5e, 0x38, 0x39, 0xca, 0x53, 0x4e,
+	0x16, 0x2b, 0x02, 0x84, 0x59, 0x0e, 0x61, 0x86, 0x4c, 0xeb, 0x19, 0xf3, 0x49, 0xbd, 0x63, 0x37,
+	0x76, 0xc9, 0x47, 0xf8, 0xc0, 0x7b, 0x36, 0x2d, 0x42, 0x91, 0x1c, 0xee, 0xe5, 0xa0, 0xe8, 0x99,
+	0x9d, 0xa9, 0x47, 0x39, 0x0a, 0x85, 0x54, 0xb2, 0x50, 0x90, 0xaf, 0x10, 0x3e, 0x98, 0x18, 0x79,
+	0x90, 0xc5, 0xe2, 0x18, 0x63, 0x23, 0x27, 0x45, 0x93, 0x55, 0x07, 0x48, 0xa7, 0x39, 0xa4, 0x39,
+	0x72, 0x22, 0x0b, 0x12, 0x7c, 0x2c, 0x03, 0x7e, 0x3e, 0x47, 0x78, 0x22, 0x24, 0xa8, 0x10, 0x9f,
+	0x68, 0x24, 0x96, 0x83, 0x4f, 0x38, 0xdb, 0x52, 0xe7, 0x39, 0xbe, 0x63, 0x64, 0xa6, 0x00, 0x1f,
+	0xf9, 0x15, 0xe1, 0x4a, 0xd6, 0xb0, 0x88, 0x9c, 0x97, 0x63, 0x25, 0x7d, 0x73, 0x51, 0xde, 0xe8,
+	0xc3, 0x12, 0xa0, 0x9f, 0xe1, 0xd0, 0x17, 0xc9, 0x42, 0x01, 0x74, 0xaa, 0x77, 0xc2, 0xcb, 0xd0,
+	0x6e, 0xb2, 0x00, 0xf8, 0xe8, 0x44, 0xa2, 0x00, 0x62, 0x73, 0x21, 0x99, 0x02, 0x88, 0x0f, 0x78,
+	0xa4, 0x0a, 0xc0, 0x37, 0x10, 0x15, 0x40, 0x01, 0x3e, 0xd1, 0xdc, 0x4a, 0xa6, 0x00, 0x12, 0xf8,
+	0x64, 0x0a, 0x80, 0xe3, 0xf8, 0x3a, 0x01, 0x8d, 0x4f, 0x54, 0x24, 0xa0, 0xc5, 0xc6, 0x47, 0x32,
+	0xd0, 0xe2, 0x73, 0x1f, 0x29, 0xea, 0x7c, 0x83, 0x80, 0xba, 0x6f, 0x11, 0x9e, 0x0c, 0xf1, 0x85,
+	0x93, 0x8c, 0x42, 0x8f, 0xc9, 0xd9, 0x8d, 0xa2, 0x4b, 0xeb, 0x03, 0xc4, 0x45, 0x0e, 0x71, 0x9e,
+	0xcc, 0x66, 0x42, 0x84, 0x71, 0x4c, 0x80, 0xf1, 0xbb, 0xa0, 0xfa, 0xa2, 0x7e, 0x3d, 0xbf, 0xfa,
+	0x52, 0x93, 0x88, 0xfc, 0xea, 0x4b, 0x8f, 0x10, 0xd4, 0xd7, 0x39, 0x3e, 0x9d, 0x2c, 0x8a, 0xf0,
+	0x45, 0x43, 0x02, 0x8e, 0x4e, 0xef, 0xc0, 0xc0, 0x85, 0xef, 0x12, 0x9e, 0x6b, 0x29, 0xa0, 0xa2,
+	0x91, 0x49, 0x7e, 0xae, 0x05, 0x40, 0x17, 0x38, 0xd0, 0x59, 0x72, 0x5c, 0x02, 0x28, 0xf9, 0x01,
+	0xe1, 0x89, 0xe4, 0x10, 0x20, 0x27, 0xd3, 0xc2, 0x71, 0x45, 0x4e, 0xa6, 0xc5, 0xd3, 0x05, 0xf5,
+	0x1c, 0x07, 0xb8, 0x44, 0xb4, 0xac, 0x4c, 0xd3, 0x8d, 0xda, 0xce, 0x06, 0xcc, 0x3c, 0xf4, 0x0e,
+	0x3c, 0xec, 0x92, 0x9f, 0x11, 0x9e, 0xec, 0xe9, 0xc7, 0x49, 0xb1, 0xf3, 0x9e, 0x53, 0x72, 0x49,
+	0xde, 0x00, 0xe0, 0x9e, 0xe7, 0x70, 0x57, 0xc8, 0x52, 0x3e, 0x5c, 0x30, 0x8b, 0x9f, 0x90, 0x0f,
+	0x11, 0x3e, 0x98, 0x68, 0x82, 0x73, 0x52, 0x2f, 0x6a, 0xe5, 0x15, 0x4d, 0x56, 0x1d, 0xa0, 0x9e,
+	0xe5, 0x50, 0x35, 0x72, 0x3a, 0x17, 0x6a, 0xd0, 0xef, 0xeb, 0x9d, 0xe0, 0xff, 0x5d, 0xf2, 0x18,
+	0xe1, 0x43, 0xe9, 0x0f, 0xd1, 0x72, 0xa6, 0xef, 0xcc, 0x2f, 0xd0, 0xca, 0x8b, 0x98, 0x00, 0xe4,
+	0x37, 0x39, 0xe4, 0xb3, 0x64, 0x45, 0x04, 0x19, 0xbe, 0x3a, 0x59, 0xfc, 0x7e, 0x86, 0xf0, 0x78,
+	0xac, 0x39, 0x24, 0x0b, 0xb9, 0x74, 0x25, 0xdb, 0x56, 0xe5, 0xb4, 0x9c, 0xb2, 0xf4, 0xe9, 0x74,
+	0x2b, 0xb0, 0x08, 0xb6, 0xd5, 0x43, 0x84, 0x27, 0x7b, 0xfa, 0xb9, 0x9c, 0x52, 0x15, 0x37, 0x8a,
+	0x39, 0xa5, 0x9a, 0xd1, 0x2a, 0xaa, 0x1a, 0x47, 0x79, 0x92, 0xcc, 0x89, 0x50, 0x9a, 0x60, 0xb1,
+	0x11, 0x5d, 0x22, 0xbf, 0x41, 0xf8, 0x25, 0xff, 0x70, 0x92, 0xc4, 0x29, 0xee, 0x26, 0x73, 0x70,
+	0x66, 0xb4, 0x85, 0xea, 0x6b, 0x1c, 0xe7, 0x71, 0x72, 0xac, 0x10, 0xa7, 0xbf, 0xe9, 0x0f, 0x26,
+	0x7a, 0xad, 0xfc, 0x73, 0x3e, 0xd5, 0x2e, 0xe5, 0x9f, 0xf3, 0xe9, 0xbe, 0x47, 0x7d, 0x9b, 0x63,
+	0x3b, 0x4f, 0xce, 0x89, 0x0b, 0xb2, 0xdb, 0x11, 0xe9, 0x9d, 0xa8, 0x7f, 0xdc, 0xd5, 0x3b, 0xd0,
+	0x2d, 0x46, 0xf7, 0x0e, 0x29, 0xc4, 0xa2, 0x06, 0x2f, 0xff, 0xc0, 0x17, 0x20, 0xce, 0xbd, 0x77,
+	0xc4, 0x10, 0xaf, 0x2e, 0x3f, 0x79, 0x56, 0x45, 0x4f, 0x9f, 0x55, 0xd1, 0x5f, 0xcf, 0xaa, 0xe8,
+	0xd3, 0xe7, 0xd5, 0x81, 0xa7, 0xcf, 0xab, 0x03, 0x7f, 0x3c, 0xaf, 0x0e, 0xbc, 0x7f, 0x24, 0x66,
+	0xf9, 0x41, 0x60, 0xcb, 0x76, 0x5a, 0x16, 0xad, 0x8d, 0xf0, 0x3f, 0x86, 0x38, 0xf3, 0x6f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xb9, 0x05, 0xec, 0x48, 0x16, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListTask Queries a list of Task items.
+	GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error)
+	// ListTask defines the ListTask RPC.
+	ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error)
+	// Queries list of TaskReward items
+	GetTaskReward(ctx context.Context, in *QueryGetTaskRewardRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardResponse, error)
+	// Queries TaskReward
+	ListTaskReward(ctx context.Context, in *QueryAllTaskRewardRequest, opts ...grpc.CallOption) (*QueryAllTaskRewardResponse, error)
+	// Queries TaskReward items by claimant
+	GetTaskRewardsByClaimant(ctx context.Context, in *QueryGetTaskRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardsByClaimantResponse, error)
+	// Queries a TaskRefund by task id
+	GetTaskRefund(ctx context.Context, in *QueryGetTaskRefundRequest, opts ...grpc.CallOption) (*QueryGetTaskRefundResponse, error)
+	// Queries a list of TaskRefund items
+	ListTaskRefund(ctx context.Context, in *QueryAllTaskRefundRequest, opts ...grpc.CallOption) (*QueryAllTaskRefundResponse, error)
+	// Queries the reviews of the current submission of a task
+	ListTaskReview(ctx context.Context, in *QueryAllTaskReviewRequest, opts ...grpc.CallOption) (*QueryAllTaskReviewResponse, error)
+	// Queries the dispute and resolution history of a task
+	ListTaskDispute(ctx context.Context, in *QueryAllTaskDisputeRequest, opts ...grpc.CallOption) (*QueryAllTaskDisputeResponse, error)
+	// Queries a submission of a task by attempt number
+	GetSubmission(ctx context.Context, in *QueryGetSubmissionRequest, opts ...grpc.CallOption) (*QueryGetSubmissionResponse, error)
+	// Queries all submissions of a task
+	ListSubmission(ctx context.Context, in *QueryAllSubmissionRequest, opts ...grpc.CallOption) (*QueryAllSubmissionResponse, error)
+	// Queries the tasks created by an address
+	TasksByCreator(ctx context.Context, in *QueryTasksByCreatorRequest, opts ...grpc.CallOption) (*QueryTasksByCreatorResponse, error)
+	// Queries the tasks claimed by an address
+	TasksByClaimant(ctx context.Context, in *QueryTasksByClaimantRequest, opts ...grpc.CallOption) (*QueryTasksByClaimantResponse, error)
+	// Queries the tasks in a status
+	TasksByStatus(ctx context.Context, in *QueryTasksByStatusRequest, opts ...grpc.CallOption) (*QueryTasksByStatusResponse, error)
+	// Queries the rewards paid out to a claimant
+	RewardsByClaimant(ctx context.Context, in *QueryRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryRewardsByClaimantResponse, error)
+	// Queries the status history of a task, oldest transition first
+	TaskHistory(ctx context.Context, in *QueryTaskHistoryRequest, opts ...grpc.CallOption) (*QueryTaskHistoryResponse, error)
+	// Queries a closed task that was moved to the archive
+	GetArchivedTask(ctx context.Context, in *QueryGetArchivedTaskRequest, opts ...grpc.CallOption) (*QueryGetArchivedTaskResponse, error)
+	// Queries the list of archived tasks
+	ListArchivedTask(ctx context.Context, in *QueryAllArchivedTaskRequest, opts ...grpc.CallOption) (*QueryAllArchivedTaskResponse, error)
+	// Queries a task of the chain at the other end of a task channel
+	GetRemoteTask(ctx context.Context, in *QueryGetRemoteTaskRequest, opts ...grpc.CallOption) (*QueryGetRemoteTaskResponse, error)
+	// Queries the list of remote tasks
+	ListRemoteTask(ctx context.Context, in *QueryAllRemoteTaskRequest, opts ...grpc.CallOption) (*QueryAllRemoteTaskResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error) {
+	out := new(QueryGetTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error) {
+	out := new(QueryAllTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTaskReward(ctx context.Context, in *QueryGetTaskRewardRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardResponse, error) {
+	out := new(QueryGetTaskRewardResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTaskReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTaskReward(ctx context.Context, in *QueryAllTaskRewardRequest, opts ...grpc.CallOption) (*QueryAllTaskRewardResponse, error) {
+	out := new(QueryAllTaskRewardResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTaskReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTaskRewardsByClaimant(ctx context.Context, in *QueryGetTaskRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryGetTaskRewardsByClaimantResponse, error) {
+	out := new(QueryGetTaskRewardsByClaimantResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTaskRewardsByClaimant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTaskRefund(ctx context.Context, in *QueryGetTaskRefundRequest, opts ...grpc.CallOption) (*QueryGetTaskRefundResponse, error) {
+	out := new(QueryGetTaskRefundResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetTaskRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTaskRefund(ctx context.Context, in *QueryAllTaskRefundRequest, opts ...grpc.CallOption) (*QueryAllTaskRefundResponse, error) {
+	out := new(QueryAllTaskRefundResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTaskRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTaskReview(ctx context.Context, in *QueryAllTaskReviewRequest, opts ...grpc.CallOption) (*QueryAllTaskReviewResponse, error) {
+	out := new(QueryAllTaskReviewResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTaskReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTaskDispute(ctx context.Context, in *QueryAllTaskDisputeRequest, opts ...grpc.CallOption) (*QueryAllTaskDisputeResponse, error) {
+	out := new(QueryAllTaskDisputeResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListTaskDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSubmission(ctx context.Context, in *QueryGetSubmissionRequest, opts ...grpc.CallOption) (*QueryGetSubmissionResponse, error) {
+	out := new(QueryGetSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSubmission(ctx context.Context, in *QueryAllSubmissionRequest, opts ...grpc.CallOption) (*QueryAllSubmissionResponse, error) {
+	out := new(QueryAllSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByCreator(ctx context.Context, in *QueryTasksByCreatorRequest, opts ...grpc.CallOption) (*QueryTasksByCreatorResponse, error) {
+	out := new(QueryTasksByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/TasksByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByClaimant(ctx context.Context, in *QueryTasksByClaimantRequest, opts ...grpc.CallOption) (*QueryTasksByClaimantResponse, error) {
+	out := new(QueryTasksByClaimantResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/TasksByClaimant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByStatus(ctx context.Context, in *QueryTasksByStatusRequest, opts ...grpc.CallOption) (*QueryTasksByStatusResponse, error) {
+	out := new(QueryTasksByStatusResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/TasksByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsByClaimant(ctx context.Context, in *QueryRewardsByClaimantRequest, opts ...grpc.CallOption) (*QueryRewardsByClaimantResponse, error) {
+	out := new(QueryRewardsByClaimantResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/RewardsByClaimant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaskHistory(ctx context.Context, in *QueryTaskHistoryRequest, opts ...grpc.CallOption) (*QueryTaskHistoryResponse, error) {
+	out := new(QueryTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/TaskHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetArchivedTask(ctx context.Context, in *QueryGetArchivedTaskRequest, opts ...grpc.CallOption) (*QueryGetArchivedTaskResponse, error) {
+	out := new(QueryGetArchivedTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetArchivedTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListArchivedTask(ctx context.Context, in *QueryAllArchivedTaskRequest, opts ...grpc.CallOption) (*QueryAllArchivedTaskResponse, error) {
+	out := new(QueryAllArchivedTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListArchivedTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRemoteTask(ctx context.Context, in *QueryGetRemoteTaskRequest, opts ...grpc.CallOption) (*QueryGetRemoteTaskResponse, error) {
+	out := new(QueryGetRemoteTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/GetRemoteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRemoteTask(ctx context.Context, in *QueryAllRemoteTaskRequest, opts ...grpc.CallOption) (*QueryAllRemoteTaskResponse, error) {
+	out := new(QueryAllRemoteTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Query/ListRemoteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListTask Queries a list of Task items.
+	GetTask(context.Context, *QueryGetTaskRequest) (*QueryGetTaskResponse, error)
+	// ListTask defines the ListTask RPC.
+	ListTask(context.Context, *QueryAllTaskRequest) (*QueryAllTaskResponse, error)
+	// Queries list of TaskReward items
+	GetTaskReward(context.Context, *QueryGetTaskRewardRequest) (*QueryGetTaskRewardResponse, error)
+	// Queries TaskReward
+	ListTaskReward(context.Context, *QueryAllTaskRewardRequest) (*QueryAllTaskRewardResponse, error)
+	// Queries TaskReward items by claimant
+	GetTaskRewardsByClaimant(context.Context, *QueryGetTaskRewardsByClaimantRequest) (*QueryGetTaskRewardsByClaimantResponse, error)
+	// Queries a TaskRefund by task id
+	GetTaskRefund(context.Context, *QueryGetTaskRefundRequest) (*QueryGetTaskRefundResponse, error)
+	// Queries a list of TaskRefund items
+	ListTaskRefund(context.Context, *QueryAllTaskRefundRequest) (*QueryAllTaskRefundResponse, error)
+	// Queries the reviews of the current submission of a task
+	ListTaskReview(context.Context, *QueryAllTaskReviewRequest) (*QueryAllTaskReviewResponse, error)
+	// Queries the dispute and resolution history of a task
+	ListTaskDispute(context.Context, *QueryAllTaskDisputeRequest) (*QueryAllTaskDisputeResponse, error)
+	// Queries a submission of a task by attempt number
+	GetSubmission(context.Context, *QueryGetSubmissionRequest) (*QueryGetSubmissionResponse, error)
+	// Queries all submissions of a task
+	ListSubmission(context.Context, *QueryAllSubmissionRequest) (*QueryAllSubmissionResponse, error)
+	// Queries the tasks created by an address
+	TasksByCreator(context.Context, *QueryTasksByCreatorRequest) (*QueryTasksByCreatorResponse, error)
+	// Queries the tasks claimed by an address
+	TasksByClaimant(context.Context, *QueryTasksByClaimantRequest) (*QueryTasksByClaimantResponse, error)
+	// Queries the tasks in a status
+	TasksByStatus(context.Context, *QueryTasksByStatusRequest) (*QueryTasksByStatusResponse, error)
+	// Queries the rewards paid out to a claimant
+	RewardsByClaimant(context.Context, *QueryRewardsByClaimantRequest) (*QueryRewardsByClaimantResponse, error)
+	// Queries the status history of a task, oldest transition first
+	TaskHistory(context.Context, *QueryTaskHistoryRequest) (*QueryTaskHistoryResponse, error)
+	// Queries a closed task that was moved to the archive
+	GetArchivedTask(context.Context, *QueryGetArchivedTaskRequest) (*QueryGetArchivedTaskResponse, error)
+	// Queries the list of archived tasks
+	ListArchivedTask(context.Context, *QueryAllArchivedTaskRequest) (*QueryAllArchivedTaskResponse, error)
+	// Queries a task of the chain at the other end of a task channel
+	GetRemoteTask(context.Context, *QueryGetRemoteTaskRequest) (*QueryGetRemoteTaskResponse, error)
+	// Queries the list of remote tasks
+	ListRemoteTask(context.Context, *QueryAllRemoteTaskRequest) (*QueryAllRemoteTaskResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetTask(ctx context.Context, req *QueryGetTaskRequest) (*QueryGetTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (*UnimplementedQueryServer) ListTask(ctx context.Context, req *QueryAllTaskRequest) (*QueryAllTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+}
+func (*UnimplementedQueryServer) GetTaskReward(ctx context.Context, req *QueryGetTaskRewardRequest) (*QueryGetTaskRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskReward not implemented")
+}
+func (*UnimplementedQueryServer) ListTaskReward(ctx context.Context, req *QueryAllTaskRewardRequest) (*QueryAllTaskRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskReward not implemented")
+}
+func (*UnimplementedQueryServer) GetTaskRewardsByClaimant(ctx context.Context, req *QueryGetTaskRewardsByClaimantRequest) (*QueryGetTaskRewardsByClaimantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRewardsByClaimant not implemented")
+}
+func (*UnimplementedQueryServer) GetTaskRefund(ctx context.Context, req *QueryGetTaskRefundRequest) (*QueryGetTaskRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRefund not implemented")
+}
+func (*UnimplementedQueryServer) ListTaskRefund(ctx context.Context, req *QueryAllTaskRefundRequest) (*QueryAllTaskRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRefund not implemented")
+}
+func (*UnimplementedQueryServer) ListTaskReview(ctx context.Context, req *QueryAllTaskReviewRequest) (*QueryAllTaskReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskReview not implemented")
+}
+func (*UnimplementedQueryServer) ListTaskDispute(ctx context.Context, req *QueryAllTaskDisputeRequest) (*QueryAllTaskDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskDispute not implemented")
+}
+func (*UnimplementedQueryServer) GetSubmission(ctx context.Context, req *QueryGetSubmissionRequest) (*QueryGetSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmission not implemented")
+}
+func (*UnimplementedQueryServer) ListSubmission(ctx context.Context, req *QueryAllSubmissionRequest) (*QueryAllSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmission not implemented")
+}
+func (*UnimplementedQueryServer) TasksByCreator(ctx context.Context, req *QueryTasksByCreatorRequest) (*QueryTasksByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByCreator not implemented")
+}
+func (*UnimplementedQueryServer) TasksByClaimant(ctx context.Context, req *QueryTasksByClaimantRequest) (*QueryTasksByClaimantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByClaimant not implemented")
+}
+func (*UnimplementedQueryServer) TasksByStatus(ctx context.Context, req *QueryTasksByStatusRequest) (*QueryTasksByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByStatus not implemented")
+}
+func (*UnimplementedQueryServer) RewardsByClaimant(ctx context.Context, req *QueryRewardsByClaimantRequest) (*QueryRewardsByClaimantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsByClaimant not implemented")
+}
+func (*UnimplementedQueryServer) TaskHistory(ctx context.Context, req *QueryTaskHistoryRequest) (*QueryTaskHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetArchivedTask(ctx context.Context, req *QueryGetArchivedTaskRequest) (*QueryGetArchivedTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedTask not implemented")
+}
+func (*UnimplementedQueryServer) ListArchivedTask(ctx context.Context, req *QueryAllArchivedTaskRequest) (*QueryAllArchivedTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedTask not implemented")
+}
+func (*UnimplementedQueryServer) GetRemoteTask(ctx context.Context, req *QueryGetRemoteTaskRequest) (*QueryGetRemoteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteTask not implemented")
+}
+func (*UnimplementedQueryServer) ListRemoteTask(ctx context.Context, req *QueryAllRemoteTaskRequest) (*QueryAllRemoteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoteTask not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTask(ctx, req.(*QueryGetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTask(ctx, req.(*QueryAllTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTaskReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTaskReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetTaskReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTaskReward(ctx, req.(*QueryGetTaskRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTaskReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTaskReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListTaskReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTaskReward(ctx, req.(*QueryAllTaskRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTaskRewardsByClaimant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskRewardsByClaimantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTaskRewardsByClaimant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetTaskRewardsByClaimant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTaskRewardsByClaimant(ctx, req.(*QueryGetTaskRewardsByClaimantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTaskRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTaskRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetTaskRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTaskRefund(ctx, req.(*QueryGetTaskRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTaskRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTaskRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListTaskRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTaskRefund(ctx, req.(*QueryAllTaskRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTaskReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTaskReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListTaskReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTaskReview(ctx, req.(*QueryAllTaskReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTaskDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTaskDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListTaskDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTaskDispute(ctx, req.(*QueryAllTaskDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSubmission(ctx, req.(*QueryGetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSubmission(ctx, req.(*QueryAllSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/TasksByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByCreator(ctx, req.(*QueryTasksByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByClaimant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByClaimantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByClaimant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/TasksByClaimant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByClaimant(ctx, req.(*QueryTasksByClaimantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/TasksByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByStatus(ctx, req.(*QueryTasksByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsByClaimant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsByClaimantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsByClaimant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/RewardsByClaimant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsByClaimant(ctx, req.(*QueryRewardsByClaimantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/TaskHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskHistory(ctx, req.(*QueryTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetArchivedTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetArchivedTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetArchivedTask(ctx, req.(*QueryGetArchivedTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllArchivedTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListArchivedTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListArchivedTask(ctx, req.(*QueryAllArchivedTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRemoteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemoteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemoteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/GetRemoteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemoteTask(ctx, req.(*QueryGetRemoteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRemoteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRemoteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRemoteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Query/ListRemoteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRemoteTask(ctx, req.(*QueryAllRemoteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "taskbounty.task.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _Query_GetTask_Handler,
+		},
+		{
+			MethodName: "ListTask",
+			Handler:    _Query_ListTask_Handler,
+		},
+		{
+			MethodName: "GetTaskReward",
+			Handler:    _Query_GetTaskReward_Handler,
+		},
+		{
+			MethodName: "ListTaskReward",
+			Handler:    _Query_ListTaskReward_Handler,
+		},
+		{
+			MethodName: "GetTaskRewardsByClaimant",
+			Handler:    _Query_GetTaskRewardsByClaimant_Handler,
+		},
+		{
+			MethodName: "GetTaskRefund",
+			Handler:    _Query_GetTaskRefund_Handler,
+		},
+		{
+			MethodName: "ListTaskRefund",
+			Handler:    _Query_ListTaskRefund_Handler,
+		},
+		{
+			MethodName: "ListTaskReview",
+			Handler:    _Query_ListTaskReview_Handler,
+		},
+		{
+			MethodName: "ListTaskDispute",
+			Handler:    _Query_ListTaskDispute_Handler,
+		},
+		{
+			MethodName: "GetSubmission",
+			Handler:    _Query_GetSubmission_Handler,
+		},
+		{
+			MethodName: "ListSubmission",
+			Handler:    _Query_ListSubmission_Handler,
+		},
+		{
+			MethodName: "TasksByCreator",
+			Handler:    _Query_TasksByCreator_Handler,
+		},
+		{
+			MethodName: "TasksByClaimant",
+			Handler:    _Query_TasksByClaimant_Handler,
+		},
+		{
+			MethodName: "TasksByStatus",
+			Handler:    _Query_TasksByStatus_Handler,
+		},
+		{
+			MethodName: "RewardsByClaimant",
+			Handler:    _Query_RewardsByClaimant_Handler,
+		},
+		{
+			MethodName: "TaskHistory",
+			Handler:    _Query_TaskHistory_Handler,
+		},
+		{
+			MethodName: "GetArchivedTask",
+			Handler:    _Query_GetArchivedTask_Handler,
+		},
+		{
+			MethodName: "ListArchivedTask",
+			Handler:    _Query_ListArchivedTask_Handler,
+		},
+		{
+			MethodName: "GetRemoteTask",
+			Handler:    _Query_GetRemoteTask_Handler,
+		},
+		{
+			MethodName: "ListRemoteTask",
+			Handler:    _Query_ListRemoteTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "taskbounty/task/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sort != nil {
+		{
+			size, err := m.Sort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TaskReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskReward) > 0 {
+		for iNdEx := len(m.TaskReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRewardsByClaimantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRewardsByClaimantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRewardsByClaimantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRewardsByClaimantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRewardsByClaimantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRewardsByClaimantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskRewards) > 0 {
+		for iNdEx := len(m.TaskRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TaskRefund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskRefund) > 0 {
+		for iNdEx := len(m.TaskRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskReviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskReviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskReview) > 0 {
+		for iNdEx := len(m.TaskReview) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskReview[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskDispute) > 0 {
+		for iNdEx := len(m.TaskDispute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskDispute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Submission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submission) > 0 {
+		for iNdEx := len(m.Submission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByClaimantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByClaimantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByClaimantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByClaimantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByClaimantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByClaimantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsByClaimantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByClaimantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByClaimantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsByClaimantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByClaimantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByClaimantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskReward) > 0 {
+		for iNdEx := len(m.TaskReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskTransition) > 0 {
+		for iNdEx := len(m.TaskTransition) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskTransition[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetArchivedTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetArchivedTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetArchivedTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetArchivedTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetArchivedTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetArchivedTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllArchivedTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllArchivedTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllArchivedTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllArchivedTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllArchivedTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllArchivedTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemoteTask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRemoteTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRemoteTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRemoteTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRemoteTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRemoteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRemoteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoteTask) > 0 {
+		for iNdEx := len(m.RemoteTask) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteTask[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sort != nil {
+		l = m.Sort.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaskReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTaskRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskReward) > 0 {
+		for _, e := range m.TaskReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRewardsByClaimantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRewardsByClaimantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskRewards) > 0 {
+		for _, e := range m.TaskRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetTaskRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaskRefund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTaskRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskRefund) > 0 {
+		for _, e := range m.TaskRefund {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskReviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskReview) > 0 {
+		for _, e := range m.TaskReview {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskDispute) > 0 {
+		for _, e := range m.TaskDispute {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovQuery(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *QueryGetSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Submission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submission) > 0 {
+		for _, e := range m.Submission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByClaimantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByClaimantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByClaimantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByClaimantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskReward) > 0 {
+		for _, e := range m.TaskReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaskHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaskHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskTransition) > 0 {
+		for _, e := range m.TaskTransition {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetArchivedTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetArchivedTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllArchivedTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllArchivedTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemoteTask.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRemoteTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRemoteTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteTask) > 0 {
+		for _, e := range m.RemoteTask {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &TaskFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sort == nil {
+				m.Sort = &TaskSort{}
+			}
+			if err := m.Sort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task, Task{})
+			if err := m.Task[len(m.Task)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskReward = append(m.TaskReward, TaskReward{})
+			if err := m.TaskReward[len(m.TaskReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRewardsByClaimantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRewardsByClaimantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRewards = append(m.TaskRewards, TaskReward{})
+			if err := m.TaskRewards[len(m.TaskRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaskRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRefund = append(m.TaskRefund, TaskRefund{})
+			if err := m.TaskRefund[len(m.TaskRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskReviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskReviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskReviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskReviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskReview = append(m.TaskReview, TaskReview{})
+			if err := m.TaskReview[len(m.TaskReview)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskDispute = append(m.TaskDispute, TaskDispute{})
+			if err := m.TaskDispute[len(m.TaskDispute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Submission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submission = append(m.Submission, Submission{})
+			if err := m.Submission[len(m.Submission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task, Task{})
+			if err := m.Task[len(m.Task)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByClaimantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByClaimantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByClaimantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByClaimantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByClaimantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByClaimantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task, Task{})
+			if err := m.Task[len(m.Task)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task, Task{})
+			if err := m.Task[len(m.Task)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByClaimantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByClaimantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByClaimantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetTaskRewardsByClaimantRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetTaskRewardsByClaimantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaskRewards) > 0 {
-		for _, e := range m.TaskRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRewardsByClaimantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByClaimantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByClaimantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskReward = append(m.TaskReward, TaskReward{})
+			if err := m.TaskReward[len(m.TaskReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1529,12 +8689,67 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1556,7 +8771,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,15 +8794,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskTransition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1614,7 +8829,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskTransition = append(m.TaskTransition, TaskTransition{})
+			if err := m.TaskTransition[len(m.TaskTransition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1639,7 +8891,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetArchivedTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1662,10 +8914,10 @@ func (m *QueryGetTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetArchivedTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetArchivedTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1708,7 +8960,7 @@ func (m *QueryGetTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetArchivedTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,10 +8983,10 @@ func (m *QueryGetTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetArchivedTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetArchivedTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1791,7 +9043,7 @@ func (m *QueryGetTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllArchivedTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1814,10 +9066,10 @@ func (m *QueryAllTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllArchivedTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllArchivedTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1877,7 +9129,7 @@ func (m *QueryAllTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllArchivedTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,10 +9152,10 @@ func (m *QueryAllTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllArchivedTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllArchivedTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1997,7 +9249,7 @@ func (m *QueryAllTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTaskRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRemoteTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,17 +9272,17 @@ func (m *QueryGetTaskRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRemoteTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRemoteTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2040,66 +9292,29 @@ func (m *QueryGetTaskRewardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetTaskRewardResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskRewardResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskReward", wireType)
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2109,25 +9324,11 @@ func (m *QueryGetTaskRewardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2149,7 +9350,7 @@ func (m *QueryGetTaskRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTaskRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRemoteTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,15 +9373,15 @@ func (m *QueryAllTaskRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTaskRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRemoteTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTaskRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRemoteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2207,10 +9408,7 @@ func (m *QueryAllTaskRewardRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoteTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2235,7 +9433,7 @@ func (m *QueryAllTaskRewardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTaskRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRemoteTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2258,47 +9456,13 @@ func (m *QueryAllTaskRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTaskRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRemoteTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTaskRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRemoteTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskReward", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskReward = append(m.TaskReward, TaskReward{})
-			if err := m.TaskReward[len(m.TaskReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2328,7 +9492,7 @@ func (m *QueryAllTaskRewardResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2355,7 +9519,7 @@ func (m *QueryAllTaskRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTaskRewardsByClaimantRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRemoteTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,17 +9542,17 @@ func (m *QueryGetTaskRewardsByClaimantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRemoteTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRemoteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTask", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2398,77 +9562,29 @@ func (m *QueryGetTaskRewardsByClaimantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.RemoteTask = append(m.RemoteTask, RemoteTask{})
+			if err := m.RemoteTask[len(m.RemoteTask)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetTaskRewardsByClaimantResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTaskRewardsByClaimantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2495,8 +9611,10 @@ func (m *QueryGetTaskRewardsByClaimantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskRewards = append(m.TaskRewards, TaskReward{})
-			if err := m.TaskRewards[len(m.TaskRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
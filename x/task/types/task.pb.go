@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -35,6 +36,7 @@ const (
 	TASK_STATUS_APPROVED  TaskStatus = 4
 	TASK_STATUS_REJECTED  TaskStatus = 5
 	TASK_STATUS_CLOSED    TaskStatus = 6
+	TASK_STATUS_DISPUTED  TaskStatus = 7
 )
 
 var TaskStatus_name = map[int32]string{
@@ -45,6 +47,7 @@ var TaskStatus_name = map[int32]string{
 	4: "TASK_STATUS_APPROVED",
 	5: "TASK_STATUS_REJECTED",
 	6: "TASK_STATUS_CLOSED",
+	7: "TASK_STATUS_DISPUTED",
 }
 
 var TaskStatus_value = map[string]int32{
@@ -55,6 +58,7 @@ var TaskStatus_value = map[string]int32{
 	"TASK_STATUS_APPROVED":  4,
 	"TASK_STATUS_REJECTED":  5,
 	"TASK_STATUS_CLOSED":    6,
+	"TASK_STATUS_DISPUTED":  7,
 }
 
 func (x TaskStatus) String() string {
@@ -65,19 +69,139 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_55df38726042d56c, []int{0}
 }
 
+// ReviewDecision is a reviewer's verdict on a submission
+type ReviewDecision int32
+
+const (
+	REVIEW_DECISION_UNSPECIFIED ReviewDecision = 0
+	REVIEW_DECISION_ENDORSE     ReviewDecision = 1
+	REVIEW_DECISION_REJECT      ReviewDecision = 2
+)
+
+var ReviewDecision_name = map[int32]string{
+	0: "REVIEW_DECISION_UNSPECIFIED",
+	1: "REVIEW_DECISION_ENDORSE",
+	2: "REVIEW_DECISION_REJECT",
+}
+
+var ReviewDecision_value = map[string]int32{
+	"REVIEW_DECISION_UNSPECIFIED": 0,
+	"REVIEW_DECISION_ENDORSE":     1,
+	"REVIEW_DECISION_REJECT":      2,
+}
+
+func (x ReviewDecision) String() string {
+	return proto.EnumName(ReviewDecision_name, int32(x))
+}
+
+func (ReviewDecision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{1}
+}
+
+// DisputeResolution is how an arbiter settles a disputed task
+type DisputeResolution int32
+
+const (
+	DISPUTE_RESOLUTION_UNSPECIFIED DisputeResolution = 0
+	// the whole bounty goes to the claimant
+	DISPUTE_RESOLUTION_PAYOUT DisputeResolution = 1
+	// the whole bounty goes back to the creator
+	DISPUTE_RESOLUTION_REFUND DisputeResolution = 2
+	// the bounty is split between claimant and creator by weight
+	DISPUTE_RESOLUTION_SPLIT DisputeResolution = 3
+)
+
+var DisputeResolution_name = map[int32]string{
+	0: "DISPUTE_RESOLUTION_UNSPECIFIED",
+	1: "DISPUTE_RESOLUTION_PAYOUT",
+	2: "DISPUTE_RESOLUTION_REFUND",
+	3: "DISPUTE_RESOLUTION_SPLIT",
+}
+
+var DisputeResolution_value = map[string]int32{
+	"DISPUTE_RESOLUTION_UNSPECIFIED": 0,
+	"DISPUTE_RESOLUTION_PAYOUT":      1,
+	"DISPUTE_RESOLUTION_REFUND":      2,
+	"DISPUTE_RESOLUTION_SPLIT":       3,
+}
+
+func (x DisputeResolution) String() string {
+	return proto.EnumName(DisputeResolution_name, int32(x))
+}
+
+func (DisputeResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{2}
+}
+
+// SubmissionOutcome is the review outcome of a submission
+type SubmissionOutcome int32
+
+const (
+	SUBMISSION_OUTCOME_PENDING  SubmissionOutcome = 0
+	SUBMISSION_OUTCOME_APPROVED SubmissionOutcome = 1
+	SUBMISSION_OUTCOME_REJECTED SubmissionOutcome = 2
+)
+
+var SubmissionOutcome_name = map[int32]string{
+	0: "SUBMISSION_OUTCOME_PENDING",
+	1: "SUBMISSION_OUTCOME_APPROVED",
+	2: "SUBMISSION_OUTCOME_REJECTED",
+}
+
+var SubmissionOutcome_value = map[string]int32{
+	"SUBMISSION_OUTCOME_PENDING":  0,
+	"SUBMISSION_OUTCOME_APPROVED": 1,
+	"SUBMISSION_OUTCOME_REJECTED": 2,
+}
+
+func (x SubmissionOutcome) String() string {
+	return proto.EnumName(SubmissionOutcome_name, int32(x))
+}
+
+func (SubmissionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{3}
+}
+
 // Task message.
 type Task struct {
-	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Bounty      types.Coin `protobuf:"bytes,4,opt,name=bounty,proto3" json:"bounty"`
-	Status      TaskStatus `protobuf:"varint,5,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
-	Claimant    string     `protobuf:"bytes,6,opt,name=claimant,proto3" json:"claimant,omitempty"`
-	Proof       string     `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
-	Approver    string     `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
-	Creator     string     `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
-	CreatedAt   int64      `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   int64      `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// escrowed coins, each within the bounds of its denom in Params
+	Bounty   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+	Status   TaskStatus                               `protobuf:"varint,5,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
+	Claimant string                                   `protobuf:"bytes,6,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	// summary of the latest proof, the full proofs are kept as Submission records
+	Proof     string `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
+	Approver  string `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	Creator   string `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// per-task expiry of an open task in seconds, 0 uses Params.task_expiry
+	TaskExpiry uint64 `protobuf:"varint,12,opt,name=task_expiry,json=taskExpiry,proto3" json:"task_expiry,omitempty"`
+	// per-task claim window in seconds, 0 uses Params.claim_deadline
+	ClaimDeadline uint64 `protobuf:"varint,13,opt,name=claim_deadline,json=claimDeadline,proto3" json:"claim_deadline,omitempty"`
+	// per-task review window in seconds, 0 uses Params.submission_deadline
+	SubmissionDeadline uint64 `protobuf:"varint,14,opt,name=submission_deadline,json=submissionDeadline,proto3" json:"submission_deadline,omitempty"`
+	// addresses allowed to endorse or reject submissions through MsgReviewTask
+	Reviewers []string `protobuf:"bytes,15,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	// attempt number of the latest submission, 0 before the first one
+	Attempt uint64 `protobuf:"varint,16,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// addresses nominated by the creator to approve or reject submissions
+	// alongside the creator. approver records who actually approved the task.
+	Approvers []string `protobuf:"bytes,17,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// fees the creator sponsors for the claimant's MsgSubmitTask through
+	// x/feegrant once the task is claimed, empty when the creator sponsors nothing
+	FeeAllowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=fee_allowance,json=feeAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance"`
+	// set on tasks created before bounties were escrowed, whose payouts and
+	// refunds are recorded without moving any coins
+	Unescrowed bool `protobuf:"varint,19,opt,name=unescrowed,proto3" json:"unescrowed,omitempty"`
+	// when the task was last claimed, the claim deadline runs from it
+	ClaimedAt int64 `protobuf:"varint,20,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	// when the latest proof was submitted, the review window runs from it
+	SubmittedAt int64 `protobuf:"varint,21,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// when the latest submission was rejected, the dispute window runs from it
+	RejectedAt int64 `protobuf:"varint,22,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -134,11 +258,11 @@ func (m *Task) GetDescription() string {
 	return ""
 }
 
-func (m *Task) GetBounty() types.Coin {
+func (m *Task) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Bounty
 	}
-	return types.Coin{}
+	return nil
 }
 
 func (m *Task) GetStatus() TaskStatus {
@@ -190,96 +314,104 @@ func (m *Task) GetUpdatedAt() int64 {
 	return 0
 }
 
-// proof of task completion
-type TaskProof struct {
-	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Data      string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+func (m *Task) GetTaskExpiry() uint64 {
+	if m != nil {
+		return m.TaskExpiry
+	}
+	return 0
 }
 
-func (m *TaskProof) Reset()         { *m = TaskProof{} }
-func (m *TaskProof) String() string { return proto.CompactTextString(m) }
-func (*TaskProof) ProtoMessage()    {}
-func (*TaskProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55df38726042d56c, []int{1}
-}
-func (m *TaskProof) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Task) GetClaimDeadline() uint64 {
+	if m != nil {
+		return m.ClaimDeadline
+	}
+	return 0
 }
-func (m *TaskProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TaskProof.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Task) GetSubmissionDeadline() uint64 {
+	if m != nil {
+		return m.SubmissionDeadline
 	}
+	return 0
 }
-func (m *TaskProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskProof.Merge(m, src)
+
+func (m *Task) GetReviewers() []string {
+	if m != nil {
+		return m.Reviewers
+	}
+	return nil
 }
-func (m *TaskProof) XXX_Size() int {
-	return m.Size()
+
+func (m *Task) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
 }
-func (m *TaskProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskProof.DiscardUnknown(m)
+
+func (m *Task) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
 }
 
-var xxx_messageInfo_TaskProof proto.InternalMessageInfo
+func (m *Task) GetFeeAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return nil
+}
 
-func (m *TaskProof) GetHash() string {
+func (m *Task) GetUnescrowed() bool {
 	if m != nil {
-		return m.Hash
+		return m.Unescrowed
 	}
-	return ""
+	return false
 }
 
-func (m *TaskProof) GetType() string {
+func (m *Task) GetClaimedAt() int64 {
 	if m != nil {
-		return m.Type
+		return m.ClaimedAt
 	}
-	return ""
+	return 0
 }
 
-func (m *TaskProof) GetTimestamp() int64 {
+func (m *Task) GetSubmittedAt() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.SubmittedAt
 	}
 	return 0
 }
 
-func (m *TaskProof) GetData() string {
+func (m *Task) GetRejectedAt() int64 {
 	if m != nil {
-		return m.Data
+		return m.RejectedAt
 	}
-	return ""
+	return 0
 }
 
-// reward distribution for completed tasks
-type TaskReward struct {
-	TaskId    uint64     `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Claimant  string     `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
-	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	Timestamp int64      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TxHash    string     `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+// review of the current submission of a task by one of its reviewers
+type TaskReview struct {
+	TaskId    uint64         `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Reviewer  string         `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Decision  ReviewDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=taskbounty.task.v1.ReviewDecision" json:"decision,omitempty"`
+	Comment   string         `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Timestamp int64          `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *TaskReward) Reset()         { *m = TaskReward{} }
-func (m *TaskReward) String() string { return proto.CompactTextString(m) }
-func (*TaskReward) ProtoMessage()    {}
-func (*TaskReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55df38726042d56c, []int{2}
+func (m *TaskReview) Reset()         { *m = TaskReview{} }
+func (m *TaskReview) String() string { return proto.CompactTextString(m) }
+func (*TaskReview) ProtoMessage()    {}
+func (*TaskReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{1}
 }
-func (m *TaskReward) XXX_Unmarshal(b []byte) error {
+func (m *TaskReview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskReview.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -289,75 +421,82 @@ func (m *TaskReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TaskReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskReward.Merge(m, src)
+func (m *TaskReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskReview.Merge(m, src)
 }
-func (m *TaskReward) XXX_Size() int {
+func (m *TaskReview) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskReward.DiscardUnknown(m)
+func (m *TaskReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskReview.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskReward proto.InternalMessageInfo
+var xxx_messageInfo_TaskReview proto.InternalMessageInfo
 
-func (m *TaskReward) GetTaskId() uint64 {
+func (m *TaskReview) GetTaskId() uint64 {
 	if m != nil {
 		return m.TaskId
 	}
 	return 0
 }
 
-func (m *TaskReward) GetClaimant() string {
+func (m *TaskReview) GetReviewer() string {
 	if m != nil {
-		return m.Claimant
+		return m.Reviewer
 	}
 	return ""
 }
 
-func (m *TaskReward) GetAmount() types.Coin {
+func (m *TaskReview) GetDecision() ReviewDecision {
 	if m != nil {
-		return m.Amount
+		return m.Decision
 	}
-	return types.Coin{}
+	return REVIEW_DECISION_UNSPECIFIED
 }
 
-func (m *TaskReward) GetTimestamp() int64 {
+func (m *TaskReview) GetComment() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Comment
 	}
-	return 0
+	return ""
 }
 
-func (m *TaskReward) GetTxHash() string {
+func (m *TaskReview) GetTimestamp() int64 {
 	if m != nil {
-		return m.TxHash
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-// filter options for querying tasks
-type TaskFilter struct {
-	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Claimant  string     `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
-	Approver  string     `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
-	Status    TaskStatus `protobuf:"varint,4,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
-	MinBounty types.Coin `protobuf:"bytes,5,opt,name=min_bounty,json=minBounty,proto3" json:"min_bounty"`
-	MaxBounty types.Coin `protobuf:"bytes,6,opt,name=max_bounty,json=maxBounty,proto3" json:"max_bounty"`
+// dispute raised by a claimant against the rejection of their submission
+type TaskDispute struct {
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// sequence of the dispute within the task
+	Seq       uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Claimant  string `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// empty until the dispute is resolved
+	Arbiter        string                                   `protobuf:"bytes,6,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Resolution     DisputeResolution                        `protobuf:"varint,7,opt,name=resolution,proto3,enum=taskbounty.task.v1.DisputeResolution" json:"resolution,omitempty"`
+	Note           string                                   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	ClaimantAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=claimant_amount,json=claimantAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimant_amount"`
+	CreatorAmount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=creator_amount,json=creatorAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_amount"`
+	ResolvedAt     int64                                    `protobuf:"varint,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
 }
 
-func (m *TaskFilter) Reset()         { *m = TaskFilter{} }
-func (m *TaskFilter) String() string { return proto.CompactTextString(m) }
-func (*TaskFilter) ProtoMessage()    {}
-func (*TaskFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55df38726042d56c, []int{3}
+func (m *TaskDispute) Reset()         { *m = TaskDispute{} }
+func (m *TaskDispute) String() string { return proto.CompactTextString(m) }
+func (*TaskDispute) ProtoMessage()    {}
+func (*TaskDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{2}
 }
-func (m *TaskFilter) XXX_Unmarshal(b []byte) error {
+func (m *TaskDispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskDispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -367,131 +506,125 @@ func (m *TaskFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TaskFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskFilter.Merge(m, src)
+func (m *TaskDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskDispute.Merge(m, src)
 }
-func (m *TaskFilter) XXX_Size() int {
+func (m *TaskDispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskFilter.DiscardUnknown(m)
+func (m *TaskDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskFilter proto.InternalMessageInfo
+var xxx_messageInfo_TaskDispute proto.InternalMessageInfo
 
-func (m *TaskFilter) GetCreator() string {
+func (m *TaskDispute) GetTaskId() uint64 {
 	if m != nil {
-		return m.Creator
+		return m.TaskId
 	}
-	return ""
+	return 0
 }
 
-func (m *TaskFilter) GetClaimant() string {
+func (m *TaskDispute) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *TaskDispute) GetClaimant() string {
 	if m != nil {
 		return m.Claimant
 	}
 	return ""
 }
 
-func (m *TaskFilter) GetApprover() string {
+func (m *TaskDispute) GetReason() string {
 	if m != nil {
-		return m.Approver
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *TaskFilter) GetStatus() TaskStatus {
+func (m *TaskDispute) GetCreatedAt() int64 {
 	if m != nil {
-		return m.Status
+		return m.CreatedAt
 	}
-	return TASK_STATUS_UNDEFINED
+	return 0
 }
 
-func (m *TaskFilter) GetMinBounty() types.Coin {
+func (m *TaskDispute) GetArbiter() string {
 	if m != nil {
-		return m.MinBounty
+		return m.Arbiter
 	}
-	return types.Coin{}
+	return ""
 }
 
-func (m *TaskFilter) GetMaxBounty() types.Coin {
+func (m *TaskDispute) GetResolution() DisputeResolution {
 	if m != nil {
-		return m.MaxBounty
+		return m.Resolution
 	}
-	return types.Coin{}
+	return DISPUTE_RESOLUTION_UNSPECIFIED
 }
 
-// sort options for querying tasks
-type TaskSort struct {
-	Field     string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+func (m *TaskDispute) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
 }
 
-func (m *TaskSort) Reset()         { *m = TaskSort{} }
-func (m *TaskSort) String() string { return proto.CompactTextString(m) }
-func (*TaskSort) ProtoMessage()    {}
-func (*TaskSort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55df38726042d56c, []int{4}
-}
-func (m *TaskSort) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TaskSort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TaskSort.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TaskDispute) GetClaimantAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimantAmount
 	}
+	return nil
 }
-func (m *TaskSort) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskSort.Merge(m, src)
-}
-func (m *TaskSort) XXX_Size() int {
-	return m.Size()
-}
-func (m *TaskSort) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskSort.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TaskSort proto.InternalMessageInfo
 
-func (m *TaskSort) GetField() string {
+func (m *TaskDispute) GetCreatorAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Field
+		return m.CreatorAmount
 	}
-	return ""
+	return nil
 }
 
-func (m *TaskSort) GetDirection() string {
+func (m *TaskDispute) GetResolvedAt() int64 {
 	if m != nil {
-		return m.Direction
+		return m.ResolvedAt
 	}
-	return ""
+	return 0
 }
 
-// status transition
-type TaskTransition struct {
-	From TaskStatus `protobuf:"varint,1,opt,name=from,proto3,enum=taskbounty.task.v1.TaskStatus" json:"from,omitempty"`
-	To   TaskStatus `protobuf:"varint,2,opt,name=to,proto3,enum=taskbounty.task.v1.TaskStatus" json:"to,omitempty"`
+// proof submitted for a task, one per attempt
+type Submission struct {
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// attempt number of the submission within the task, starting at 1
+	Attempt   uint64    `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Submitter string    `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	Proof     TaskProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
+	// block time and height at which the proof was submitted
+	SubmittedAt int64             `protobuf:"varint,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	BlockHeight int64             `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Outcome     SubmissionOutcome `protobuf:"varint,7,opt,name=outcome,proto3,enum=taskbounty.task.v1.SubmissionOutcome" json:"outcome,omitempty"`
+	// who approved or rejected the submission, empty when decided by the chain
+	Reviewer string `protobuf:"bytes,8,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// rejection reason or review note
+	ReviewNote string `protobuf:"bytes,9,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	ReviewedAt int64  `protobuf:"varint,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
 }
 
-func (m *TaskTransition) Reset()         { *m = TaskTransition{} }
-func (m *TaskTransition) String() string { return proto.CompactTextString(m) }
-func (*TaskTransition) ProtoMessage()    {}
-func (*TaskTransition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55df38726042d56c, []int{5}
+func (m *Submission) Reset()         { *m = Submission{} }
+func (m *Submission) String() string { return proto.CompactTextString(m) }
+func (*Submission) ProtoMessage()    {}
+func (*Submission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{3}
 }
-func (m *TaskTransition) XXX_Unmarshal(b []byte) error {
+func (m *Submission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Submission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskTransition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Submission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -501,607 +634,3028 @@ func (m *TaskTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *TaskTransition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskTransition.Merge(m, src)
+func (m *Submission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Submission.Merge(m, src)
 }
-func (m *TaskTransition) XXX_Size() int {
+func (m *Submission) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskTransition) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskTransition.DiscardUnknown(m)
+func (m *Submission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Submission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskTransition proto.InternalMessageInfo
+var xxx_messageInfo_Submission proto.InternalMessageInfo
 
-func (m *TaskTransition) GetFrom() TaskStatus {
+func (m *Submission) GetTaskId() uint64 {
 	if m != nil {
-		return m.From
+		return m.TaskId
 	}
-	return TASK_STATUS_UNDEFINED
+	return 0
 }
 
-func (m *TaskTransition) GetTo() TaskStatus {
+func (m *Submission) GetAttempt() uint64 {
 	if m != nil {
-		return m.To
+		return m.Attempt
 	}
-	return TASK_STATUS_UNDEFINED
-}
-
-func init() {
-	proto.RegisterEnum("taskbounty.task.v1.TaskStatus", TaskStatus_name, TaskStatus_value)
-	proto.RegisterType((*Task)(nil), "taskbounty.task.v1.Task")
-	proto.RegisterType((*TaskProof)(nil), "taskbounty.task.v1.TaskProof")
-	proto.RegisterType((*TaskReward)(nil), "taskbounty.task.v1.TaskReward")
-	proto.RegisterType((*TaskFilter)(nil), "taskbounty.task.v1.TaskFilter")
-	proto.RegisterType((*TaskSort)(nil), "taskbounty.task.v1.TaskSort")
-	proto.RegisterType((*TaskTransition)(nil), "taskbounty.task.v1.TaskTransition")
+	return 0
 }
 
-func init() { proto.RegisterFile("taskbounty/task/v1/task.proto", fileDescriptor_55df38726042d56c) }
-
-var fileDescriptor_55df38726042d56c = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8e, 0x1d, 0xc7, 0xe0, 0x83, 0x84, 0xac, 0xb9, 0xb9, 0x17, 0x13, 0x5d, 0x7c, 0xa3, 0xac,
-	0xa2, 0xbb, 0x70, 0x14, 0x2a, 0x95, 0x1d, 0x52, 0x42, 0x8c, 0x9a, 0xb6, 0x40, 0xe4, 0x98, 0x2e,
-	0xba, 0x89, 0x26, 0xb1, 0x81, 0x11, 0xb1, 0xc7, 0xb2, 0x87, 0x34, 0xbc, 0x41, 0x97, 0x5d, 0xf5,
-	0x05, 0xfa, 0x04, 0x7d, 0x86, 0x6e, 0x58, 0xb2, 0xec, 0xaa, 0xaa, 0x40, 0x7d, 0x8f, 0x6a, 0x66,
-	0x1c, 0xf2, 0x53, 0xa9, 0xd0, 0x95, 0xcf, 0xf9, 0xce, 0x7c, 0x9e, 0x6f, 0xbe, 0x73, 0x66, 0x60,
-	0x87, 0xe1, 0xec, 0x72, 0x48, 0xaf, 0x62, 0x76, 0xdd, 0xe0, 0x61, 0x63, 0xd2, 0x14, 0x5f, 0x27,
-	0x49, 0x29, 0xa3, 0x08, 0xcd, 0xcb, 0x8e, 0x80, 0x27, 0xcd, 0x8a, 0x3d, 0xa2, 0x59, 0x44, 0xb3,
-	0xc6, 0x10, 0x67, 0x61, 0x63, 0xd2, 0x1c, 0x86, 0x0c, 0x37, 0x1b, 0x23, 0x4a, 0x62, 0xc9, 0xa9,
-	0x94, 0xcf, 0xe9, 0x39, 0x15, 0x61, 0x83, 0x47, 0x12, 0xad, 0xfd, 0x50, 0x41, 0xf3, 0x71, 0x76,
-	0x89, 0x36, 0x41, 0x25, 0x81, 0xa5, 0x54, 0x95, 0xba, 0xe6, 0xa9, 0x24, 0x40, 0x65, 0x28, 0x31,
-	0xc2, 0xc6, 0xa1, 0xa5, 0x56, 0x95, 0xba, 0xe1, 0xc9, 0x04, 0x55, 0x61, 0x23, 0x08, 0xb3, 0x51,
-	0x4a, 0x12, 0x46, 0x68, 0x6c, 0x15, 0x45, 0x6d, 0x11, 0x42, 0x7b, 0xa0, 0x4b, 0x61, 0x96, 0x56,
-	0x55, 0xea, 0x1b, 0xbb, 0xdb, 0x8e, 0xd4, 0xe5, 0x70, 0x5d, 0x4e, 0xae, 0xcb, 0x39, 0xa0, 0x24,
-	0x6e, 0x6b, 0x37, 0xdf, 0xfe, 0x2b, 0x78, 0xf9, 0x72, 0xf4, 0x1c, 0xf4, 0x8c, 0x61, 0x76, 0x95,
-	0x59, 0xa5, 0xaa, 0x52, 0xdf, 0xdc, 0xb5, 0x9d, 0x5f, 0x0f, 0xe9, 0x70, 0xa9, 0x7d, 0xb1, 0xca,
-	0xcb, 0x57, 0xa3, 0x0a, 0xac, 0x8f, 0xc6, 0x98, 0x44, 0x38, 0x66, 0x96, 0x2e, 0xf4, 0x3c, 0xe4,
-	0xfc, 0x10, 0x49, 0x4a, 0xe9, 0x99, 0xb5, 0x26, 0x0f, 0x21, 0x12, 0xce, 0xc0, 0x49, 0x92, 0xd2,
-	0x49, 0x98, 0x5a, 0xeb, 0x92, 0x31, 0xcb, 0x91, 0x05, 0x6b, 0xa3, 0x34, 0xc4, 0x8c, 0xa6, 0x96,
-	0x21, 0x4a, 0xb3, 0x14, 0xed, 0x00, 0x88, 0x30, 0x0c, 0x06, 0x98, 0x59, 0x50, 0x55, 0xea, 0x45,
-	0xcf, 0xc8, 0x91, 0x16, 0xe3, 0xe5, 0xab, 0x24, 0x98, 0x95, 0x37, 0x64, 0x39, 0x47, 0x5a, 0xac,
-	0x16, 0x82, 0xc1, 0xb5, 0xf7, 0x84, 0x00, 0x04, 0xda, 0x05, 0xce, 0x2e, 0x84, 0xdb, 0x86, 0x27,
-	0x62, 0x8e, 0xb1, 0xeb, 0x64, 0x66, 0xb7, 0x88, 0xd1, 0xbf, 0x60, 0x30, 0x12, 0x85, 0x19, 0xc3,
-	0x51, 0x22, 0xbc, 0x2e, 0x7a, 0x73, 0x80, 0x33, 0x02, 0xcc, 0xb0, 0xf0, 0xd9, 0xf0, 0x44, 0x5c,
-	0xfb, 0xac, 0x00, 0xf0, 0x7d, 0xbc, 0xf0, 0x1d, 0x4e, 0x03, 0xb4, 0x05, 0x6b, 0xdc, 0xb9, 0xc1,
-	0x43, 0x67, 0x75, 0x9e, 0x76, 0x83, 0x25, 0xd3, 0xd4, 0x15, 0xd3, 0xf6, 0x40, 0xc7, 0x11, 0xb7,
-	0x5d, 0x6c, 0xf9, 0x94, 0x0e, 0xca, 0xe5, 0xcb, 0x72, 0xb5, 0x55, 0xb9, 0x5c, 0xcb, 0x74, 0x20,
-	0xce, 0x5d, 0x12, 0x3b, 0xea, 0x6c, 0xfa, 0x02, 0x67, 0x17, 0xb5, 0x8f, 0xaa, 0xd4, 0x7c, 0x48,
-	0xc6, 0x6c, 0xb9, 0x03, 0xca, 0x72, 0x07, 0x7e, 0x27, 0x7a, 0xb1, 0xa7, 0xc5, 0x95, 0x9e, 0xce,
-	0x27, 0x4b, 0xfb, 0xa3, 0xc9, 0xda, 0x07, 0x88, 0x48, 0x3c, 0xc8, 0xc7, 0xb9, 0xf4, 0x34, 0x33,
-	0x8c, 0x88, 0xc4, 0x6d, 0x39, 0xd1, 0x9c, 0x8f, 0xa7, 0x33, 0xbe, 0xfe, 0x54, 0x3e, 0x9e, 0x4a,
-	0x7e, 0x6d, 0x1f, 0xd6, 0x85, 0x2a, 0x9a, 0x8a, 0x49, 0x3e, 0x23, 0xe1, 0x38, 0xc8, 0x3d, 0x91,
-	0x09, 0x77, 0x3c, 0x20, 0x69, 0x38, 0x12, 0x97, 0x51, 0x5a, 0x32, 0x07, 0x6a, 0x0c, 0x36, 0x39,
-	0xdf, 0x4f, 0x71, 0x9c, 0x11, 0x71, 0x39, 0x77, 0x41, 0x3b, 0x4b, 0x69, 0x24, 0x7e, 0xf2, 0xb8,
-	0x0f, 0x62, 0x2d, 0x72, 0x40, 0x65, 0x54, 0xfc, 0xfc, 0x71, 0x86, 0xca, 0xe8, 0xff, 0x5f, 0xf2,
-	0x11, 0x94, 0x10, 0xda, 0x86, 0xbf, 0xfd, 0x56, 0xff, 0xd5, 0xa0, 0xef, 0xb7, 0xfc, 0xd3, 0xfe,
-	0xe0, 0xf4, 0xb8, 0xe3, 0x1e, 0x76, 0x8f, 0xdd, 0x8e, 0x59, 0x40, 0x65, 0x30, 0x17, 0x4b, 0x27,
-	0x3d, 0xf7, 0xd8, 0x54, 0xd0, 0x16, 0xfc, 0xb5, 0x88, 0x1e, 0xbc, 0x6e, 0x75, 0x8f, 0xdc, 0x8e,
-	0xa9, 0xae, 0xfe, 0xa9, 0x7f, 0xda, 0x3e, 0xea, 0xfa, 0xbe, 0xdb, 0x31, 0x8b, 0xc8, 0x82, 0xf2,
-	0x62, 0xa9, 0xd5, 0xeb, 0x79, 0x27, 0x6f, 0xdc, 0x8e, 0xa9, 0xad, 0x56, 0x3c, 0xf7, 0xa5, 0x7b,
-	0xc0, 0x39, 0x25, 0xf4, 0x0f, 0xa0, 0xe5, 0x7d, 0x4e, 0xfa, 0x6e, 0xc7, 0xd4, 0x2b, 0xda, 0xfb,
-	0x4f, 0x76, 0xa1, 0xdd, 0xbc, 0xb9, 0xb3, 0x95, 0xdb, 0x3b, 0x5b, 0xf9, 0x7e, 0x67, 0x2b, 0x1f,
-	0xee, 0xed, 0xc2, 0xed, 0xbd, 0x5d, 0xf8, 0x7a, 0x6f, 0x17, 0xde, 0x6e, 0x2d, 0x3c, 0xcd, 0x53,
-	0xf9, 0x38, 0xf3, 0xcb, 0x9a, 0x0d, 0x75, 0xf1, 0xa2, 0x3e, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff,
-	0x6a, 0x4e, 0xa9, 0xfc, 0xbc, 0x05, 0x00, 0x00,
+func (m *Submission) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
 }
 
-func (m *Task) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Submission) GetProof() TaskProof {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return TaskProof{}
 }
 
-func (m *Task) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Submission) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
 }
 
-func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatedAt != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Status != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTask(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *Submission) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TaskProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Submission) GetOutcome() SubmissionOutcome {
+	if m != nil {
+		return m.Outcome
 	}
-	return dAtA[:n], nil
+	return SUBMISSION_OUTCOME_PENDING
 }
 
-func (m *TaskProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Submission) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
 }
 
-func (m *TaskProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *Submission) GetReviewNote() string {
+	if m != nil {
+		return m.ReviewNote
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TaskReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Submission) GetReviewedAt() int64 {
+	if m != nil {
+		return m.ReviewedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TaskReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// proof of task completion
+type TaskProof struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data      string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *TaskReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *TaskProof) Reset()         { *m = TaskProof{} }
+func (m *TaskProof) String() string { return proto.CompactTextString(m) }
+func (*TaskProof) ProtoMessage()    {}
+func (*TaskProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{4}
+}
+func (m *TaskProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTask(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TaskProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProof.Merge(m, src)
+}
+func (m *TaskProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProof.DiscardUnknown(m)
 }
 
-func (m *TaskFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TaskProof proto.InternalMessageInfo
+
+func (m *TaskProof) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskProof) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *TaskFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MaxBounty.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTask(dAtA, i, uint64(size))
+func (m *TaskProof) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.MinBounty.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *TaskProof) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// reward distribution for completed tasks
+type TaskReward struct {
+	TaskId    uint64                                   `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Claimant  string                                   `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Timestamp int64                                    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// hash of the transaction that paid out the reward
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// height of the block in which the reward was paid out
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *TaskReward) Reset()         { *m = TaskReward{} }
+func (m *TaskReward) String() string { return proto.CompactTextString(m) }
+func (*TaskReward) ProtoMessage()    {}
+func (*TaskReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{5}
+}
+func (m *TaskReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTask(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Status != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TaskReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskReward.Merge(m, src)
+}
+func (m *TaskReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskReward proto.InternalMessageInfo
+
+func (m *TaskReward) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TaskSort) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskReward) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskSort) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *TaskSort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Direction) > 0 {
-		i -= len(m.Direction)
-		copy(dAtA[i:], m.Direction)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Direction)))
-		i--
-		dAtA[i] = 0x12
+func (m *TaskReward) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintTask(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TaskReward) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TaskTransition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskReward) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TaskTransition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// refund of an escrowed bounty to the task creator
+type TaskRefund struct {
+	TaskId    uint64                                   `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Creator   string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Timestamp int64                                    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// hash of the transaction that triggered the refund, empty when refunded by the chain
+	TxHash      string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHeight int64  `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// why the bounty was refunded: "deleted", "closed", "expired" or "disputed"
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *TaskTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.To != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.To))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.From != 0 {
-		i = encodeVarintTask(dAtA, i, uint64(m.From))
-		i--
-		dAtA[i] = 0x8
+func (m *TaskRefund) Reset()         { *m = TaskRefund{} }
+func (m *TaskRefund) String() string { return proto.CompactTextString(m) }
+func (*TaskRefund) ProtoMessage()    {}
+func (*TaskRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{6}
+}
+func (m *TaskRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TaskRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRefund.Merge(m, src)
+}
+func (m *TaskRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRefund.DiscardUnknown(m)
 }
 
-func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTask(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TaskRefund proto.InternalMessageInfo
+
+func (m *TaskRefund) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Task) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTask(uint64(m.Id))
+
+func (m *TaskRefund) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+func (m *TaskRefund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return nil
+}
+
+func (m *TaskRefund) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	l = m.Bounty.Size()
-	n += 1 + l + sovTask(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTask(uint64(m.Status))
+	return 0
+}
+
+func (m *TaskRefund) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+func (m *TaskRefund) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return 0
+}
+
+func (m *TaskRefund) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTask(uint64(m.CreatedAt))
+	return ""
+}
+
+// filter options for querying tasks
+type TaskFilter struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Claimant  string     `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Approver  string     `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	Status    TaskStatus `protobuf:"varint,4,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
+	MinBounty types.Coin `protobuf:"bytes,5,opt,name=min_bounty,json=minBounty,proto3" json:"min_bounty"`
+	MaxBounty types.Coin `protobuf:"bytes,6,opt,name=max_bounty,json=maxBounty,proto3" json:"max_bounty"`
+}
+
+func (m *TaskFilter) Reset()         { *m = TaskFilter{} }
+func (m *TaskFilter) String() string { return proto.CompactTextString(m) }
+func (*TaskFilter) ProtoMessage()    {}
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{7}
+}
+func (m *TaskFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovTask(uint64(m.UpdatedAt))
+}
+func (m *TaskFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskFilter.Merge(m, src)
+}
+func (m *TaskFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskFilter proto.InternalMessageInfo
+
+func (m *TaskFilter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *TaskProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskFilter) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+func (m *TaskFilter) GetApprover() string {
+	if m != nil {
+		return m.Approver
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+func (m *TaskFilter) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTask(uint64(m.Timestamp))
+	return TASK_STATUS_UNDEFINED
+}
+
+func (m *TaskFilter) GetMinBounty() types.Coin {
+	if m != nil {
+		return m.MinBounty
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return types.Coin{}
+}
+
+func (m *TaskFilter) GetMaxBounty() types.Coin {
+	if m != nil {
+		return m.MaxBounty
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *TaskReward) Size() (n int) {
-	if m == nil {
-		return 0
+// sort options for querying tasks
+type TaskSort struct {
+	Field     string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// denom whose amount orders tasks sorted by bounty, only tasks with a
+	// bounty in it are listed; defaults to the denom of the filter's bounty
+	// range, or else to the min_bounty denom of params
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TaskSort) Reset()         { *m = TaskSort{} }
+func (m *TaskSort) String() string { return proto.CompactTextString(m) }
+func (*TaskSort) ProtoMessage()    {}
+func (*TaskSort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{8}
+}
+func (m *TaskSort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovTask(uint64(m.TaskId))
+}
+func (m *TaskSort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSort.Merge(m, src)
+}
+func (m *TaskSort) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSort) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskSort proto.InternalMessageInfo
+
+func (m *TaskSort) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+func (m *TaskSort) GetDirection() string {
+	if m != nil {
+		return m.Direction
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTask(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovTask(uint64(m.Timestamp))
+	return ""
+}
+
+func (m *TaskSort) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return ""
+}
+
+// status transition, also recorded in the append-only history of a task
+type TaskTransition struct {
+	// UNDEFINED when the task is created
+	From TaskStatus `protobuf:"varint,1,opt,name=from,proto3,enum=taskbounty.task.v1.TaskStatus" json:"from,omitempty"`
+	// UNDEFINED when the task is deleted
+	To     TaskStatus `protobuf:"varint,2,opt,name=to,proto3,enum=taskbounty.task.v1.TaskStatus" json:"to,omitempty"`
+	TaskId uint64     `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// sequence of the transition within the task, starting at 0
+	Seq uint64 `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	// who caused the transition, empty when the chain did
+	Actor       string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	BlockHeight int64  `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp   int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Reason      string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *TaskTransition) Reset()         { *m = TaskTransition{} }
+func (m *TaskTransition) String() string { return proto.CompactTextString(m) }
+func (*TaskTransition) ProtoMessage()    {}
+func (*TaskTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55df38726042d56c, []int{9}
+}
+func (m *TaskTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TaskTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskTransition.Merge(m, src)
+}
+func (m *TaskTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskTransition.DiscardUnknown(m)
 }
 
-func (m *TaskFilter) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_TaskTransition proto.InternalMessageInfo
+
+func (m *TaskTransition) GetFrom() TaskStatus {
+	if m != nil {
+		return m.From
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return TASK_STATUS_UNDEFINED
+}
+
+func (m *TaskTransition) GetTo() TaskStatus {
+	if m != nil {
+		return m.To
 	}
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return TASK_STATUS_UNDEFINED
+}
+
+func (m *TaskTransition) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	return 0
+}
+
+func (m *TaskTransition) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	if m.Status != 0 {
-		n += 1 + sovTask(uint64(m.Status))
+	return 0
+}
+
+func (m *TaskTransition) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	l = m.MinBounty.Size()
-	n += 1 + l + sovTask(uint64(l))
-	l = m.MaxBounty.Size()
-	n += 1 + l + sovTask(uint64(l))
-	return n
+	return ""
 }
 
-func (m *TaskSort) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskTransition) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
+	return 0
+}
+
+func (m *TaskTransition) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TaskTransition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("taskbounty.task.v1.TaskStatus", TaskStatus_name, TaskStatus_value)
+	proto.RegisterEnum("taskbounty.task.v1.ReviewDecision", ReviewDecision_name, ReviewDecision_value)
+	proto.RegisterEnum("taskbounty.task.v1.DisputeResolution", DisputeResolution_name, DisputeResolution_value)
+	proto.RegisterEnum("taskbounty.task.v1.SubmissionOutcome", SubmissionOutcome_name, SubmissionOutcome_value)
+	proto.RegisterType((*Task)(nil), "taskbounty.task.v1.Task")
+	proto.RegisterType((*TaskReview)(nil), "taskbounty.task.v1.TaskReview")
+	proto.RegisterType((*TaskDispute)(nil), "taskbounty.task.v1.TaskDispute")
+	proto.RegisterType((*Submission)(nil), "taskbounty.task.v1.Submission")
+	proto.RegisterType((*TaskProof)(nil), "taskbounty.task.v1.TaskProof")
+	proto.RegisterType((*TaskReward)(nil), "taskbounty.task.v1.TaskReward")
+	proto.RegisterType((*TaskRefund)(nil), "taskbounty.task.v1.TaskRefund")
+	proto.RegisterType((*TaskFilter)(nil), "taskbounty.task.v1.TaskFilter")
+	proto.RegisterType((*TaskSort)(nil), "taskbounty.task.v1.TaskSort")
+	proto.RegisterType((*TaskTransition)(nil), "taskbounty.task.v1.TaskTransition")
+}
+
+func init() { proto.RegisterFile("taskbounty/task/v1/task.proto", fileDescriptor_55df38726042d56c) }
+
+var fileDescriptor_55df38726042d56c = []byte{
+	// 1478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0xf5, 0xb4, 0x8e, 0x6c, 0x45, 0x99, 0x38, 0x36, 0xe3, 0xc4, 0xb2, 0x22, 0x20, 0x80,
+	0x10, 0xe0, 0x4a, 0xd7, 0xbe, 0xc0, 0x05, 0xba, 0x49, 0x21, 0x5b, 0x74, 0xa3, 0x36, 0x91, 0x04,
+	0x4a, 0x4a, 0xd1, 0x6e, 0x08, 0x8a, 0x1c, 0xdb, 0xac, 0x45, 0x8e, 0x4a, 0x8e, 0x6c, 0x07, 0xdd,
+	0x74, 0x59, 0xa0, 0x40, 0xd1, 0x55, 0x81, 0xae, 0xbb, 0xeb, 0x3f, 0xe8, 0x3f, 0xc8, 0x32, 0x9b,
+	0x02, 0xdd, 0xf4, 0x81, 0xe4, 0x77, 0x14, 0x28, 0xe6, 0x41, 0x8a, 0xa2, 0x1f, 0x49, 0x81, 0x74,
+	0xd1, 0x95, 0xe6, 0xbc, 0x38, 0xe7, 0x7c, 0xf3, 0x9d, 0x33, 0x23, 0xd8, 0xa2, 0x66, 0x70, 0x32,
+	0x26, 0x33, 0x8f, 0x3e, 0x6f, 0xb2, 0x65, 0xf3, 0x74, 0x87, 0xff, 0x36, 0xa6, 0x3e, 0xa1, 0x04,
+	0xa1, 0xb9, 0xb9, 0xc1, 0xd5, 0xa7, 0x3b, 0x9b, 0x15, 0x8b, 0x04, 0x2e, 0x09, 0x9a, 0x63, 0x33,
+	0xc0, 0xcd, 0xd3, 0x9d, 0x31, 0xa6, 0xe6, 0x4e, 0xd3, 0x22, 0x8e, 0x27, 0x62, 0x36, 0xd7, 0x8e,
+	0xc8, 0x11, 0xe1, 0xcb, 0x26, 0x5b, 0x09, 0x6d, 0xed, 0xe7, 0x1c, 0x64, 0x86, 0x66, 0x70, 0x82,
+	0x4a, 0x90, 0x72, 0x6c, 0x55, 0xa9, 0x2a, 0xf5, 0x8c, 0x9e, 0x72, 0x6c, 0xb4, 0x06, 0x59, 0xea,
+	0xd0, 0x09, 0x56, 0x53, 0x55, 0xa5, 0x5e, 0xd0, 0x85, 0x80, 0xaa, 0x50, 0xb4, 0x71, 0x60, 0xf9,
+	0xce, 0x94, 0x3a, 0xc4, 0x53, 0xd3, 0xdc, 0x16, 0x57, 0x21, 0x0b, 0x72, 0x22, 0x31, 0x35, 0x53,
+	0x4d, 0xd7, 0x8b, 0xbb, 0x77, 0x1a, 0x22, 0xaf, 0x06, 0xcb, 0xab, 0x21, 0xf3, 0x6a, 0xec, 0x13,
+	0xc7, 0xdb, 0xfb, 0xef, 0x8b, 0xdf, 0xb6, 0x97, 0x7e, 0xfc, 0x7d, 0xbb, 0x7e, 0xe4, 0xd0, 0xe3,
+	0xd9, 0xb8, 0x61, 0x11, 0xb7, 0x29, 0x8b, 0x10, 0x3f, 0xff, 0x09, 0xec, 0x93, 0x26, 0x7d, 0x3e,
+	0xc5, 0x01, 0x0f, 0x08, 0x74, 0xf9, 0x69, 0xf4, 0x7f, 0xc8, 0x05, 0xd4, 0xa4, 0xb3, 0x40, 0xcd,
+	0x56, 0x95, 0x7a, 0x69, 0xb7, 0xd2, 0xb8, 0x08, 0x48, 0x83, 0x95, 0x35, 0xe0, 0x5e, 0xba, 0xf4,
+	0x46, 0x9b, 0xb0, 0x6c, 0x4d, 0x4c, 0xc7, 0x35, 0x3d, 0xaa, 0xe6, 0x78, 0xee, 0x91, 0xcc, 0x0a,
+	0x9e, 0xfa, 0x84, 0x1c, 0xaa, 0x79, 0x51, 0x30, 0x17, 0x58, 0x84, 0x39, 0x9d, 0xfa, 0xe4, 0x14,
+	0xfb, 0xea, 0xb2, 0x88, 0x08, 0x65, 0xa4, 0x42, 0xde, 0xf2, 0xb1, 0x49, 0x89, 0xaf, 0x16, 0xb8,
+	0x29, 0x14, 0xd1, 0x16, 0x00, 0x5f, 0x62, 0xdb, 0x30, 0xa9, 0x0a, 0x55, 0xa5, 0x9e, 0xd6, 0x0b,
+	0x52, 0xd3, 0xa2, 0xcc, 0x3c, 0x9b, 0xda, 0xa1, 0xb9, 0x28, 0xcc, 0x52, 0xd3, 0xa2, 0x68, 0x1b,
+	0x8a, 0xac, 0x06, 0x03, 0x9f, 0x4f, 0x1d, 0xff, 0xb9, 0xba, 0xc2, 0xcf, 0x04, 0x98, 0x4a, 0xe3,
+	0x1a, 0xf4, 0x00, 0x4a, 0x3c, 0x6d, 0xc3, 0xc6, 0xa6, 0x3d, 0x71, 0x3c, 0xac, 0xae, 0x72, 0x9f,
+	0x55, 0xae, 0x6d, 0x4b, 0x25, 0x6a, 0xc2, 0xad, 0x60, 0x36, 0x76, 0x9d, 0x20, 0x70, 0x88, 0x37,
+	0xf7, 0x2d, 0x71, 0x5f, 0x34, 0x37, 0x45, 0x01, 0xf7, 0xa0, 0xe0, 0xe3, 0x53, 0x07, 0x9f, 0x61,
+	0x3f, 0x50, 0x6f, 0x54, 0xd3, 0xf5, 0x82, 0x3e, 0x57, 0xb0, 0x72, 0x4d, 0x4a, 0xb1, 0x3b, 0xa5,
+	0x6a, 0x99, 0x7f, 0x22, 0x14, 0x59, 0x5c, 0x08, 0x4a, 0xa0, 0xde, 0x14, 0x71, 0x91, 0x02, 0x4d,
+	0x61, 0xf5, 0x10, 0x63, 0xc3, 0x9c, 0x4c, 0xc8, 0x99, 0xe9, 0x59, 0x58, 0x45, 0xef, 0x9e, 0x18,
+	0x2b, 0x87, 0x18, 0xb7, 0xc2, 0x0d, 0x50, 0x05, 0x60, 0xe6, 0x31, 0x4e, 0x92, 0x33, 0x6c, 0xab,
+	0xb7, 0xaa, 0x4a, 0x7d, 0x59, 0x8f, 0x69, 0xf8, 0xf1, 0x30, 0xa4, 0x04, 0xfe, 0x6b, 0xf2, 0x78,
+	0x84, 0xa6, 0x45, 0xd1, 0x7d, 0x58, 0xe1, 0xe0, 0x50, 0x79, 0x40, 0xb7, 0xb9, 0x43, 0x31, 0xd2,
+	0x89, 0x23, 0xf2, 0xf1, 0x67, 0xd8, 0x92, 0x1e, 0xeb, 0xdc, 0x03, 0x42, 0x55, 0x8b, 0xd6, 0x7e,
+	0x52, 0x00, 0x18, 0x01, 0x75, 0x0e, 0x1f, 0xda, 0x80, 0x3c, 0x3f, 0xd2, 0xa8, 0xc5, 0x72, 0x4c,
+	0xec, 0xd8, 0x8c, 0x5f, 0x21, 0xc2, 0xb2, 0xd3, 0x22, 0x19, 0x3d, 0x82, 0x65, 0x1b, 0x5b, 0x4e,
+	0x10, 0x76, 0x5a, 0x69, 0xb7, 0x76, 0x19, 0xcf, 0xc5, 0x16, 0x6d, 0xe9, 0xa9, 0x47, 0x31, 0x9c,
+	0x9f, 0xc4, 0x75, 0xb1, 0x47, 0xd5, 0x8c, 0xe4, 0xa7, 0x10, 0xd9, 0x81, 0x51, 0xc7, 0xc5, 0x01,
+	0x35, 0xdd, 0x29, 0x6f, 0xa1, 0xb4, 0x3e, 0x57, 0xd4, 0xbe, 0xce, 0x40, 0x91, 0xe5, 0xde, 0x76,
+	0x82, 0xe9, 0x8c, 0xe2, 0xab, 0x93, 0x2f, 0x43, 0x3a, 0xc0, 0x9f, 0xf3, 0xbc, 0x33, 0x3a, 0x5b,
+	0x2e, 0x34, 0x58, 0x3a, 0xd1, 0x60, 0xeb, 0x90, 0xf3, 0xb1, 0x19, 0x10, 0x4f, 0x66, 0x23, 0xa5,
+	0x44, 0xb3, 0x64, 0x93, 0xcd, 0xc2, 0x68, 0xe7, 0x8f, 0x1d, 0x8a, 0x7d, 0xd9, 0xb2, 0xa1, 0x88,
+	0x34, 0x00, 0x1f, 0x07, 0x64, 0x32, 0xe3, 0xb3, 0x28, 0xcf, 0x11, 0x7a, 0x70, 0x19, 0x42, 0xb2,
+	0x10, 0x3d, 0x72, 0xd6, 0x63, 0x81, 0x08, 0x41, 0xc6, 0x23, 0x14, 0xcb, 0xf6, 0xe6, 0x6b, 0x44,
+	0xe1, 0x46, 0x98, 0xb7, 0x61, 0xba, 0xec, 0x6b, 0x6a, 0xe1, 0xdd, 0xb3, 0xb6, 0x14, 0xee, 0xd1,
+	0xe2, 0x5b, 0x20, 0x1f, 0x4a, 0x72, 0x82, 0x84, 0x9b, 0xc2, 0xbb, 0xdf, 0x74, 0x55, 0x6e, 0x21,
+	0xf7, 0xe4, 0x4c, 0x0e, 0xc8, 0xe4, 0x34, 0x3e, 0x8c, 0x20, 0x54, 0xb5, 0x68, 0xed, 0xcf, 0x14,
+	0xc0, 0x20, 0x9a, 0x15, 0x57, 0x93, 0x21, 0x36, 0x1e, 0x52, 0x17, 0xc6, 0x43, 0xd8, 0x3b, 0xbe,
+	0x64, 0xc5, 0x5c, 0x81, 0xde, 0x0b, 0xe7, 0x2e, 0x63, 0x45, 0x71, 0x77, 0xeb, 0xaa, 0x51, 0xde,
+	0x67, 0x4e, 0x7b, 0x19, 0x56, 0x6f, 0x38, 0x9c, 0x93, 0x8d, 0x9a, 0xbd, 0xd8, 0xa8, 0xf7, 0x61,
+	0x65, 0x3c, 0x21, 0xd6, 0x89, 0x71, 0x8c, 0x9d, 0xa3, 0x63, 0x31, 0xf5, 0xd3, 0x7a, 0x91, 0xeb,
+	0x1e, 0x73, 0x15, 0x7a, 0x1f, 0xf2, 0x64, 0x46, 0x2d, 0xe2, 0xe2, 0xeb, 0x38, 0x34, 0x87, 0xa0,
+	0x27, 0x9c, 0xf5, 0x30, 0x6a, 0xa1, 0x87, 0x97, 0x13, 0x3d, 0xcc, 0xe1, 0x65, 0x6b, 0x83, 0x73,
+	0x4c, 0xdc, 0x13, 0x20, 0x54, 0x5d, 0xc6, 0xb4, 0xc8, 0x21, 0x7e, 0x57, 0x40, 0xa8, 0x6a, 0xd1,
+	0x1a, 0x86, 0x42, 0x54, 0x3e, 0xe3, 0xea, 0xb1, 0x19, 0x1c, 0x73, 0xe8, 0x0b, 0x3a, 0x5f, 0x33,
+	0x1d, 0x3b, 0x5f, 0x39, 0x3e, 0xf8, 0x7a, 0xb1, 0xc1, 0xd3, 0x89, 0x06, 0x67, 0x11, 0xb6, 0x49,
+	0x4d, 0xd9, 0x87, 0x7c, 0x5d, 0xfb, 0x32, 0x15, 0x0e, 0xac, 0x33, 0xd3, 0xb7, 0xaf, 0x1d, 0x58,
+	0x51, 0x87, 0xa7, 0x12, 0x1d, 0x6e, 0x41, 0x4e, 0xf2, 0x36, 0xfd, 0x0f, 0xdc, 0xfd, 0xe2, 0xd3,
+	0x8b, 0xa5, 0x65, 0x92, 0xa5, 0xb1, 0xbc, 0xcf, 0x0d, 0x8e, 0x51, 0x56, 0x4c, 0x19, 0x7a, 0xfe,
+	0x98, 0xa1, 0xf4, 0x66, 0x22, 0xd4, 0xbe, 0x8b, 0x20, 0x38, 0x9c, 0x79, 0xf6, 0xb5, 0x4c, 0x0f,
+	0xef, 0xfd, 0xd4, 0xe2, 0xbd, 0xff, 0xef, 0x06, 0x20, 0x36, 0xa1, 0xf3, 0xf1, 0x09, 0x1d, 0x01,
+	0x73, 0xe0, 0x4c, 0xe8, 0xe2, 0xbb, 0x47, 0x59, 0xac, 0xff, 0x3a, 0x72, 0xc4, 0x5f, 0x52, 0xe9,
+	0xc4, 0x4b, 0x6a, 0xfe, 0x9e, 0xcb, 0xfc, 0xad, 0xf7, 0xdc, 0x23, 0x00, 0xd7, 0xf1, 0x0c, 0xf9,
+	0xe0, 0xcc, 0xf2, 0x01, 0x72, 0x0d, 0xe6, 0x62, 0x78, 0x14, 0x5c, 0xc7, 0xdb, 0x13, 0xef, 0x48,
+	0x16, 0x6f, 0x9e, 0x87, 0xf1, 0xb9, 0xb7, 0x8d, 0x37, 0xcf, 0x45, 0x7c, 0x6d, 0x08, 0xcb, 0x3c,
+	0x2b, 0xe2, 0xf3, 0xf7, 0xe3, 0xa1, 0x83, 0x27, 0xb6, 0xc4, 0x44, 0x08, 0xec, 0xb0, 0x6c, 0xc7,
+	0xc7, 0x16, 0xbf, 0xa2, 0x04, 0x24, 0x73, 0x05, 0x8b, 0xb1, 0xb1, 0x47, 0x5c, 0x09, 0x88, 0x10,
+	0x6a, 0xdf, 0xa4, 0xa0, 0xc4, 0x3e, 0x3b, 0xf4, 0x4d, 0x2f, 0x70, 0xb8, 0xe3, 0x2e, 0x64, 0x0e,
+	0x7d, 0xe2, 0xf2, 0x6f, 0xbf, 0x19, 0x1e, 0xee, 0x8b, 0x1a, 0x90, 0xa2, 0x84, 0xef, 0xf9, 0xe6,
+	0x88, 0x14, 0x25, 0x71, 0xbe, 0xa7, 0x2f, 0xbb, 0xe6, 0x33, 0xf3, 0x6b, 0x7e, 0x0d, 0xb2, 0xa6,
+	0xc5, 0xce, 0x5f, 0x50, 0x4c, 0x08, 0x6f, 0xc3, 0xb0, 0x05, 0xee, 0xe6, 0x93, 0xdc, 0x9d, 0xf3,
+	0x6f, 0x39, 0xce, 0xbf, 0x87, 0xbf, 0xca, 0xc7, 0x94, 0x48, 0x16, 0xdd, 0x81, 0xdb, 0xc3, 0xd6,
+	0xe0, 0x23, 0x63, 0x30, 0x6c, 0x0d, 0x47, 0x03, 0x63, 0xd4, 0x6d, 0x6b, 0x07, 0x9d, 0xae, 0xd6,
+	0x2e, 0x2f, 0xa1, 0x35, 0x28, 0xc7, 0x4d, 0xbd, 0xbe, 0xd6, 0x2d, 0x2b, 0x68, 0x03, 0x6e, 0xc5,
+	0xb5, 0xfb, 0x4f, 0x5a, 0x9d, 0xa7, 0x5a, 0xbb, 0x9c, 0x4a, 0x7e, 0x69, 0x30, 0xda, 0x7b, 0xda,
+	0x19, 0x0e, 0xb5, 0x76, 0x39, 0x8d, 0x54, 0x58, 0x8b, 0x9b, 0x5a, 0xfd, 0xbe, 0xde, 0x7b, 0xa6,
+	0xb5, 0xcb, 0x99, 0xa4, 0x45, 0xd7, 0x3e, 0xd4, 0xf6, 0x59, 0x4c, 0x16, 0xad, 0x03, 0x5a, 0xdc,
+	0xa7, 0x37, 0xd0, 0xda, 0xe5, 0x5c, 0x32, 0xa2, 0xdd, 0x19, 0xf4, 0x47, 0x2c, 0x22, 0xbf, 0x99,
+	0xf9, 0xea, 0x87, 0xca, 0xd2, 0xc3, 0x29, 0x94, 0x16, 0x1f, 0x71, 0x68, 0x1b, 0xee, 0xea, 0xda,
+	0xb3, 0x8e, 0xf6, 0xb1, 0xd1, 0xd6, 0xf6, 0x3b, 0x83, 0x4e, 0xaf, 0x6b, 0x8c, 0xba, 0x83, 0xbe,
+	0xb6, 0xdf, 0x39, 0xe8, 0xf0, 0x42, 0xef, 0xc2, 0x46, 0xd2, 0x41, 0xeb, 0xb6, 0x7b, 0xfa, 0x40,
+	0x2b, 0x2b, 0x68, 0x13, 0xd6, 0x93, 0x46, 0x91, 0x65, 0x39, 0x25, 0x77, 0xfc, 0x5e, 0x81, 0x9b,
+	0x17, 0x5e, 0x45, 0xa8, 0x06, 0x15, 0x99, 0x9b, 0xa1, 0x6b, 0x83, 0xde, 0x93, 0xd1, 0xf0, 0xe2,
+	0xc6, 0x5b, 0x70, 0xe7, 0x12, 0x9f, 0x7e, 0xeb, 0x93, 0xde, 0x68, 0x58, 0x56, 0xae, 0x30, 0xeb,
+	0xda, 0xc1, 0xa8, 0xcb, 0x00, 0xbf, 0x07, 0xea, 0x25, 0xe6, 0x41, 0xff, 0x49, 0x67, 0x58, 0x4e,
+	0xcb, 0xdc, 0xbe, 0x80, 0x9b, 0x17, 0x2e, 0x5b, 0x54, 0x81, 0x4d, 0x7e, 0x3a, 0x03, 0x5e, 0x4d,
+	0x6f, 0x34, 0xdc, 0xef, 0x3d, 0xd5, 0x8c, 0xbe, 0xd6, 0x6d, 0x77, 0xba, 0x1f, 0x94, 0x97, 0x18,
+	0x60, 0x97, 0xd8, 0xa3, 0x53, 0x53, 0xae, 0x70, 0x88, 0x0e, 0x4f, 0x02, 0xb3, 0xb7, 0xf3, 0xe2,
+	0x55, 0x45, 0x79, 0xf9, 0xaa, 0xa2, 0xfc, 0xf1, 0xaa, 0xa2, 0x7c, 0xfb, 0xba, 0xb2, 0xf4, 0xf2,
+	0x75, 0x65, 0xe9, 0x97, 0xd7, 0x95, 0xa5, 0x4f, 0x37, 0x62, 0x7f, 0xc9, 0xcf, 0xc5, 0x9f, 0x72,
+	0x3e, 0x9d, 0xc7, 0x39, 0xfe, 0x4f, 0xfa, 0x7f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x82, 0xef,
+	0x37, 0x31, 0xb4, 0x0f, 0x00, 0x00,
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	if m.RejectedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.RejectedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	l = len(m.Direction)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	if m.SubmittedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ClaimedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ClaimedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Unescrowed {
+		i--
+		if m.Unescrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.FeeAllowance) > 0 {
+		for iNdEx := len(m.FeeAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Reviewers) > 0 {
+		for iNdEx := len(m.Reviewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reviewers[iNdEx])
+			copy(dAtA[i:], m.Reviewers[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.Reviewers[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.SubmissionDeadline != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.SubmissionDeadline))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClaimDeadline != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ClaimDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TaskExpiry != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskExpiry))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decision != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CreatorAmount) > 0 {
+		for iNdEx := len(m.CreatorAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ClaimantAmount) > 0 {
+		for iNdEx := len(m.ClaimantAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimantAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Resolution != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Submission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Submission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReviewedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ReviewedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ReviewNote) > 0 {
+		i -= len(m.ReviewNote)
+		copy(dAtA[i:], m.ReviewNote)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ReviewNote)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubmittedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MinBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskSort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskSort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.To != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTask(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTask(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTask(uint64(m.Status))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTask(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTask(uint64(m.UpdatedAt))
+	}
+	if m.TaskExpiry != 0 {
+		n += 1 + sovTask(uint64(m.TaskExpiry))
+	}
+	if m.ClaimDeadline != 0 {
+		n += 1 + sovTask(uint64(m.ClaimDeadline))
+	}
+	if m.SubmissionDeadline != 0 {
+		n += 1 + sovTask(uint64(m.SubmissionDeadline))
+	}
+	if len(m.Reviewers) > 0 {
+		for _, s := range m.Reviewers {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Attempt != 0 {
+		n += 2 + sovTask(uint64(m.Attempt))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.FeeAllowance) > 0 {
+		for _, e := range m.FeeAllowance {
+			l = e.Size()
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Unescrowed {
+		n += 3
+	}
+	if m.ClaimedAt != 0 {
+		n += 2 + sovTask(uint64(m.ClaimedAt))
+	}
+	if m.SubmittedAt != 0 {
+		n += 2 + sovTask(uint64(m.SubmittedAt))
+	}
+	if m.RejectedAt != 0 {
+		n += 2 + sovTask(uint64(m.RejectedAt))
+	}
+	return n
+}
+
+func (m *TaskReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovTask(uint64(m.Decision))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTask(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *TaskDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovTask(uint64(m.Seq))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTask(uint64(m.CreatedAt))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovTask(uint64(m.Resolution))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.ClaimantAmount) > 0 {
+		for _, e := range m.ClaimantAmount {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.CreatorAmount) > 0 {
+		for _, e := range m.CreatorAmount {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovTask(uint64(m.ResolvedAt))
+	}
+	return n
+}
+
+func (m *Submission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTask(uint64(m.Attempt))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTask(uint64(l))
+	if m.SubmittedAt != 0 {
+		n += 1 + sovTask(uint64(m.SubmittedAt))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTask(uint64(m.BlockHeight))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTask(uint64(m.Outcome))
+	}
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.ReviewNote)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.ReviewedAt != 0 {
+		n += 1 + sovTask(uint64(m.ReviewedAt))
+	}
+	return n
+}
+
+func (m *TaskProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTask(uint64(m.Timestamp))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTask(uint64(m.Timestamp))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTask(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TaskRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTask(uint64(m.Timestamp))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTask(uint64(m.BlockHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTask(uint64(m.Status))
+	}
+	l = m.MinBounty.Size()
+	n += 1 + l + sovTask(uint64(l))
+	l = m.MaxBounty.Size()
+	n += 1 + l + sovTask(uint64(l))
+	return n
+}
+
+func (m *TaskSort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovTask(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTask(uint64(m.To))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTask(uint64(m.TaskId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovTask(uint64(m.Seq))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTask(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTask(uint64(m.Timestamp))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func sovTask(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTask(x uint64) (n int) {
+	return sovTask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskExpiry", wireType)
+			}
+			m.TaskExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeadline", wireType)
+			}
+			m.ClaimDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionDeadline", wireType)
+			}
+			m.SubmissionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewers = append(m.Reviewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = append(m.FeeAllowance, types.Coin{})
+			if err := m.FeeAllowance[len(m.FeeAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unescrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unescrowed = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAt", wireType)
+			}
+			m.ClaimedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
+			}
+			m.RejectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= ReviewDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= DisputeResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimantAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimantAmount = append(m.ClaimantAmount, types.Coin{})
+			if err := m.ClaimantAmount[len(m.ClaimantAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAmount = append(m.CreatorAmount, types.Coin{})
+			if err := m.CreatorAmount[len(m.CreatorAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TaskTransition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.From != 0 {
-		n += 1 + sovTask(uint64(m.From))
-	}
-	if m.To != 0 {
-		n += 1 + sovTask(uint64(m.To))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTask(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTask(x uint64) (n int) {
-	return sovTask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Task) Unmarshal(dAtA []byte) error {
+func (m *Submission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,17 +3678,17 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+			return fmt.Errorf("proto: Submission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Submission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.Id = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1144,16 +3698,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var stringLen uint64
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1163,27 +3717,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTask
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1211,11 +3752,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1242,15 +3783,15 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
 			}
-			m.Status = 0
+			m.SubmittedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1260,14 +3801,52 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= TaskStatus(b&0x7F) << shift
+				m.SubmittedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= SubmissionOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,11 +3874,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimant = string(dAtA[iNdEx:postIndex])
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewNote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1327,11 +3906,80 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = string(dAtA[iNdEx:postIndex])
+			m.ReviewNote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+			}
+			m.ReviewedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1359,11 +4007,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1391,13 +4039,13 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.CreatedAt = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1407,16 +4055,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.UpdatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1426,11 +4074,24 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -1452,7 +4113,7 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskProof) Unmarshal(dAtA []byte) error {
+func (m *TaskReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,15 +4136,34 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,13 +4191,13 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -1527,25 +4207,27 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
@@ -1564,9 +4246,9 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1594,8 +4276,27 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -1617,7 +4318,7 @@ func (m *TaskProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskReward) Unmarshal(dAtA []byte) error {
+func (m *TaskRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,10 +4341,10 @@ func (m *TaskReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1667,7 +4368,7 @@ func (m *TaskReward) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1695,7 +4396,7 @@ func (m *TaskReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimant = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1726,7 +4427,8 @@ func (m *TaskReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1781,6 +4483,57 @@ func (m *TaskReward) Unmarshal(dAtA []byte) error {
 			}
 			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -2126,6 +4879,38 @@ func (m *TaskSort) Unmarshal(dAtA []byte) error {
 			}
 			m.Direction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -2214,6 +4999,146 @@ func (m *TaskTransition) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
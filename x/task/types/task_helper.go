@@ -1,105 +1,114 @@
 package types
 
 import (
-"fmt"
-"strings"
-"time"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
-"cosmossdk.io/math"
-sdk "github.com/cosmos/cosmos-sdk/types"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // converts a TaskStatus to its string representation
 func TaskStatusToString(status TaskStatus) string {
-switch status {
-case TASK_STATUS_UNDEFINED:
-return "undefined"
-case TASK_STATUS_OPEN:
-return "open"
-case TASK_STATUS_CLAIMED:
-return "claimed"
-case TASK_STATUS_SUBMITTED:
-return "submitted"
-case TASK_STATUS_APPROVED:
-return "approved"
-case TASK_STATUS_REJECTED:
-return "rejected"
-case TASK_STATUS_CLOSED:
-return "closed"
-default:
-return "unknown"
-}
+	switch status {
+	case TASK_STATUS_UNDEFINED:
+		return "undefined"
+	case TASK_STATUS_OPEN:
+		return "open"
+	case TASK_STATUS_CLAIMED:
+		return "claimed"
+	case TASK_STATUS_SUBMITTED:
+		return "submitted"
+	case TASK_STATUS_APPROVED:
+		return "approved"
+	case TASK_STATUS_REJECTED:
+		return "rejected"
+	case TASK_STATUS_CLOSED:
+		return "closed"
+	case TASK_STATUS_DISPUTED:
+		return "disputed"
+	default:
+		return "unknown"
+	}
 }
 
 // converts a string to a TaskStatus
 func StringToTaskStatus(status string) TaskStatus {
-switch strings.ToLower(status) {
-case "undefined":
-return TASK_STATUS_UNDEFINED
-case "open":
-return TASK_STATUS_OPEN
-case "claimed":
-return TASK_STATUS_CLAIMED
-case "submitted":
-return TASK_STATUS_SUBMITTED
-case "approved":
-return TASK_STATUS_APPROVED
-case "rejected":
-return TASK_STATUS_REJECTED
-case "closed":
-return TASK_STATUS_CLOSED
-default:
-return TASK_STATUS_UNDEFINED
-}
+	switch strings.ToLower(status) {
+	case "undefined":
+		return TASK_STATUS_UNDEFINED
+	case "open":
+		return TASK_STATUS_OPEN
+	case "claimed":
+		return TASK_STATUS_CLAIMED
+	case "submitted":
+		return TASK_STATUS_SUBMITTED
+	case "approved":
+		return TASK_STATUS_APPROVED
+	case "rejected":
+		return TASK_STATUS_REJECTED
+	case "closed":
+		return TASK_STATUS_CLOSED
+	case "disputed":
+		return TASK_STATUS_DISPUTED
+	default:
+		return TASK_STATUS_UNDEFINED
+	}
 }
 
 // checks if the given status is a valid TaskStatus
 func IsValidTaskStatus(status TaskStatus) bool {
-return status >= TASK_STATUS_UNDEFINED && status <= TASK_STATUS_CLOSED
+	return status >= TASK_STATUS_UNDEFINED && status <= TASK_STATUS_DISPUTED
 }
 
 // list of valid status transitions
 func GetValidTransitions() []TaskTransition {
-return []TaskTransition{
-{From: TASK_STATUS_UNDEFINED, To: TASK_STATUS_OPEN},
-{From: TASK_STATUS_OPEN, To: TASK_STATUS_CLAIMED},
-{From: TASK_STATUS_OPEN, To: TASK_STATUS_CLOSED},
-{From: TASK_STATUS_CLAIMED, To: TASK_STATUS_SUBMITTED},
-{From: TASK_STATUS_CLAIMED, To: TASK_STATUS_OPEN}, // -> Revert claim
-{From: TASK_STATUS_SUBMITTED, To: TASK_STATUS_APPROVED},
-{From: TASK_STATUS_SUBMITTED, To: TASK_STATUS_REJECTED},
-{From: TASK_STATUS_REJECTED, To: TASK_STATUS_CLAIMED}, // when resubmited
-{From: TASK_STATUS_REJECTED, To: TASK_STATUS_OPEN},    // when reopened
-{From: TASK_STATUS_APPROVED, To: TASK_STATUS_CLOSED}, // when closed after approval
-}
+	return []TaskTransition{
+		{From: TASK_STATUS_UNDEFINED, To: TASK_STATUS_OPEN},
+		{From: TASK_STATUS_OPEN, To: TASK_STATUS_CLAIMED},
+		{From: TASK_STATUS_OPEN, To: TASK_STATUS_CLOSED},
+		{From: TASK_STATUS_CLAIMED, To: TASK_STATUS_SUBMITTED},
+		{From: TASK_STATUS_CLAIMED, To: TASK_STATUS_OPEN}, // -> Revert claim
+		{From: TASK_STATUS_SUBMITTED, To: TASK_STATUS_APPROVED},
+		{From: TASK_STATUS_SUBMITTED, To: TASK_STATUS_REJECTED},
+		{From: TASK_STATUS_REJECTED, To: TASK_STATUS_CLAIMED},  // when resubmited
+		{From: TASK_STATUS_REJECTED, To: TASK_STATUS_OPEN},     // when reopened
+		{From: TASK_STATUS_APPROVED, To: TASK_STATUS_CLOSED},   // when closed after approval
+		{From: TASK_STATUS_REJECTED, To: TASK_STATUS_DISPUTED}, // when the claimant disputes the rejection
+		{From: TASK_STATUS_DISPUTED, To: TASK_STATUS_APPROVED}, // when an arbiter pays out the claimant
+		{From: TASK_STATUS_DISPUTED, To: TASK_STATUS_CLOSED},   // when an arbiter refunds or splits the bounty
+	}
 }
 
 func IsValidTransition(from, to TaskStatus) bool {
-for _, transition := range GetValidTransitions() {
-if transition.From == from && transition.To == to {
-return true
-}
-}
-return false
+	for _, transition := range GetValidTransitions() {
+		if transition.From == from && transition.To == to {
+			return true
+		}
+	}
+	return false
 }
 
 func GetTransitionsFrom(status TaskStatus) []TaskStatus {
-var transitions []TaskStatus
-for _, transition := range GetValidTransitions() {
-if transition.From == status {
-transitions = append(transitions, transition.To)
-}
-}
-return transitions
+	var transitions []TaskStatus
+	for _, transition := range GetValidTransitions() {
+		if transition.From == status {
+			transitions = append(transitions, transition.To)
+		}
+	}
+	return transitions
 }
 
 func (t Task) Validate(params Params) error {
-if strings.TrimSpace(t.Title) == "" {
-return fmt.Errorf("title cannot be empty")
-}
-if uint32(len(t.Title)) > params.MaxTitleLength {
-return fmt.Errorf("title exceeds maximum length of %d", params.MaxTitleLength)
-}
+	if strings.TrimSpace(t.Title) == "" {
+		return fmt.Errorf("title cannot be empty")
+	}
+	if uint32(len(t.Title)) > params.MaxTitleLength {
+		return fmt.Errorf("title exceeds maximum length of %d", params.MaxTitleLength)
+	}
 
 	if strings.TrimSpace(t.Description) == "" {
 		return fmt.Errorf("description cannot be empty")
@@ -108,20 +117,8 @@ return fmt.Errorf("title exceeds maximum length of %d", params.MaxTitleLength)
 		return fmt.Errorf("description exceeds maximum length of %d", params.MaxDescriptionLength)
 	}
 
-	if t.Bounty.IsZero() {
-		return fmt.Errorf("bounty cannot be zero")
-	}
-	if t.Bounty.IsNegative() {
-		return fmt.Errorf("bounty cannot be negative")
-	}
-	if t.Bounty.Amount.LT(params.MinBounty.Amount) {
-		return fmt.Errorf("bounty amount is below minimum of %s", params.MinBounty.String())
-	}
-	if !params.MaxBounty.IsZero() && t.Bounty.Amount.GT(params.MaxBounty.Amount) {
-		return fmt.Errorf("bounty amount exceeds maximum of %s", params.MaxBounty.String())
-	}
-	if t.Bounty.Denom != params.MinBounty.Denom {
-		return fmt.Errorf("bounty denom must be %s", params.MinBounty.Denom)
+	if err := ValidateBounty(t.Bounty, params); err != nil {
+		return err
 	}
 	if !IsValidTaskStatus(t.Status) {
 		return fmt.Errorf("invalid task status: %s", TaskStatusToString(t.Status))
@@ -152,6 +149,31 @@ return fmt.Errorf("title exceeds maximum length of %d", params.MaxTitleLength)
 	return nil
 }
 
+// ValidateBounty checks that bounty is made of positive coins of allowed
+// denoms, each within the bounds Params sets for its denom.
+func ValidateBounty(bounty sdk.Coins, params Params) error {
+	if bounty.Empty() {
+		return fmt.Errorf("bounty cannot be zero")
+	}
+	if err := bounty.Validate(); err != nil {
+		return fmt.Errorf("invalid bounty: %s", err)
+	}
+	for _, coin := range bounty {
+		minBounty, maxBounty, ok := params.BountyLimits(coin.Denom)
+		if !ok {
+			return fmt.Errorf("bounty denom %s is not allowed", coin.Denom)
+		}
+		if coin.Amount.LT(minBounty.Amount) {
+			return fmt.Errorf("bounty amount %s is below minimum of %s", coin, minBounty)
+		}
+		if coin.Amount.GT(maxBounty.Amount) {
+			return fmt.Errorf("bounty amount %s exceeds maximum of %s", coin, maxBounty)
+		}
+	}
+
+	return nil
+}
+
 func (t Task) CanClaim(claimant string) error {
 	if t.Status != TASK_STATUS_OPEN {
 		return fmt.Errorf("task is not open for claiming")
@@ -159,6 +181,9 @@ func (t Task) CanClaim(claimant string) error {
 	if t.Creator == claimant {
 		return fmt.Errorf("creator cannot claim their own task")
 	}
+	if t.IsApprover(claimant) {
+		return fmt.Errorf("approvers cannot claim the task they approve")
+	}
 	if strings.TrimSpace(t.Claimant) != "" {
 		return fmt.Errorf("task is already claimed by %s", t.Claimant)
 	}
@@ -166,9 +191,11 @@ func (t Task) CanClaim(claimant string) error {
 	return nil
 }
 
+// CanSubmit checks that claimer can submit a proof for the task, either for
+// their claim or as a resubmission after a rejection.
 func (t Task) CanSubmit(claimer string) error {
-	if t.Status != TASK_STATUS_CLAIMED {
-		return fmt.Errorf("task is not in claimed status")
+	if t.Status != TASK_STATUS_CLAIMED && t.Status != TASK_STATUS_REJECTED {
+		return fmt.Errorf("task is not in claimed or rejected status")
 	}
 	if t.Claimant != claimer {
 		return fmt.Errorf("only the current claimant can submit the task")
@@ -177,162 +204,602 @@ func (t Task) CanSubmit(claimer string) error {
 	return nil
 }
 
+// CanApprove checks that approver, the creator or a nominated approver, can
+// approve the submitted task.
 func (t Task) CanApprove(approver string) error {
 	if t.Status != TASK_STATUS_SUBMITTED {
 		return fmt.Errorf("task is not in submitted status")
 	}
-	if t.Creator != approver {
-		return fmt.Errorf("only the creator can approve the task")
+	if t.Creator != approver && !t.IsApprover(approver) {
+		return fmt.Errorf("only the creator or an approver can approve the task")
 	}
 
 	return nil
 }
 
+// CanReject checks that rejecter, the creator or a nominated approver, can
+// reject the submitted task.
 func (t Task) CanReject(rejecter string) error {
 	if t.Status != TASK_STATUS_SUBMITTED {
 		return fmt.Errorf("task is not in submitted status")
 	}
-	if t.Creator != rejecter {
-		return fmt.Errorf("only the creator can reject the task")
+	if t.Creator != rejecter && !t.IsApprover(rejecter) {
+		return fmt.Errorf("only the creator or an approver can reject the task")
 	}
 
 	return nil
 }
 
-func (t Task) IsExpired(params Params, currentTime time.Time) bool {
-	if params.TaskExpiry == 0 {
-		return false
+// ValidateApprovers checks the approvers nominated for a task. Approvers must
+// be distinct and cannot include the creator, who can always approve, or the
+// claimant.
+func (t Task) ValidateApprovers() error {
+	seen := make(map[string]bool, len(t.Approvers))
+	for _, approver := range t.Approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return fmt.Errorf("invalid approver address %s: %s", approver, err)
+		}
+		if approver == t.Creator {
+			return fmt.Errorf("creator cannot be a nominated approver")
+		}
+		if approver == t.Claimant {
+			return fmt.Errorf("claimant cannot approve their own submission")
+		}
+		if seen[approver] {
+			return fmt.Errorf("duplicate approver %s", approver)
+		}
+		seen[approver] = true
 	}
 
-	expiryTime := time.Unix(t.CreatedAt, 0).Add(time.Duration(params.TaskExpiry) * time.Second)
-	return currentTime.After(expiryTime)
+	return nil
 }
 
-func (t Task) IsClaimExpired(params Params, currentTime time.Time) bool {
-	if params.ClaimDeadline == 0 || t.Claimant == "" {
-		return false
+// IsApprover reports whether addr is one of the task's nominated approvers.
+func (t Task) IsApprover(addr string) bool {
+	for _, approver := range t.Approvers {
+		if approver == addr {
+			return true
+		}
 	}
-	claimTime := time.Unix(t.UpdatedAt, 0)
-	expiryTime := claimTime.Add(time.Duration(params.ClaimDeadline) * time.Second)
-	return currentTime.After(expiryTime)
+	return false
 }
 
-func (t Task) IsSubmissionExpired(params Params, currentTime time.Time) bool {
-	if params.SubmissionDeadline == 0 || t.Claimant == "" {
-		return false
+// CanSetApprovers checks that the creator can change the approvers of the
+// task, which is only possible while it is open.
+func (t Task) CanSetApprovers(creator string) error {
+	if t.Status != TASK_STATUS_OPEN {
+		return fmt.Errorf("approvers can only be changed while the task is open")
+	}
+	if t.Creator != creator {
+		return fmt.Errorf("only the creator can change the approvers of the task")
 	}
-	claimTime := time.Unix(t.UpdatedAt, 0)
-	expiryTime := claimTime.Add(time.Duration(params.SubmissionDeadline) * time.Second)
-	return currentTime.After(expiryTime)
+
+	return nil
 }
 
-func FilterTasks(tasks []Task, filter TaskFilter) []Task {
-	var filteredTasks []Task
+// ValidateFeeAllowance checks the fees the creator sponsors for the claimant,
+// which are optional.
+func (t Task) ValidateFeeAllowance() error {
+	if len(t.FeeAllowance) == 0 {
+		return nil
+	}
+	if err := t.FeeAllowance.Validate(); err != nil {
+		return fmt.Errorf("invalid fee allowance: %s", err)
+	}
 
-	for _, task := range tasks {
-		if filter.Creator != "" && task.Creator != filter.Creator {
-			continue
+	return nil
+}
+
+// ValidateMaxBounty checks the bounty of the task against the max bounty a
+// message accepts, which bounds nothing when empty.
+func (t Task) ValidateMaxBounty(maxBounty sdk.Coins) error {
+	if maxBounty.Empty() {
+		return nil
+	}
+	if !t.Bounty.IsAllLTE(maxBounty) {
+		return fmt.Errorf("bounty %s of task %d exceeds the max bounty %s", t.Bounty, t.Id, maxBounty)
+	}
+
+	return nil
+}
+
+// ValidateReviewers checks the reviewers nominated for a task. Reviewers must be
+// distinct, cannot include the creator and, when set, must be able to reach
+// the auto approve threshold.
+func (t Task) ValidateReviewers(params Params) error {
+	if len(t.Reviewers) == 0 {
+		return nil
+	}
+	if uint32(len(t.Reviewers)) < params.AutoApproveThreshold {
+		return fmt.Errorf("at least %d reviewers are required to reach the auto approve threshold", params.AutoApproveThreshold)
+	}
+
+	seen := make(map[string]bool, len(t.Reviewers))
+	for _, reviewer := range t.Reviewers {
+		if _, err := sdk.AccAddressFromBech32(reviewer); err != nil {
+			return fmt.Errorf("invalid reviewer address %s: %s", reviewer, err)
 		}
-		if filter.Claimant != "" && task.Claimant != filter.Claimant {
-			continue
+		if reviewer == t.Creator {
+			return fmt.Errorf("creator cannot be a reviewer")
 		}
-		if filter.Approver != "" && task.Approver != filter.Approver {
-			continue
+		if seen[reviewer] {
+			return fmt.Errorf("duplicate reviewer %s", reviewer)
 		}
-		if filter.Status != TASK_STATUS_UNDEFINED && task.Status != filter.Status {
-			continue
+		seen[reviewer] = true
+	}
+
+	return nil
+}
+
+// IsReviewer reports whether addr is one of the task's reviewers.
+func (t Task) IsReviewer(addr string) bool {
+	for _, reviewer := range t.Reviewers {
+		if reviewer == addr {
+			return true
 		}
-		if !filter.MinBounty.IsZero() && task.Bounty.Amount.LT(filter.MinBounty.Amount) {
-			continue
+	}
+	return false
+}
+
+func (t Task) CanReview(reviewer string) error {
+	if t.Status != TASK_STATUS_SUBMITTED {
+		return fmt.Errorf("task is not in submitted status")
+	}
+	if !t.IsReviewer(reviewer) {
+		return fmt.Errorf("%s is not a reviewer of the task", reviewer)
+	}
+	if t.Claimant == reviewer {
+		return fmt.Errorf("claimant cannot review their own submission")
+	}
+
+	return nil
+}
+
+func (t Task) CanDispute(claimant string) error {
+	if t.Status != TASK_STATUS_REJECTED {
+		return fmt.Errorf("only rejected tasks can be disputed")
+	}
+	if t.Claimant != claimant {
+		return fmt.Errorf("only the claimant can dispute the rejection")
+	}
+
+	return nil
+}
+
+// CanUnclaim checks that the claimant can release a claimed task back to OPEN.
+func (t Task) CanUnclaim(claimant string) error {
+	if t.Status != TASK_STATUS_CLAIMED {
+		return fmt.Errorf("only claimed tasks can be unclaimed")
+	}
+	if t.Claimant != claimant {
+		return fmt.Errorf("only the claimant can unclaim the task")
+	}
+
+	return nil
+}
+
+// CanReopen checks that the creator can reopen a rejected task for new claims.
+func (t Task) CanReopen(creator string) error {
+	if t.Status != TASK_STATUS_REJECTED {
+		return fmt.Errorf("only rejected tasks can be reopened")
+	}
+	if t.Creator != creator {
+		return fmt.Errorf("only the creator can reopen the task")
+	}
+
+	return nil
+}
+
+// CanClose checks that the creator can cancel an open task or finalize an
+// approved one.
+func (t Task) CanClose(creator string) error {
+	if t.Status != TASK_STATUS_OPEN && t.Status != TASK_STATUS_APPROVED {
+		return fmt.Errorf("only open or approved tasks can be closed")
+	}
+	if t.Creator != creator {
+		return fmt.Errorf("only the creator can close the task")
+	}
+
+	return nil
+}
+
+// IsArbiter reports whether addr is one of the arbiters in params.
+func (p Params) IsArbiter(addr string) bool {
+	for _, arbiter := range p.Arbiters {
+		if arbiter == addr {
+			return true
 		}
-		if !filter.MaxBounty.IsZero() && task.Bounty.Amount.GT(filter.MaxBounty.Amount) {
+	}
+	return false
+}
+
+// converts a string to a DisputeResolution
+func StringToDisputeResolution(resolution string) DisputeResolution {
+	switch strings.ToLower(resolution) {
+	case "payout":
+		return DISPUTE_RESOLUTION_PAYOUT
+	case "refund":
+		return DISPUTE_RESOLUTION_REFUND
+	case "split":
+		return DISPUTE_RESOLUTION_SPLIT
+	default:
+		return DISPUTE_RESOLUTION_UNSPECIFIED
+	}
+}
+
+// converts a DisputeResolution to its string representation
+func DisputeResolutionToString(resolution DisputeResolution) string {
+	switch resolution {
+	case DISPUTE_RESOLUTION_PAYOUT:
+		return "payout"
+	case DISPUTE_RESOLUTION_REFUND:
+		return "refund"
+	case DISPUTE_RESOLUTION_SPLIT:
+		return "split"
+	default:
+		return "unspecified"
+	}
+}
+
+// converts a string to a ReviewDecision
+func StringToReviewDecision(decision string) ReviewDecision {
+	switch strings.ToLower(decision) {
+	case "endorse":
+		return REVIEW_DECISION_ENDORSE
+	case "reject":
+		return REVIEW_DECISION_REJECT
+	default:
+		return REVIEW_DECISION_UNSPECIFIED
+	}
+}
+
+// converts a ReviewDecision to its string representation
+func ReviewDecisionToString(decision ReviewDecision) string {
+	switch decision {
+	case REVIEW_DECISION_ENDORSE:
+		return "endorse"
+	case REVIEW_DECISION_REJECT:
+		return "reject"
+	default:
+		return "unspecified"
+	}
+}
+
+// ParseTaskProof parses the proof stored on a task by SubmitTask, formatted as
+// hash:type:timestamp with an optional :data suffix. The hash and data may
+// themselves contain colons (e.g. URLs), so the timestamp is taken to be the
+// first integer segment after the hash and type.
+func ParseTaskProof(proof string) (TaskProof, error) {
+	parts := strings.Split(proof, ":")
+	for i := 2; i < len(parts); i++ {
+		timestamp, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
 			continue
 		}
 
-		filteredTasks = append(filteredTasks, task)
+		return TaskProof{
+			Hash:      strings.Join(parts[:i-1], ":"),
+			Type:      parts[i-1],
+			Timestamp: timestamp,
+			Data:      strings.Join(parts[i+1:], ":"),
+		}, nil
 	}
 
-	return filteredTasks
+	return TaskProof{}, fmt.Errorf("malformed task proof %q", proof)
 }
 
-func SortTasks(tasks []Task, sort TaskSort) []Task {
-	sortedTasks := make([]Task, len(tasks))
-	copy(sortedTasks, tasks)
+// DeadlineKind identifies which deadline of a task an entry of the keeper's
+// deadline queue enforces.
+type DeadlineKind int32
 
-	switch sort.Field {
-	case "id":
-		if sort.Direction == "desc" {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Id < sortedTasks[j].Id {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
-		} else {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Id > sortedTasks[j].Id {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
+const (
+	// DeadlineTaskExpiry closes an open task that nobody claimed in time.
+	DeadlineTaskExpiry DeadlineKind = iota + 1
+	// DeadlineClaim returns a claimed task to OPEN when no proof was submitted in time.
+	DeadlineClaim
+	// DeadlineSubmission applies the review timeout action to an unreviewed submission.
+	DeadlineSubmission
+)
+
+// EffectiveTaskExpiry returns the task's own expiry, or the Params default when none was set.
+func (t Task) EffectiveTaskExpiry(params Params) uint64 {
+	if t.TaskExpiry != 0 {
+		return t.TaskExpiry
+	}
+	return params.TaskExpiry
+}
+
+// EffectiveClaimDeadline returns the task's own claim window, or the Params default when none was set.
+func (t Task) EffectiveClaimDeadline(params Params) uint64 {
+	if t.ClaimDeadline != 0 {
+		return t.ClaimDeadline
+	}
+	return params.ClaimDeadline
+}
+
+// EffectiveSubmissionDeadline returns the task's own review window, or the Params default when none was set.
+func (t Task) EffectiveSubmissionDeadline(params Params) uint64 {
+	if t.SubmissionDeadline != 0 {
+		return t.SubmissionDeadline
+	}
+	return params.SubmissionDeadline
+}
+
+// ValidateDeadlines checks the per-task deadlines against the bounds in Params.
+// Unset deadlines fall back to the Params defaults and are not checked.
+func (t Task) ValidateDeadlines(params Params) error {
+	if t.TaskExpiry != 0 && !deadlineWithinBounds(t.TaskExpiry, params.MinTaskExpiry, params.MaxTaskExpiry) {
+		return fmt.Errorf("task expiry must be between %d and %d seconds", params.MinTaskExpiry, params.MaxTaskExpiry)
+	}
+	if t.ClaimDeadline != 0 && !deadlineWithinBounds(t.ClaimDeadline, params.MinClaimDeadline, params.MaxClaimDeadline) {
+		return fmt.Errorf("claim deadline must be between %d and %d seconds", params.MinClaimDeadline, params.MaxClaimDeadline)
+	}
+	if t.SubmissionDeadline != 0 && !deadlineWithinBounds(t.SubmissionDeadline, params.MinSubmissionDeadline, params.MaxSubmissionDeadline) {
+		return fmt.Errorf("submission deadline must be between %d and %d seconds", params.MinSubmissionDeadline, params.MaxSubmissionDeadline)
+	}
+
+	return nil
+}
+
+// ExpiresAt returns when an open task expires. ok is false when task expiry is disabled.
+func (t Task) ExpiresAt(params Params) (time.Time, bool) {
+	expiry := t.EffectiveTaskExpiry(params)
+	if expiry == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(t.CreatedAt, 0).Add(time.Duration(expiry) * time.Second), true
+}
+
+// ClaimExpiresAt returns when the current claim on the task expires. ok is false
+// when the task is not claimed or claim deadlines are disabled.
+func (t Task) ClaimExpiresAt(params Params) (time.Time, bool) {
+	deadline := t.EffectiveClaimDeadline(params)
+	if deadline == 0 || t.Claimant == "" {
+		return time.Time{}, false
+	}
+
+	return time.Unix(since(t.ClaimedAt, t.UpdatedAt), 0).Add(time.Duration(deadline) * time.Second), true
+}
+
+// SubmissionExpiresAt returns when the review window of the current submission
+// ends. ok is false when the task has no claimant or submission deadlines are disabled.
+func (t Task) SubmissionExpiresAt(params Params) (time.Time, bool) {
+	deadline := t.EffectiveSubmissionDeadline(params)
+	if deadline == 0 || t.Claimant == "" {
+		return time.Time{}, false
+	}
+
+	return time.Unix(since(t.SubmittedAt, t.UpdatedAt), 0).Add(time.Duration(deadline) * time.Second), true
+}
+
+// DisputeWindowEndsAt returns when the claimant can no longer dispute the
+// rejection of the task, and the creator can reopen it.
+func (t Task) DisputeWindowEndsAt(params Params) time.Time {
+	return time.Unix(since(t.RejectedAt, t.UpdatedAt), 0).Add(time.Duration(params.DisputeWindow) * time.Second)
+}
+
+// IsDisputeWindowOpen reports whether the rejection of the task can still be
+// disputed at currentTime.
+func (t Task) IsDisputeWindowOpen(params Params, currentTime time.Time) bool {
+	return !currentTime.After(t.DisputeWindowEndsAt(params))
+}
+
+// since returns the time a deadline runs from. Tasks claimed, submitted or
+// rejected before ClaimedAt, SubmittedAt and RejectedAt were recorded fall
+// back to UpdatedAt, updates of the task do not move it.
+func since(at, updatedAt int64) int64 {
+	if at == 0 {
+		return updatedAt
+	}
+	return at
+}
+
+// DeadlineAt returns the deadline of the given kind for the task.
+func (t Task) DeadlineAt(params Params, kind DeadlineKind) (time.Time, bool) {
+	switch kind {
+	case DeadlineTaskExpiry:
+		return t.ExpiresAt(params)
+	case DeadlineClaim:
+		return t.ClaimExpiresAt(params)
+	case DeadlineSubmission:
+		return t.SubmissionExpiresAt(params)
+	default:
+		return time.Time{}, false
+	}
+}
+
+// PendingDeadline returns the deadline kind that applies to the task in its
+// current status. ok is false when no deadline applies.
+func (t Task) PendingDeadline() (DeadlineKind, bool) {
+	switch t.Status {
+	case TASK_STATUS_OPEN:
+		return DeadlineTaskExpiry, true
+	case TASK_STATUS_CLAIMED:
+		return DeadlineClaim, true
+	case TASK_STATUS_SUBMITTED:
+		return DeadlineSubmission, true
+	default:
+		return 0, false
+	}
+}
+
+func (t Task) IsExpired(params Params, currentTime time.Time) bool {
+	expiryTime, ok := t.ExpiresAt(params)
+	return ok && currentTime.After(expiryTime)
+}
+
+func (t Task) IsClaimExpired(params Params, currentTime time.Time) bool {
+	expiryTime, ok := t.ClaimExpiresAt(params)
+	return ok && currentTime.After(expiryTime)
+}
+
+func (t Task) IsSubmissionExpired(params Params, currentTime time.Time) bool {
+	expiryTime, ok := t.SubmissionExpiresAt(params)
+	return ok && currentTime.After(expiryTime)
+}
+
+// TaskSort fields and directions accepted by ListTask.
+const (
+	SortFieldID        = "id"
+	SortFieldBounty    = "bounty"
+	SortFieldStatus    = "status"
+	SortFieldCreatedAt = "created_at"
+
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Validate checks the addresses, status and bounty range of the filter.
+func (f TaskFilter) Validate() error {
+	for _, addr := range []string{f.Creator, f.Claimant, f.Approver} {
+		if addr == "" {
+			continue
 		}
-	case "bounty":
-		if sort.Direction == "desc" {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Bounty.Amount.LT(sortedTasks[j].Bounty.Amount) {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
-		} else {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Bounty.Amount.GT(sortedTasks[j].Bounty.Amount) {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address %s in filter: %s", addr, err)
 		}
-	case "status":
-		if sort.Direction == "desc" {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Status < sortedTasks[j].Status {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
-		} else {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].Status > sortedTasks[j].Status {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
+	}
+	if _, ok := TaskStatus_name[int32(f.Status)]; !ok {
+		return fmt.Errorf("invalid task status %d", f.Status)
+	}
+	if hasAmount(f.MinBounty) && f.MinBounty.IsNegative() {
+		return fmt.Errorf("min bounty cannot be negative")
+	}
+	if hasAmount(f.MaxBounty) && f.MaxBounty.IsNegative() {
+		return fmt.Errorf("max bounty cannot be negative")
+	}
+	if denom := f.BountyDenom(); denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid bounty denom %s in filter: %s", denom, err)
 		}
-	case "created_at":
-		if sort.Direction == "desc" {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].CreatedAt < sortedTasks[j].CreatedAt {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
-			}
-		} else {
-			for i := 0; i < len(sortedTasks); i++ {
-				for j := i + 1; j < len(sortedTasks); j++ {
-					if sortedTasks[i].CreatedAt > sortedTasks[j].CreatedAt {
-						sortedTasks[i], sortedTasks[j] = sortedTasks[j], sortedTasks[i]
-					}
-				}
+		for _, bound := range []sdk.Coin{f.MinBounty, f.MaxBounty} {
+			if hasAmount(bound) && !bound.IsZero() && bound.Denom != denom {
+				return fmt.Errorf("min bounty and max bounty must be in the same denom")
 			}
 		}
 	}
+	if hasAmount(f.MinBounty) && hasAmount(f.MaxBounty) && !f.MaxBounty.IsZero() && f.MinBounty.Amount.GT(f.MaxBounty.Amount) {
+		return fmt.Errorf("min bounty cannot be greater than max bounty")
+	}
+
+	return nil
+}
+
+// Matches reports whether the task matches every set field of the filter.
+// An approver matches the approvers nominated on the task as well as the one
+// that approved it. The bounty range compares the amount of the bounty in the denom of its
+// bounds, so only tasks with a bounty in that denom match it.
+func (f TaskFilter) Matches(task Task) bool {
+	if f.Creator != "" && task.Creator != f.Creator {
+		return false
+	}
+	if f.Claimant != "" && task.Claimant != f.Claimant {
+		return false
+	}
+	if f.Approver != "" && task.Approver != f.Approver && !task.IsApprover(f.Approver) {
+		return false
+	}
+	if f.Status != TASK_STATUS_UNDEFINED && task.Status != f.Status {
+		return false
+	}
+	if hasAmount(f.MinBounty) && !f.MinBounty.IsZero() && task.Bounty.AmountOf(f.MinBounty.Denom).LT(f.MinBounty.Amount) {
+		return false
+	}
+	if hasAmount(f.MaxBounty) && !f.MaxBounty.IsZero() {
+		amount := task.Bounty.AmountOf(f.MaxBounty.Denom)
+		if !amount.IsPositive() || amount.GT(f.MaxBounty.Amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BountyDenom returns the denom of the bounty range of the filter, empty when
+// the range is unset.
+func (f TaskFilter) BountyDenom() string {
+	for _, bound := range []sdk.Coin{f.MinBounty, f.MaxBounty} {
+		if hasAmount(bound) && !bound.IsZero() {
+			return bound.Denom
+		}
+	}
+	return ""
+}
+
+func hasAmount(coin sdk.Coin) bool {
+	return !coin.Amount.IsNil()
+}
+
+// BountyIndexKey encodes a bounty amount as a fixed width big endian key, so
+// that keys sort in the same order as the amounts they encode.
+func BountyIndexKey(amount math.Int) []byte {
+	key := make([]byte, 32)
+	if amount.IsNil() || !amount.IsPositive() {
+		return key
+	}
+	return amount.BigIntMut().FillBytes(key)
+}
+
+// Validate checks the sort field and direction.
+func (s TaskSort) Validate() error {
+	switch s.Field {
+	case "", SortFieldID, SortFieldBounty, SortFieldStatus, SortFieldCreatedAt:
+	default:
+		return fmt.Errorf("invalid sort field %s", s.Field)
+	}
+	switch s.Direction {
+	case "", SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("invalid sort direction %s", s.Direction)
+	}
+	if s.Denom != "" {
+		if err := sdk.ValidateDenom(s.Denom); err != nil {
+			return fmt.Errorf("invalid sort denom %s: %w", s.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+func FilterTasks(tasks []Task, filter TaskFilter) []Task {
+	var filteredTasks []Task
+
+	for _, task := range tasks {
+		if filter.Matches(task) {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+
+	return filteredTasks
+}
+
+// SortTasks returns a sorted copy of tasks. Ties keep their original order.
+// Sorting by bounty compares the amounts of the bounties in the sort denom.
+func SortTasks(tasks []Task, by TaskSort) []Task {
+	sortedTasks := make([]Task, len(tasks))
+	copy(sortedTasks, tasks)
+
+	var less func(a, b Task) bool
+	switch by.Field {
+	case SortFieldID:
+		less = func(a, b Task) bool { return a.Id < b.Id }
+	case SortFieldBounty:
+		less = func(a, b Task) bool { return a.Bounty.AmountOf(by.Denom).LT(b.Bounty.AmountOf(by.Denom)) }
+	case SortFieldStatus:
+		less = func(a, b Task) bool { return a.Status < b.Status }
+	case SortFieldCreatedAt:
+		less = func(a, b Task) bool { return a.CreatedAt < b.CreatedAt }
+	default:
+		return sortedTasks
+	}
+
+	sort.SliceStable(sortedTasks, func(i, j int) bool {
+		if by.Direction == SortDesc {
+			return less(sortedTasks[j], sortedTasks[i])
+		}
+		return less(sortedTasks[i], sortedTasks[j])
+	})
 
 	return sortedTasks
 }
@@ -368,11 +835,11 @@ func (r TaskReward) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(r.Claimant); err != nil {
 		return fmt.Errorf("invalid claimant address: %s", err)
 	}
-	if r.Amount.IsZero() {
+	if r.Amount.Empty() {
 		return fmt.Errorf("reward amount cannot be zero")
 	}
-	if r.Amount.IsNegative() {
-		return fmt.Errorf("reward amount cannot be negative")
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid reward amount: %s", err)
 	}
 	if r.Timestamp <= 0 {
 		return fmt.Errorf("reward timestamp must be positive")
@@ -382,7 +849,7 @@ func (r TaskReward) Validate() error {
 }
 
 func DefaultParams() Params {
-	minBounty := sdk.NewCoin("stake", math.NewInt(1000)) // 1000 stake as minimum
+	minBounty := sdk.NewCoin("stake", math.NewInt(1000))    // 1000 stake as minimum
 	maxBounty := sdk.NewCoin("stake", math.NewInt(1000000)) // 1M stake as maximum
 
 	return Params{
@@ -392,19 +859,51 @@ func DefaultParams() Params {
 		MaxDescriptionLength:  1000,
 		ProofTypes:            []string{"ipfs", "url", "text"},
 		AutoApproveThreshold:  5,
-		TaskExpiry:            86400 * 30, 
-		ClaimDeadline:         86400 * 7,  
-		SubmissionDeadline:    86400 * 14, 
+		TaskExpiry:            86400 * 30,
+		ClaimDeadline:         86400 * 7,
+		SubmissionDeadline:    86400 * 14,
+		ReviewTimeoutAction:   REVIEW_TIMEOUT_ACTION_APPROVE,
+		MinTaskExpiry:         3600,
+		MaxTaskExpiry:         86400 * 90,
+		MinClaimDeadline:      3600,
+		MaxClaimDeadline:      86400 * 30,
+		MinSubmissionDeadline: 3600,
+		MaxSubmissionDeadline: 86400 * 30,
+		MaxSubmissionAttempts: 3,
+		DisputeWindow:         86400 * 7,
+		MaxDeadlinesPerBlock:  100,
+		MaxDeadlineRetries:    60,
 	}
 }
 
-func CreateTaskReward(taskId uint64, claimant string, bounty sdk.Coin, txHash string, timestamp int64) TaskReward {
+func CreateTaskReward(taskId uint64, claimant string, bounty sdk.Coins, txHash string, blockHeight int64, timestamp int64) TaskReward {
 	return TaskReward{
-		TaskId:    taskId,
-		Claimant:  claimant,
-		Amount:    bounty,
-		Timestamp: timestamp,
-		TxHash:    txHash,
+		TaskId:      taskId,
+		Claimant:    claimant,
+		Amount:      bounty,
+		Timestamp:   timestamp,
+		TxHash:      txHash,
+		BlockHeight: blockHeight,
+	}
+}
+
+// reasons recorded on a TaskRefund
+const (
+	RefundReasonDeleted  = "deleted"
+	RefundReasonClosed   = "closed"
+	RefundReasonExpired  = "expired"
+	RefundReasonDisputed = "disputed"
+)
+
+func CreateTaskRefund(taskId uint64, creator string, amount sdk.Coins, reason string, txHash string, blockHeight int64, timestamp int64) TaskRefund {
+	return TaskRefund{
+		TaskId:      taskId,
+		Creator:     creator,
+		Amount:      amount,
+		Timestamp:   timestamp,
+		TxHash:      txHash,
+		BlockHeight: blockHeight,
+		Reason:      reason,
 	}
 }
 
@@ -418,7 +917,7 @@ func ValidateRewardDistribution(task Task, reward TaskReward) error {
 	if task.Id != reward.TaskId {
 		return fmt.Errorf("reward task ID %d does not match task ID %d", reward.TaskId, task.Id)
 	}
-	if !task.Bounty.IsEqual(reward.Amount) {
+	if !task.Bounty.Equal(reward.Amount) {
 		return fmt.Errorf("reward amount %s does not match task bounty %s", reward.Amount.String(), task.Bounty.String())
 	}
 	if err := reward.Validate(); err != nil {
@@ -428,22 +927,27 @@ func ValidateRewardDistribution(task Task, reward TaskReward) error {
 	return nil
 }
 
-func CalculateRewardAmount(task Task, params Params, performanceScore float64) sdk.Coin {
+func CalculateRewardAmount(task Task, params Params, performanceScore float64) sdk.Coins {
 	if performanceScore < 0.0 {
 		performanceScore = 0.0
 	} else if performanceScore > 1.0 {
 		performanceScore = 1.0
 	}
-	baseReward := math.LegacyNewDecFromInt(task.Bounty.Amount).Mul(math.LegacyMustNewDecFromStr(fmt.Sprintf("%f", performanceScore)))
-	rewardAmount := baseReward.TruncateInt()
-	if rewardAmount.GT(task.Bounty.Amount) {
-		rewardAmount = task.Bounty.Amount
-	}
-	if performanceScore >= 0.5 && rewardAmount.LT(params.MinBounty.Amount) {
-		rewardAmount = params.MinBounty.Amount
+	score := math.LegacyMustNewDecFromStr(fmt.Sprintf("%f", performanceScore))
+
+	reward := sdk.NewCoins()
+	for _, coin := range task.Bounty {
+		rewardAmount := math.LegacyNewDecFromInt(coin.Amount).Mul(score).TruncateInt()
+		if rewardAmount.GT(coin.Amount) {
+			rewardAmount = coin.Amount
+		}
+		if minBounty, _, ok := params.BountyLimits(coin.Denom); ok && performanceScore >= 0.5 && rewardAmount.LT(minBounty.Amount) {
+			rewardAmount = minBounty.Amount
+		}
+		reward = reward.Add(sdk.NewCoin(coin.Denom, rewardAmount))
 	}
 
-	return sdk.NewCoin(task.Bounty.Denom, rewardAmount)
+	return reward
 }
 
 func SplitTaskReward(reward TaskReward, recipients []string, weights []math.Int) []TaskReward {
@@ -465,17 +969,26 @@ func SplitTaskReward(reward TaskReward, recipients []string, weights []math.Int)
 			continue
 		}
 		portion := math.LegacyNewDecFromInt(weights[i]).Quo(math.LegacyNewDecFromInt(totalWeight))
-		amount := math.LegacyNewDecFromInt(reward.Amount.Amount).Mul(portion).TruncateInt()
-		if amount.IsZero() {
+		// every denom is split by the same portion, reward.Amount is sorted
+		// so amount stays sorted too
+		var amount sdk.Coins
+		for _, coin := range reward.Amount {
+			share := math.LegacyNewDecFromInt(coin.Amount).Mul(portion).TruncateInt()
+			if share.IsPositive() {
+				amount = append(amount, sdk.NewCoin(coin.Denom, share))
+			}
+		}
+		if amount.Empty() {
 			continue
 		}
 
 		rewards = append(rewards, TaskReward{
-			TaskId:    reward.TaskId,
-			Claimant:  recipient,
-			Amount:    sdk.NewCoin(reward.Amount.Denom, amount),
-			Timestamp: reward.Timestamp,
-			TxHash:    reward.TxHash,
+			TaskId:      reward.TaskId,
+			Claimant:    recipient,
+			Amount:      amount,
+			Timestamp:   reward.Timestamp,
+			TxHash:      reward.TxHash,
+			BlockHeight: reward.BlockHeight,
 		})
 	}
 
@@ -496,13 +1009,26 @@ func CheckAutoApproval(task Task, params Params, proof TaskProof, approvals uint
 }
 
 func EstimateTaskCompletionTime(task Task, params Params) time.Duration {
-	bountyRatio := math.LegacyNewDecFromInt(task.Bounty.Amount).Quo(math.LegacyNewDecFromInt(params.MaxBounty.Amount))
-	baseHours := 100.0
-	estimatedHours := baseHours / bountyRatio.MustFloat64()
-	if estimatedHours > 1000.0 {
-		estimatedHours = 1000.0
+	const (
+		baseHours = 100.0
+		maxHours  = 1000.0
+	)
+
+	// each coin is estimated against the max bounty of its own denom, and the
+	// coin closest to it sets the estimate; amounts of different denoms are
+	// never compared, and coins of denoms without a bound are skipped
+	estimatedHours := maxHours
+	for _, coin := range task.Bounty {
+		_, maxBounty, ok := params.BountyLimits(coin.Denom)
+		if !ok || !hasAmount(maxBounty) || !maxBounty.IsPositive() || !coin.IsPositive() {
+			continue
+		}
+		ratio := math.LegacyNewDecFromInt(coin.Amount).Quo(math.LegacyNewDecFromInt(maxBounty.Amount))
+		if hours := baseHours / ratio.MustFloat64(); hours < estimatedHours {
+			estimatedHours = hours
+		}
 	}
-	
+
 	return time.Duration(estimatedHours) * time.Hour
 }
 
@@ -520,6 +1046,8 @@ func GetTaskProgress(task Task) float64 {
 		return 1.0
 	case TASK_STATUS_REJECTED:
 		return 0.5
+	case TASK_STATUS_DISPUTED:
+		return 0.75
 	case TASK_STATUS_CLOSED:
 		if task.Claimant != "" {
 			return 1.0
@@ -528,4 +1056,4 @@ func GetTaskProgress(task Task) float64 {
 	default:
 		return 0.0
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"taskbounty/x/task/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateTaskCompletionTime(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxBounty = sdk.NewInt64Coin("stake", 1000)
+	params.BountyDenoms = []types.BountyDenom{{
+		MinBounty: sdk.NewInt64Coin("uatom", 10),
+		MaxBounty: sdk.NewInt64Coin("uatom", 100),
+	}}
+
+	unbounded := params
+	unbounded.MaxBounty = sdk.NewInt64Coin("stake", 0)
+
+	tests := []struct {
+		desc     string
+		bounty   sdk.Coins
+		params   types.Params
+		expected time.Duration
+	}{
+		{
+			desc:     "max bounty",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			params:   params,
+			expected: 100 * time.Hour,
+		},
+		{
+			desc:     "half of the max bounty",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			params:   params,
+			expected: 200 * time.Hour,
+		},
+		{
+			desc:     "closest coin to its max bounty",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("uatom", 100)),
+			params:   params,
+			expected: 100 * time.Hour,
+		},
+		{
+			desc:     "capped",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			params:   params,
+			expected: 1000 * time.Hour,
+		},
+		{
+			desc:     "denom without a bound",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)),
+			params:   params,
+			expected: 1000 * time.Hour,
+		},
+		{
+			desc:     "denom without a bound next to a bounded one",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin("uatom", 50)),
+			params:   params,
+			expected: 200 * time.Hour,
+		},
+		{
+			desc:     "unbounded denom",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			params:   unbounded,
+			expected: 1000 * time.Hour,
+		},
+		{
+			desc:     "unset max bounty",
+			bounty:   sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			params:   types.Params{},
+			expected: 1000 * time.Hour,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			task := types.Task{Bounty: tc.bounty}
+			require.Equal(t, tc.expected, types.EstimateTaskCompletionTime(task, tc.params))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -127,14 +128,28 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgCreateTask defines the MsgCreateTask message.
 type MsgCreateTask struct {
-	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Title       string     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Bounty      types.Coin `protobuf:"bytes,4,opt,name=bounty,proto3" json:"bounty"`
-	Status      TaskStatus `protobuf:"varint,5,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
-	Claimant    string     `protobuf:"bytes,6,opt,name=claimant,proto3" json:"claimant,omitempty"`
-	Proof       TaskProof  `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof"`
-	Approver    string     `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	Creator     string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title       string                                   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Bounty      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+	Status      TaskStatus                               `protobuf:"varint,5,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
+	Claimant    string                                   `protobuf:"bytes,6,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Proof       TaskProof                                `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof"`
+	Approver    string                                   `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	// optional expiry of the open task in seconds, bounded by Params.min_task_expiry and Params.max_task_expiry
+	TaskExpiry uint64 `protobuf:"varint,9,opt,name=task_expiry,json=taskExpiry,proto3" json:"task_expiry,omitempty"`
+	// optional claim window in seconds, bounded by Params.min_claim_deadline and Params.max_claim_deadline
+	ClaimDeadline uint64 `protobuf:"varint,10,opt,name=claim_deadline,json=claimDeadline,proto3" json:"claim_deadline,omitempty"`
+	// optional review window in seconds, bounded by Params.min_submission_deadline and Params.max_submission_deadline
+	SubmissionDeadline uint64 `protobuf:"varint,11,opt,name=submission_deadline,json=submissionDeadline,proto3" json:"submission_deadline,omitempty"`
+	// optional reviewers whose endorsements auto-approve the task once Params.auto_approve_threshold is reached
+	Reviewers []string `protobuf:"bytes,12,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	// optional approvers allowed to approve or reject submissions alongside the creator,
+	// a set approver is nominated as well
+	Approvers []string `protobuf:"bytes,13,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// optional fees the creator sponsors for the claimant's MsgSubmitTask on this task, granted
+	// through x/feegrant when the task is claimed. The claimant pays for the claim itself.
+	FeeAllowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=fee_allowance,json=feeAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance"`
 }
 
 func (m *MsgCreateTask) Reset()         { *m = MsgCreateTask{} }
@@ -191,11 +206,11 @@ func (m *MsgCreateTask) GetDescription() string {
 	return ""
 }
 
-func (m *MsgCreateTask) GetBounty() types.Coin {
+func (m *MsgCreateTask) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Bounty
 	}
-	return types.Coin{}
+	return nil
 }
 
 func (m *MsgCreateTask) GetStatus() TaskStatus {
@@ -226,6 +241,48 @@ func (m *MsgCreateTask) GetApprover() string {
 	return ""
 }
 
+func (m *MsgCreateTask) GetTaskExpiry() uint64 {
+	if m != nil {
+		return m.TaskExpiry
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetClaimDeadline() uint64 {
+	if m != nil {
+		return m.ClaimDeadline
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetSubmissionDeadline() uint64 {
+	if m != nil {
+		return m.SubmissionDeadline
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetReviewers() []string {
+	if m != nil {
+		return m.Reviewers
+	}
+	return nil
+}
+
+func (m *MsgCreateTask) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *MsgCreateTask) GetFeeAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return nil
+}
+
 // MsgCreateTaskResponse defines the MsgCreateTaskResponse message.
 type MsgCreateTaskResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -273,15 +330,15 @@ func (m *MsgCreateTaskResponse) GetId() uint64 {
 
 // MsgUpdateTask defines the MsgUpdateTask message.
 type MsgUpdateTask struct {
-	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Bounty      types.Coin `protobuf:"bytes,5,opt,name=bounty,proto3" json:"bounty"`
-	Status      TaskStatus `protobuf:"varint,6,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
-	Claimant    string     `protobuf:"bytes,7,opt,name=claimant,proto3" json:"claimant,omitempty"`
-	Proof       TaskProof  `protobuf:"bytes,8,opt,name=proof,proto3" json:"proof"`
-	Approver    string     `protobuf:"bytes,9,opt,name=approver,proto3" json:"approver,omitempty"`
+	Creator     string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id          uint64                                   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                                   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Bounty      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+	Status      TaskStatus                               `protobuf:"varint,6,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
+	Claimant    string                                   `protobuf:"bytes,7,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Proof       TaskProof                                `protobuf:"bytes,8,opt,name=proof,proto3" json:"proof"`
+	Approver    string                                   `protobuf:"bytes,9,opt,name=approver,proto3" json:"approver,omitempty"`
 }
 
 func (m *MsgUpdateTask) Reset()         { *m = MsgUpdateTask{} }
@@ -345,11 +402,11 @@ func (m *MsgUpdateTask) GetDescription() string {
 	return ""
 }
 
-func (m *MsgUpdateTask) GetBounty() types.Coin {
+func (m *MsgUpdateTask) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Bounty
 	}
-	return types.Coin{}
+	return nil
 }
 
 func (m *MsgUpdateTask) GetStatus() TaskStatus {
@@ -511,6 +568,10 @@ var xxx_messageInfo_MsgDeleteTaskResponse proto.InternalMessageInfo
 type MsgClaimTask struct {
 	Claimant string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
 	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// fails the message when the bounty of the task exceeds max_bounty in any of
+	// its denoms or holds a denom missing from it, any bounty when empty.
+	// Messages executed through an authz grant with a max bounty must set it.
+	MaxBounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_bounty,json=maxBounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bounty"`
 }
 
 func (m *MsgClaimTask) Reset()         { *m = MsgClaimTask{} }
@@ -560,6 +621,13 @@ func (m *MsgClaimTask) GetId() uint64 {
 	return 0
 }
 
+func (m *MsgClaimTask) GetMaxBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBounty
+	}
+	return nil
+}
+
 // MsgClaimTaskResponse defines the ClaimTaskResponse message.
 type MsgClaimTaskResponse struct {
 }
@@ -660,6 +728,8 @@ func (m *MsgSubmitTask) GetProof() TaskProof {
 
 // MsgSubmitTaskResponse defines the SubmitTaskResponse message.
 type MsgSubmitTaskResponse struct {
+	// attempt number of the submitted proof
+	Attempt uint64 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *MsgSubmitTaskResponse) Reset()         { *m = MsgSubmitTaskResponse{} }
@@ -695,11 +765,21 @@ func (m *MsgSubmitTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitTaskResponse proto.InternalMessageInfo
 
+func (m *MsgSubmitTaskResponse) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // MsgApproveTask defines the ApproveTask message.
 type MsgApproveTask struct {
 	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
 	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	TxHash   string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// fails the message when the bounty of the task exceeds max_bounty in any of
+	// its denoms or holds a denom missing from it, any bounty when empty.
+	// Messages executed through an authz grant with a max bounty must set it.
+	MaxBounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_bounty,json=maxBounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bounty"`
 }
 
 func (m *MsgApproveTask) Reset()         { *m = MsgApproveTask{} }
@@ -749,11 +829,11 @@ func (m *MsgApproveTask) GetId() uint64 {
 	return 0
 }
 
-func (m *MsgApproveTask) GetTxHash() string {
+func (m *MsgApproveTask) GetMaxBounty() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.TxHash
+		return m.MaxBounty
 	}
-	return ""
+	return nil
 }
 
 // MsgApproveTaskResponse defines the ApproveTaskResponse message.
@@ -798,6 +878,10 @@ type MsgRejectTask struct {
 	Rejecter string `protobuf:"bytes,1,opt,name=rejecter,proto3" json:"rejecter,omitempty"`
 	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// fails the message when the bounty of the task exceeds max_bounty in any of
+	// its denoms or holds a denom missing from it, any bounty when empty.
+	// Messages executed through an authz grant with a max bounty must set it.
+	MaxBounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_bounty,json=maxBounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bounty"`
 }
 
 func (m *MsgRejectTask) Reset()         { *m = MsgRejectTask{} }
@@ -854,6 +938,13 @@ func (m *MsgRejectTask) GetReason() string {
 	return ""
 }
 
+func (m *MsgRejectTask) GetMaxBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBounty
+	}
+	return nil
+}
+
 // MsgRejectTaskResponse defines the RejectTaskResponse message.
 type MsgRejectTaskResponse struct {
 }
@@ -891,1303 +982,5591 @@ func (m *MsgRejectTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRejectTaskResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "taskbounty.task.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "taskbounty.task.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateTask)(nil), "taskbounty.task.v1.MsgCreateTask")
-	proto.RegisterType((*MsgCreateTaskResponse)(nil), "taskbounty.task.v1.MsgCreateTaskResponse")
-	proto.RegisterType((*MsgUpdateTask)(nil), "taskbounty.task.v1.MsgUpdateTask")
-	proto.RegisterType((*MsgUpdateTaskResponse)(nil), "taskbounty.task.v1.MsgUpdateTaskResponse")
-	proto.RegisterType((*MsgDeleteTask)(nil), "taskbounty.task.v1.MsgDeleteTask")
-	proto.RegisterType((*MsgDeleteTaskResponse)(nil), "taskbounty.task.v1.MsgDeleteTaskResponse")
-	proto.RegisterType((*MsgClaimTask)(nil), "taskbounty.task.v1.MsgClaimTask")
-	proto.RegisterType((*MsgClaimTaskResponse)(nil), "taskbounty.task.v1.MsgClaimTaskResponse")
-	proto.RegisterType((*MsgSubmitTask)(nil), "taskbounty.task.v1.MsgSubmitTask")
-	proto.RegisterType((*MsgSubmitTaskResponse)(nil), "taskbounty.task.v1.MsgSubmitTaskResponse")
-	proto.RegisterType((*MsgApproveTask)(nil), "taskbounty.task.v1.MsgApproveTask")
-	proto.RegisterType((*MsgApproveTaskResponse)(nil), "taskbounty.task.v1.MsgApproveTaskResponse")
-	proto.RegisterType((*MsgRejectTask)(nil), "taskbounty.task.v1.MsgRejectTask")
-	proto.RegisterType((*MsgRejectTaskResponse)(nil), "taskbounty.task.v1.MsgRejectTaskResponse")
+// MsgReviewTask defines the ReviewTask message.
+type MsgReviewTask struct {
+	Reviewer string         `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Id       uint64         `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Decision ReviewDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=taskbounty.task.v1.ReviewDecision" json:"decision,omitempty"`
+	Comment  string         `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func init() { proto.RegisterFile("taskbounty/task/v1/tx.proto", fileDescriptor_48f6bb01333777c3) }
-
-var fileDescriptor_48f6bb01333777c3 = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xf3, 0xc3, 0x69, 0xa6, 0xdd, 0x22, 0xac, 0xd0, 0xb8, 0x46, 0xeb, 0x4d, 0xcd, 0x81,
-	0x10, 0x84, 0xad, 0x84, 0xd5, 0x22, 0x56, 0xe2, 0xb0, 0x59, 0x0e, 0x5c, 0x22, 0xad, 0x5c, 0x10,
-	0xd2, 0x4a, 0x68, 0x99, 0x24, 0x83, 0x63, 0xda, 0x78, 0x2c, 0xcf, 0x24, 0x4a, 0x25, 0x84, 0x10,
-	0x47, 0x4e, 0xfc, 0x07, 0x88, 0x1b, 0xc7, 0x22, 0x71, 0xe6, 0xdc, 0x63, 0xc5, 0x89, 0x13, 0x42,
-	0xed, 0xa1, 0x77, 0xfe, 0x02, 0x34, 0xe3, 0xf1, 0x8f, 0xc6, 0x71, 0x92, 0x66, 0x7b, 0x89, 0x32,
-	0x7e, 0xdf, 0x7b, 0xdf, 0x37, 0xef, 0x9b, 0x37, 0x36, 0x78, 0x9b, 0x42, 0x72, 0x32, 0xc0, 0x53,
-	0x8f, 0x9e, 0x59, 0xec, 0xaf, 0x35, 0xeb, 0x58, 0x74, 0x6e, 0xfa, 0x01, 0xa6, 0x58, 0x51, 0x92,
-	0xa0, 0xc9, 0xfe, 0x9a, 0xb3, 0x8e, 0xf6, 0x26, 0x9c, 0xb8, 0x1e, 0xb6, 0xf8, 0x6f, 0x08, 0xd3,
-	0xf4, 0x21, 0x26, 0x13, 0x4c, 0xac, 0x01, 0x24, 0xc8, 0x9a, 0x75, 0x06, 0x88, 0xc2, 0x8e, 0x35,
-	0xc4, 0xae, 0x27, 0xe2, 0x0d, 0x11, 0x9f, 0x10, 0x87, 0x95, 0x9f, 0x10, 0x47, 0x04, 0x0e, 0xc3,
-	0xc0, 0x2b, 0xbe, 0xb2, 0xc2, 0x85, 0x08, 0xd5, 0x1d, 0xec, 0xe0, 0xf0, 0x39, 0xfb, 0x27, 0x9e,
-	0x3e, 0x5a, 0xa2, 0xd6, 0x87, 0x01, 0x9c, 0x44, 0x69, 0x0f, 0x97, 0x6d, 0x87, 0x29, 0xe7, 0x61,
-	0xe3, 0x4f, 0x09, 0xbc, 0xd1, 0x27, 0xce, 0x17, 0xfe, 0x08, 0x52, 0xf4, 0x82, 0x27, 0x2a, 0x4f,
-	0x40, 0x0d, 0x4e, 0xe9, 0x18, 0x07, 0x2e, 0x3d, 0x53, 0xa5, 0xa6, 0xd4, 0xaa, 0xf5, 0xd4, 0xbf,
-	0xfe, 0xf8, 0xa0, 0x2e, 0xe4, 0x3c, 0x1b, 0x8d, 0x02, 0x44, 0xc8, 0x31, 0x0d, 0x5c, 0xcf, 0xb1,
-	0x13, 0xa8, 0xf2, 0x09, 0x90, 0x43, 0x6a, 0xb5, 0xd8, 0x94, 0x5a, 0xbb, 0x5d, 0xcd, 0xcc, 0x76,
-	0xcb, 0x0c, 0x39, 0x7a, 0xb5, 0x8b, 0x7f, 0x1e, 0x15, 0x7e, 0xbb, 0x39, 0x6f, 0x4b, 0xb6, 0x48,
-	0x7a, 0xfa, 0xf8, 0xc7, 0x9b, 0xf3, 0x76, 0x52, 0xee, 0xa7, 0x9b, 0xf3, 0xf6, 0x51, 0x4a, 0xfc,
-	0x3c, 0x94, 0xbf, 0x20, 0xd6, 0x38, 0x04, 0x8d, 0x85, 0x47, 0x36, 0x22, 0x3e, 0xf6, 0x08, 0x32,
-	0x7e, 0x2d, 0x81, 0x07, 0x7d, 0xe2, 0x3c, 0x0f, 0x10, 0xa4, 0xe8, 0x73, 0x48, 0x4e, 0x94, 0x2e,
-	0xa8, 0x0e, 0xd9, 0x0a, 0x07, 0x6b, 0xf7, 0x15, 0x01, 0x95, 0x3a, 0xa8, 0x50, 0x97, 0x9e, 0x22,
-	0xbe, 0xa9, 0x9a, 0x1d, 0x2e, 0x94, 0x26, 0xd8, 0x1d, 0x21, 0x32, 0x0c, 0x5c, 0x9f, 0xba, 0xd8,
-	0x53, 0x4b, 0x3c, 0x96, 0x7e, 0xa4, 0x7c, 0x04, 0xe4, 0x50, 0xb9, 0x5a, 0xe6, 0xdd, 0x38, 0x34,
-	0x05, 0x0f, 0x3b, 0x14, 0xa6, 0x38, 0x14, 0xe6, 0x73, 0xec, 0x7a, 0xbd, 0x32, 0x6b, 0x86, 0x2d,
-	0xe0, 0xca, 0x13, 0x20, 0x13, 0x0a, 0xe9, 0x94, 0xa8, 0x95, 0xa6, 0xd4, 0xda, 0xef, 0xea, 0xcb,
-	0xda, 0xc8, 0xb6, 0x73, 0xcc, 0x51, 0xb6, 0x40, 0x2b, 0x8f, 0xc1, 0xce, 0xf0, 0x14, 0xba, 0x13,
-	0xe8, 0x51, 0x55, 0x5e, 0xb3, 0xbb, 0x18, 0xa9, 0x7c, 0x0c, 0x2a, 0x7e, 0x80, 0xf1, 0x37, 0x6a,
-	0x95, 0xab, 0x7c, 0x98, 0x47, 0xf6, 0x82, 0x81, 0x84, 0xd2, 0x30, 0x83, 0x11, 0x42, 0xdf, 0x0f,
-	0xf0, 0x0c, 0x05, 0xea, 0xce, 0x3a, 0xc2, 0x08, 0xf9, 0x74, 0x8f, 0xd9, 0x1c, 0x75, 0xd7, 0x78,
-	0x17, 0xbc, 0x75, 0xcb, 0xa2, 0xc8, 0x3c, 0x65, 0x1f, 0x14, 0xdd, 0x11, 0x77, 0xa9, 0x6c, 0x17,
-	0xdd, 0x91, 0xf1, 0x7b, 0x68, 0x66, 0x68, 0xf4, 0xd6, 0x66, 0x86, 0x55, 0x8b, 0x51, 0xd5, 0xc4,
-	0xdc, 0xd2, 0x0a, 0x73, 0xcb, 0xab, 0xcc, 0xad, 0x6c, 0x6b, 0xae, 0xbc, 0xb5, 0xb9, 0xd5, 0xbb,
-	0x9b, 0xbb, 0xf3, 0x5a, 0xe6, 0xd6, 0xb6, 0x34, 0xb7, 0xc1, 0xcd, 0x4d, 0x2c, 0x8b, 0x27, 0x13,
-	0x72, 0x2f, 0x3f, 0x45, 0xa7, 0xe8, 0xfe, 0xbc, 0x5c, 0xca, 0x9d, 0x50, 0xc4, 0xdc, 0x43, 0xb0,
-	0xc7, 0x4e, 0x1c, 0x6b, 0x11, 0xa7, 0x4e, 0x77, 0x56, 0xda, 0xb8, 0xb3, 0x8b, 0xe4, 0x0f, 0x18,
-	0x79, 0x1c, 0x36, 0x0e, 0x40, 0x3d, 0x4d, 0x12, 0x93, 0xff, 0x22, 0xf1, 0x9d, 0x1f, 0x4f, 0x07,
-	0x13, 0x97, 0xde, 0x1f, 0x7d, 0x62, 0x74, 0xe9, 0xae, 0x46, 0x2f, 0x2a, 0x0f, 0xfb, 0x96, 0x08,
-	0x8c, 0xa5, 0x7f, 0x0f, 0xf6, 0xfb, 0xc4, 0x79, 0x16, 0x3a, 0x1d, 0x49, 0x8f, 0x8f, 0x88, 0xb4,
-	0xe9, 0x11, 0xc9, 0x48, 0x6f, 0x80, 0x2a, 0x9d, 0xbf, 0x1a, 0x43, 0x32, 0x16, 0x43, 0x28, 0xd3,
-	0xf9, 0x67, 0x90, 0x8c, 0x85, 0xb0, 0x28, 0xcf, 0x50, 0xc1, 0xc1, 0x6d, 0xfe, 0x58, 0xd9, 0x77,
-	0xbc, 0xa7, 0x36, 0xfa, 0x16, 0x0d, 0xe3, 0x9e, 0x06, 0x7c, 0xb5, 0x89, 0xb0, 0x08, 0x99, 0x11,
-	0x76, 0x00, 0xe4, 0x00, 0x41, 0x12, 0xdf, 0xee, 0x62, 0x25, 0x74, 0x45, 0x69, 0xa2, 0x61, 0x09,
-	0x7b, 0x24, 0xab, 0xfb, 0x5f, 0x05, 0x94, 0xfa, 0xc4, 0x51, 0xbe, 0x06, 0x7b, 0xb7, 0x5e, 0xaf,
-	0xef, 0x2c, 0x33, 0x67, 0xe1, 0x1d, 0xa6, 0xbd, 0xbf, 0x01, 0x28, 0xbe, 0x2b, 0x5f, 0x02, 0x90,
-	0x7a, 0xc9, 0x1d, 0xe5, 0xa4, 0x26, 0x10, 0xed, 0xbd, 0xb5, 0x90, 0x74, 0xed, 0xd4, 0x9d, 0x7b,
-	0xb4, 0x52, 0xd6, 0xca, 0xda, 0xd9, 0x6b, 0x80, 0xd5, 0x4e, 0xdd, 0x01, 0x79, 0xb5, 0x13, 0x48,
-	0x6e, 0xed, 0xec, 0x98, 0x2b, 0x5f, 0x82, 0x5a, 0x32, 0xe3, 0xcd, 0xbc, 0xfd, 0x46, 0x08, 0xad,
-	0xb5, 0x0e, 0x91, 0x16, 0x9d, 0x1a, 0xdf, 0x3c, 0xd1, 0x09, 0x24, 0x57, 0x74, 0x76, 0xc6, 0x94,
-	0xaf, 0xc0, 0x6e, 0x7a, 0xc0, 0x8c, 0x9c, 0xcc, 0x14, 0x46, 0x6b, 0xaf, 0xc7, 0xa4, 0xa5, 0xa7,
-	0xa6, 0x24, 0x4f, 0x7a, 0x02, 0xc9, 0x95, 0x9e, 0x3d, 0xed, 0x5a, 0xe5, 0x07, 0xf6, 0x31, 0xd7,
-	0xeb, 0x5c, 0x5c, 0xe9, 0xd2, 0xe5, 0x95, 0x2e, 0xfd, 0x7b, 0xa5, 0x4b, 0x3f, 0x5f, 0xeb, 0x85,
-	0xcb, 0x6b, 0xbd, 0xf0, 0xf7, 0xb5, 0x5e, 0x78, 0xd9, 0xc8, 0x7e, 0xcb, 0xd1, 0x33, 0x1f, 0x91,
-	0x81, 0xcc, 0xbf, 0x44, 0x3f, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x8b, 0x37, 0xa3, 0x79,
-	0x0b, 0x00, 0x00,
+func (m *MsgReviewTask) Reset()         { *m = MsgReviewTask{} }
+func (m *MsgReviewTask) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewTask) ProtoMessage()    {}
+func (*MsgReviewTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateTask defines the CreateTask RPC.
-	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
-	// UpdateTask defines the UpdateTask RPC.
-	UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error)
-	// DeleteTask defines the DeleteTask RPC.
-	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
-	// Custom task management messages
-	ClaimTask(ctx context.Context, in *MsgClaimTask, opts ...grpc.CallOption) (*MsgClaimTaskResponse, error)
-	SubmitTask(ctx context.Context, in *MsgSubmitTask, opts ...grpc.CallOption) (*MsgSubmitTaskResponse, error)
-	ApproveTask(ctx context.Context, in *MsgApproveTask, opts ...grpc.CallOption) (*MsgApproveTaskResponse, error)
-	RejectTask(ctx context.Context, in *MsgRejectTask, opts ...grpc.CallOption) (*MsgRejectTaskResponse, error)
+func (m *MsgReviewTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgReviewTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgReviewTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewTask.Merge(m, src)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReviewTask) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error) {
-	out := new(MsgCreateTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/CreateTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReviewTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewTask.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error) {
-	out := new(MsgUpdateTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/UpdateTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReviewTask proto.InternalMessageInfo
+
+func (m *MsgReviewTask) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error) {
-	out := new(MsgDeleteTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/DeleteTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewTask) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ClaimTask(ctx context.Context, in *MsgClaimTask, opts ...grpc.CallOption) (*MsgClaimTaskResponse, error) {
-	out := new(MsgClaimTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ClaimTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewTask) GetDecision() ReviewDecision {
+	if m != nil {
+		return m.Decision
 	}
-	return out, nil
+	return REVIEW_DECISION_UNSPECIFIED
 }
 
-func (c *msgClient) SubmitTask(ctx context.Context, in *MsgSubmitTask, opts ...grpc.CallOption) (*MsgSubmitTaskResponse, error) {
-	out := new(MsgSubmitTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/SubmitTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewTask) GetComment() string {
+	if m != nil {
+		return m.Comment
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ApproveTask(ctx context.Context, in *MsgApproveTask, opts ...grpc.CallOption) (*MsgApproveTaskResponse, error) {
-	out := new(MsgApproveTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ApproveTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgReviewTaskResponse defines the ReviewTaskResponse message.
+type MsgReviewTaskResponse struct {
+	// status of the task after the review, APPROVED or REJECTED once the threshold is reached
+	Status TaskStatus `protobuf:"varint,1,opt,name=status,proto3,enum=taskbounty.task.v1.TaskStatus" json:"status,omitempty"`
 }
 
-func (c *msgClient) RejectTask(ctx context.Context, in *MsgRejectTask, opts ...grpc.CallOption) (*MsgRejectTaskResponse, error) {
-	out := new(MsgRejectTaskResponse)
-	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/RejectTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewTaskResponse) Reset()         { *m = MsgReviewTaskResponse{} }
+func (m *MsgReviewTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewTaskResponse) ProtoMessage()    {}
+func (*MsgReviewTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{17}
+}
+func (m *MsgReviewTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReviewTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateTask defines the CreateTask RPC.
-	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
-	// UpdateTask defines the UpdateTask RPC.
-	UpdateTask(context.Context, *MsgUpdateTask) (*MsgUpdateTaskResponse, error)
-	// DeleteTask defines the DeleteTask RPC.
-	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
-	// Custom task management messages
-	ClaimTask(context.Context, *MsgClaimTask) (*MsgClaimTaskResponse, error)
-	SubmitTask(context.Context, *MsgSubmitTask) (*MsgSubmitTaskResponse, error)
-	ApproveTask(context.Context, *MsgApproveTask) (*MsgApproveTaskResponse, error)
-	RejectTask(context.Context, *MsgRejectTask) (*MsgRejectTaskResponse, error)
+func (m *MsgReviewTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewTaskResponse.Merge(m, src)
+}
+func (m *MsgReviewTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReviewTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewTaskResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgReviewTaskResponse proto.InternalMessageInfo
+
+func (m *MsgReviewTaskResponse) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TASK_STATUS_UNDEFINED
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+// MsgDisputeTask defines the DisputeTask message.
+type MsgDisputeTask struct {
+	Claimant string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTask) (*MsgCreateTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+
+func (m *MsgDisputeTask) Reset()         { *m = MsgDisputeTask{} }
+func (m *MsgDisputeTask) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeTask) ProtoMessage()    {}
+func (*MsgDisputeTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{18}
 }
-func (*UnimplementedMsgServer) UpdateTask(ctx context.Context, req *MsgUpdateTask) (*MsgUpdateTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+func (m *MsgDisputeTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteTask(ctx context.Context, req *MsgDeleteTask) (*MsgDeleteTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+func (m *MsgDisputeTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClaimTask(ctx context.Context, req *MsgClaimTask) (*MsgClaimTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimTask not implemented")
+func (m *MsgDisputeTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeTask.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitTask(ctx context.Context, req *MsgSubmitTask) (*MsgSubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitTask not implemented")
+func (m *MsgDisputeTask) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ApproveTask(ctx context.Context, req *MsgApproveTask) (*MsgApproveTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveTask not implemented")
+func (m *MsgDisputeTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeTask.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RejectTask(ctx context.Context, req *MsgRejectTask) (*MsgRejectTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectTask not implemented")
+
+var xxx_messageInfo_MsgDisputeTask proto.InternalMessageInfo
+
+func (m *MsgDisputeTask) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgDisputeTask) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDisputeTask) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/CreateTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTask(ctx, req.(*MsgCreateTask))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDisputeTaskResponse defines the DisputeTaskResponse message.
+type MsgDisputeTaskResponse struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func _Msg_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/UpdateTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTask(ctx, req.(*MsgUpdateTask))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgDisputeTaskResponse) Reset()         { *m = MsgDisputeTaskResponse{} }
+func (m *MsgDisputeTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeTaskResponse) ProtoMessage()    {}
+func (*MsgDisputeTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{19}
 }
-
-func _Msg_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/DeleteTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteTask(ctx, req.(*MsgDeleteTask))
+func (m *MsgDisputeTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDisputeTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeTaskResponse.Merge(m, src)
+}
+func (m *MsgDisputeTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeTaskResponse.DiscardUnknown(m)
 }
 
-func _Msg_ClaimTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/ClaimTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimTask(ctx, req.(*MsgClaimTask))
+var xxx_messageInfo_MsgDisputeTaskResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeTaskResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/SubmitTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitTask(ctx, req.(*MsgSubmitTask))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgResolveDispute defines the ResolveDispute message.
+type MsgResolveDispute struct {
+	Arbiter    string            `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Id         uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Resolution DisputeResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=taskbounty.task.v1.DisputeResolution" json:"resolution,omitempty"`
+	// weights of the split, only used with DISPUTE_RESOLUTION_SPLIT
+	ClaimantWeight uint64 `protobuf:"varint,4,opt,name=claimant_weight,json=claimantWeight,proto3" json:"claimant_weight,omitempty"`
+	CreatorWeight  uint64 `protobuf:"varint,5,opt,name=creator_weight,json=creatorWeight,proto3" json:"creator_weight,omitempty"`
+	Note           string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func _Msg_ApproveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApproveTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApproveTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/ApproveTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApproveTask(ctx, req.(*MsgApproveTask))
+func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
+func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDispute) ProtoMessage()    {}
+func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{20}
+}
+func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
+}
+func (m *MsgResolveDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
 }
 
-func _Msg_RejectTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/taskbounty.task.v1.Msg/RejectTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectTask(ctx, req.(*MsgRejectTask))
+var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
+
+func (m *MsgResolveDispute) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "taskbounty.task.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateTask",
-			Handler:    _Msg_CreateTask_Handler,
-		},
-		{
-			MethodName: "UpdateTask",
-			Handler:    _Msg_UpdateTask_Handler,
-		},
-		{
-			MethodName: "DeleteTask",
-			Handler:    _Msg_DeleteTask_Handler,
-		},
-		{
-			MethodName: "ClaimTask",
-			Handler:    _Msg_ClaimTask_Handler,
-		},
-		{
-			MethodName: "SubmitTask",
-			Handler:    _Msg_SubmitTask_Handler,
-		},
-		{
-			MethodName: "ApproveTask",
-			Handler:    _Msg_ApproveTask_Handler,
-		},
-		{
-			MethodName: "RejectTask",
-			Handler:    _Msg_RejectTask_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "taskbounty/task/v1/tx.proto",
+func (m *MsgResolveDispute) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDispute) GetResolution() DisputeResolution {
+	if m != nil {
+		return m.Resolution
 	}
-	return dAtA[:n], nil
+	return DISPUTE_RESOLUTION_UNSPECIFIED
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveDispute) GetClaimantWeight() uint64 {
+	if m != nil {
+		return m.ClaimantWeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgResolveDispute) GetCreatorWeight() uint64 {
+	if m != nil {
+		return m.CreatorWeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDispute) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgResolveDisputeResponse defines the ResolveDisputeResponse message.
+type MsgResolveDisputeResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
+func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{21}
 }
-
-func (m *MsgCreateTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
+}
+func (m *MsgResolveDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
+
+// MsgUnclaimTask defines the UnclaimTask message.
+type MsgUnclaimTask struct {
+	Claimant string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x42
-	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnclaimTask) Reset()         { *m = MsgUnclaimTask{} }
+func (m *MsgUnclaimTask) String() string { return proto.CompactTextString(m) }
+func (*MsgUnclaimTask) ProtoMessage()    {}
+func (*MsgUnclaimTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{22}
+}
+func (m *MsgUnclaimTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnclaimTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnclaimTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *MsgUnclaimTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnclaimTask.Merge(m, src)
+}
+func (m *MsgUnclaimTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnclaimTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnclaimTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnclaimTask proto.InternalMessageInfo
+
+func (m *MsgUnclaimTask) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *MsgUnclaimTask) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	{
-		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *MsgUnclaimTask) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgUnclaimTaskResponse defines the UnclaimTaskResponse message.
+type MsgUnclaimTaskResponse struct {
+}
+
+func (m *MsgUnclaimTaskResponse) Reset()         { *m = MsgUnclaimTaskResponse{} }
+func (m *MsgUnclaimTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnclaimTaskResponse) ProtoMessage()    {}
+func (*MsgUnclaimTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{23}
+}
+func (m *MsgUnclaimTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnclaimTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnclaimTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnclaimTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnclaimTaskResponse.Merge(m, src)
+}
+func (m *MsgUnclaimTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnclaimTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnclaimTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnclaimTaskResponse proto.InternalMessageInfo
+
+// MsgReopenTask defines the ReopenTask message.
+type MsgReopenTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgReopenTask) Reset()         { *m = MsgReopenTask{} }
+func (m *MsgReopenTask) String() string { return proto.CompactTextString(m) }
+func (*MsgReopenTask) ProtoMessage()    {}
+func (*MsgReopenTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{24}
+}
+func (m *MsgReopenTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReopenTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReopenTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReopenTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReopenTask.Merge(m, src)
+}
+func (m *MsgReopenTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReopenTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReopenTask.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReopenTask proto.InternalMessageInfo
+
+func (m *MsgReopenTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgReopenTask) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReopenTask) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReopenTaskResponse defines the ReopenTaskResponse message.
+type MsgReopenTaskResponse struct {
 }
 
-func (m *MsgUpdateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReopenTaskResponse) Reset()         { *m = MsgReopenTaskResponse{} }
+func (m *MsgReopenTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReopenTaskResponse) ProtoMessage()    {}
+func (*MsgReopenTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{25}
+}
+func (m *MsgReopenTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReopenTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReopenTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *MsgReopenTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReopenTaskResponse.Merge(m, src)
+}
+func (m *MsgReopenTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReopenTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReopenTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReopenTaskResponse proto.InternalMessageInfo
+
+// MsgCloseTask defines the CloseTask message.
+type MsgCloseTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCloseTask) Reset()         { *m = MsgCloseTask{} }
+func (m *MsgCloseTask) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTask) ProtoMessage()    {}
+func (*MsgCloseTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{26}
+}
+func (m *MsgCloseTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCloseTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTask.Merge(m, src)
+}
+func (m *MsgCloseTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseTask proto.InternalMessageInfo
+
+func (m *MsgCloseTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCloseTask) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCloseTaskResponse defines the CloseTaskResponse message.
+type MsgCloseTaskResponse struct {
+	// whether the closed task was moved to the archive
+	Archived bool `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
-func (m *MsgUpdateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCloseTaskResponse) Reset()         { *m = MsgCloseTaskResponse{} }
+func (m *MsgCloseTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTaskResponse) ProtoMessage()    {}
+func (*MsgCloseTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{27}
+}
+func (m *MsgCloseTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTaskResponse.Merge(m, src)
+}
+func (m *MsgCloseTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCloseTaskResponse proto.InternalMessageInfo
+
+func (m *MsgCloseTaskResponse) GetArchived() bool {
+	if m != nil {
+		return m.Archived
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetTaskApprovers defines the SetTaskApprovers message.
+type MsgSetTaskApprovers struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// the new approvers, empty leaves approval to the creator only
+	Approvers []string `protobuf:"bytes,3,rep,name=approvers,proto3" json:"approvers,omitempty"`
 }
 
-func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgSetTaskApprovers) Reset()         { *m = MsgSetTaskApprovers{} }
+func (m *MsgSetTaskApprovers) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTaskApprovers) ProtoMessage()    {}
+func (*MsgSetTaskApprovers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{28}
+}
+func (m *MsgSetTaskApprovers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTaskApprovers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTaskApprovers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetTaskApprovers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTaskApprovers.Merge(m, src)
+}
+func (m *MsgSetTaskApprovers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTaskApprovers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTaskApprovers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTaskApprovers proto.InternalMessageInfo
+
+func (m *MsgSetTaskApprovers) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTaskApprovers) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTaskApprovers) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
 }
 
-func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetTaskApproversResponse defines the SetTaskApproversResponse message.
+type MsgSetTaskApproversResponse struct {
 }
 
-func (m *MsgClaimTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTaskApproversResponse) Reset()         { *m = MsgSetTaskApproversResponse{} }
+func (m *MsgSetTaskApproversResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTaskApproversResponse) ProtoMessage()    {}
+func (*MsgSetTaskApproversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{29}
+}
+func (m *MsgSetTaskApproversResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTaskApproversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTaskApproversResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetTaskApproversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTaskApproversResponse.Merge(m, src)
+}
+func (m *MsgSetTaskApproversResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTaskApproversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTaskApproversResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTaskApproversResponse proto.InternalMessageInfo
+
+// MsgSendCreateTask defines the SendCreateTask message.
+type MsgSendCreateTask struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// absolute timeout of the packet in unix nanoseconds
+	TimeoutTimestamp uint64                                   `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Title            string                                   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                                   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Bounty           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
 }
 
-func (m *MsgClaimTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgSendCreateTask) Reset()         { *m = MsgSendCreateTask{} }
+func (m *MsgSendCreateTask) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCreateTask) ProtoMessage()    {}
+func (*MsgSendCreateTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{30}
+}
+func (m *MsgSendCreateTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendCreateTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendCreateTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSendCreateTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCreateTask.Merge(m, src)
+}
+func (m *MsgSendCreateTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendCreateTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCreateTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendCreateTask proto.InternalMessageInfo
+
+func (m *MsgSendCreateTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendCreateTask) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendCreateTask) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (m *MsgClaimTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendCreateTask) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *MsgSubmitTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendCreateTask) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendCreateTask) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bounty
+	}
+	return nil
 }
 
-func (m *MsgSubmitTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+// MsgSendCreateTaskResponse defines the SendCreateTaskResponse message.
+type MsgSendCreateTaskResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSendCreateTaskResponse) Reset()         { *m = MsgSendCreateTaskResponse{} }
+func (m *MsgSendCreateTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCreateTaskResponse) ProtoMessage()    {}
+func (*MsgSendCreateTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{31}
+}
+func (m *MsgSendCreateTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendCreateTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendCreateTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Claimant) > 0 {
-		i -= len(m.Claimant)
-		copy(dAtA[i:], m.Claimant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSendCreateTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCreateTaskResponse.Merge(m, src)
 }
-
-func (m *MsgSubmitTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendCreateTaskResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSubmitTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendCreateTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCreateTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgApproveTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendCreateTaskResponse proto.InternalMessageInfo
+
+func (m *MsgSendCreateTaskResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgApproveTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSendClaimTask defines the SendClaimTask message.
+type MsgSendClaimTask struct {
+	Claimant  string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// absolute timeout of the packet in unix nanoseconds
+	TimeoutTimestamp uint64 `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// id of the task on the other chain
+	TaskId uint64 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *MsgApproveTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSendClaimTask) Reset()         { *m = MsgSendClaimTask{} }
+func (m *MsgSendClaimTask) String() string { return proto.CompactTextString(m) }
+func (*MsgSendClaimTask) ProtoMessage()    {}
+func (*MsgSendClaimTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{32}
 }
-
-func (m *MsgApproveTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendClaimTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendClaimTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendClaimTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgApproveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendClaimTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendClaimTask.Merge(m, src)
 }
-
-func (m *MsgApproveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendClaimTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendClaimTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendClaimTask.DiscardUnknown(m)
 }
 
-func (m *MsgRejectTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendClaimTask proto.InternalMessageInfo
+
+func (m *MsgSendClaimTask) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRejectTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendClaimTask) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *MsgRejectTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Rejecter) > 0 {
-		i -= len(m.Rejecter)
-		copy(dAtA[i:], m.Rejecter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Rejecter)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSendClaimTask) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRejectTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendClaimTask) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRejectTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSendClaimTaskResponse defines the SendClaimTaskResponse message.
+type MsgSendClaimTaskResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgRejectTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendClaimTaskResponse) Reset()         { *m = MsgSendClaimTaskResponse{} }
+func (m *MsgSendClaimTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendClaimTaskResponse) ProtoMessage()    {}
+func (*MsgSendClaimTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f6bb01333777c3, []int{33}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSendClaimTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSendClaimTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendClaimTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSendClaimTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendClaimTaskResponse.Merge(m, src)
+}
+func (m *MsgSendClaimTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendClaimTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendClaimTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSendClaimTaskResponse proto.InternalMessageInfo
+
+func (m *MsgSendClaimTaskResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Bounty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Proof.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "taskbounty.task.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "taskbounty.task.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateTask)(nil), "taskbounty.task.v1.MsgCreateTask")
+	proto.RegisterType((*MsgCreateTaskResponse)(nil), "taskbounty.task.v1.MsgCreateTaskResponse")
+	proto.RegisterType((*MsgUpdateTask)(nil), "taskbounty.task.v1.MsgUpdateTask")
+	proto.RegisterType((*MsgUpdateTaskResponse)(nil), "taskbounty.task.v1.MsgUpdateTaskResponse")
+	proto.RegisterType((*MsgDeleteTask)(nil), "taskbounty.task.v1.MsgDeleteTask")
+	proto.RegisterType((*MsgDeleteTaskResponse)(nil), "taskbounty.task.v1.MsgDeleteTaskResponse")
+	proto.RegisterType((*MsgClaimTask)(nil), "taskbounty.task.v1.MsgClaimTask")
+	proto.RegisterType((*MsgClaimTaskResponse)(nil), "taskbounty.task.v1.MsgClaimTaskResponse")
+	proto.RegisterType((*MsgSubmitTask)(nil), "taskbounty.task.v1.MsgSubmitTask")
+	proto.RegisterType((*MsgSubmitTaskResponse)(nil), "taskbounty.task.v1.MsgSubmitTaskResponse")
+	proto.RegisterType((*MsgApproveTask)(nil), "taskbounty.task.v1.MsgApproveTask")
+	proto.RegisterType((*MsgApproveTaskResponse)(nil), "taskbounty.task.v1.MsgApproveTaskResponse")
+	proto.RegisterType((*MsgRejectTask)(nil), "taskbounty.task.v1.MsgRejectTask")
+	proto.RegisterType((*MsgRejectTaskResponse)(nil), "taskbounty.task.v1.MsgRejectTaskResponse")
+	proto.RegisterType((*MsgReviewTask)(nil), "taskbounty.task.v1.MsgReviewTask")
+	proto.RegisterType((*MsgReviewTaskResponse)(nil), "taskbounty.task.v1.MsgReviewTaskResponse")
+	proto.RegisterType((*MsgDisputeTask)(nil), "taskbounty.task.v1.MsgDisputeTask")
+	proto.RegisterType((*MsgDisputeTaskResponse)(nil), "taskbounty.task.v1.MsgDisputeTaskResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "taskbounty.task.v1.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "taskbounty.task.v1.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgUnclaimTask)(nil), "taskbounty.task.v1.MsgUnclaimTask")
+	proto.RegisterType((*MsgUnclaimTaskResponse)(nil), "taskbounty.task.v1.MsgUnclaimTaskResponse")
+	proto.RegisterType((*MsgReopenTask)(nil), "taskbounty.task.v1.MsgReopenTask")
+	proto.RegisterType((*MsgReopenTaskResponse)(nil), "taskbounty.task.v1.MsgReopenTaskResponse")
+	proto.RegisterType((*MsgCloseTask)(nil), "taskbounty.task.v1.MsgCloseTask")
+	proto.RegisterType((*MsgCloseTaskResponse)(nil), "taskbounty.task.v1.MsgCloseTaskResponse")
+	proto.RegisterType((*MsgSetTaskApprovers)(nil), "taskbounty.task.v1.MsgSetTaskApprovers")
+	proto.RegisterType((*MsgSetTaskApproversResponse)(nil), "taskbounty.task.v1.MsgSetTaskApproversResponse")
+	proto.RegisterType((*MsgSendCreateTask)(nil), "taskbounty.task.v1.MsgSendCreateTask")
+	proto.RegisterType((*MsgSendCreateTaskResponse)(nil), "taskbounty.task.v1.MsgSendCreateTaskResponse")
+	proto.RegisterType((*MsgSendClaimTask)(nil), "taskbounty.task.v1.MsgSendClaimTask")
+	proto.RegisterType((*MsgSendClaimTaskResponse)(nil), "taskbounty.task.v1.MsgSendClaimTaskResponse")
 }
 
-func (m *MsgCreateTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
+func init() { proto.RegisterFile("taskbounty/task/v1/tx.proto", fileDescriptor_48f6bb01333777c3) }
+
+var fileDescriptor_48f6bb01333777c3 = []byte{
+	// 1638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x4f, 0x1b, 0xd7,
+	0x16, 0x67, 0xb0, 0x31, 0xf8, 0x00, 0x0e, 0x99, 0xf0, 0xc2, 0xe0, 0x08, 0xe3, 0xcc, 0x7b, 0x28,
+	0x3c, 0xf2, 0x62, 0x0b, 0x5e, 0x44, 0xd5, 0x48, 0xad, 0x04, 0x21, 0x95, 0x52, 0x09, 0x35, 0x1a,
+	0x12, 0x45, 0x8a, 0x14, 0x39, 0x97, 0xf1, 0xc5, 0x4c, 0xe3, 0x99, 0x71, 0xe6, 0x5e, 0x13, 0x58,
+	0x54, 0x8a, 0xba, 0xe8, 0xa2, 0xab, 0xac, 0xbb, 0xe9, 0xb6, 0xea, 0x8a, 0x45, 0x97, 0x55, 0xd6,
+	0x59, 0x46, 0x55, 0x2b, 0x75, 0xd5, 0x56, 0x49, 0x55, 0xfe, 0x80, 0x2e, 0xba, 0x6c, 0x75, 0x3f,
+	0xe6, 0xce, 0x30, 0xf6, 0xd8, 0x4e, 0x42, 0x93, 0x6e, 0x60, 0xee, 0xbd, 0xbf, 0x7b, 0xbe, 0xcf,
+	0x3d, 0xe7, 0x00, 0x9c, 0xa3, 0x88, 0xdc, 0xdf, 0xf6, 0xdb, 0x1e, 0x3d, 0xa8, 0xb2, 0xcf, 0xea,
+	0xde, 0x72, 0x95, 0xee, 0x57, 0x5a, 0x81, 0x4f, 0x7d, 0x5d, 0x8f, 0x0e, 0x2b, 0xec, 0xb3, 0xb2,
+	0xb7, 0x5c, 0x3c, 0x8d, 0x5c, 0xc7, 0xf3, 0xab, 0xfc, 0xa7, 0x80, 0x15, 0x4b, 0xb6, 0x4f, 0x5c,
+	0x9f, 0x54, 0xb7, 0x11, 0xc1, 0xd5, 0xbd, 0xe5, 0x6d, 0x4c, 0xd1, 0x72, 0xd5, 0xf6, 0x1d, 0x4f,
+	0x9e, 0xcf, 0xc8, 0x73, 0x97, 0x34, 0x18, 0x79, 0x97, 0x34, 0xe4, 0xc1, 0xac, 0x38, 0xa8, 0xf1,
+	0x55, 0x55, 0x2c, 0xe4, 0xd1, 0x74, 0xc3, 0x6f, 0xf8, 0x62, 0x9f, 0x7d, 0xc9, 0xdd, 0xf9, 0x2e,
+	0xd2, 0xb6, 0x50, 0x80, 0xdc, 0xf0, 0xda, 0x5c, 0x37, 0x75, 0x98, 0xe4, 0xfc, 0xd8, 0x7c, 0xa2,
+	0xc1, 0xa9, 0x4d, 0xd2, 0xb8, 0xd5, 0xaa, 0x23, 0x8a, 0x6f, 0xf0, 0x8b, 0xfa, 0x2a, 0xe4, 0x51,
+	0x9b, 0xee, 0xfa, 0x81, 0x43, 0x0f, 0x0c, 0xad, 0xac, 0x2d, 0xe6, 0xd7, 0x8d, 0xef, 0xbe, 0xb9,
+	0x34, 0x2d, 0xc5, 0x59, 0xab, 0xd7, 0x03, 0x4c, 0xc8, 0x16, 0x0d, 0x1c, 0xaf, 0x61, 0x45, 0x50,
+	0xfd, 0x3d, 0xc8, 0x09, 0xd6, 0xc6, 0x70, 0x59, 0x5b, 0x1c, 0x5f, 0x29, 0x56, 0x3a, 0xad, 0x55,
+	0x11, 0x3c, 0xd6, 0xf3, 0x4f, 0x7f, 0x9a, 0x1f, 0xfa, 0xea, 0xe8, 0x70, 0x49, 0xb3, 0xe4, 0xa5,
+	0x2b, 0x97, 0x3f, 0x3d, 0x3a, 0x5c, 0x8a, 0xc8, 0x7d, 0x7e, 0x74, 0xb8, 0x74, 0x3e, 0x26, 0xfc,
+	0xbe, 0x10, 0x3f, 0x21, 0xac, 0x39, 0x0b, 0x33, 0x89, 0x2d, 0x0b, 0x93, 0x96, 0xef, 0x11, 0x6c,
+	0xfe, 0x90, 0x83, 0xc9, 0x4d, 0xd2, 0xb8, 0x1a, 0x60, 0x44, 0xf1, 0x4d, 0x44, 0xee, 0xeb, 0x2b,
+	0x30, 0x6a, 0xb3, 0x95, 0x1f, 0xf4, 0xd5, 0x2b, 0x04, 0xea, 0xd3, 0x30, 0x42, 0x1d, 0xda, 0xc4,
+	0x5c, 0xa9, 0xbc, 0x25, 0x16, 0x7a, 0x19, 0xc6, 0xeb, 0x98, 0xd8, 0x81, 0xd3, 0xa2, 0x8e, 0xef,
+	0x19, 0x19, 0x7e, 0x16, 0xdf, 0xd2, 0x0f, 0x20, 0x27, 0x24, 0x37, 0xb2, 0xe5, 0xcc, 0xe2, 0xf8,
+	0xca, 0x6c, 0x45, 0xf2, 0x61, 0x41, 0x51, 0x91, 0x41, 0x51, 0xb9, 0xea, 0x3b, 0xde, 0xfa, 0x07,
+	0xcc, 0x18, 0x5f, 0xff, 0x3c, 0xbf, 0xd8, 0x70, 0xe8, 0x6e, 0x7b, 0xbb, 0x62, 0xfb, 0xae, 0xf4,
+	0xbd, 0xfc, 0x75, 0x89, 0xd4, 0xef, 0x57, 0xe9, 0x41, 0x0b, 0x13, 0x7e, 0x81, 0x7c, 0x71, 0x74,
+	0xb8, 0x34, 0xd1, 0xc4, 0x0d, 0x64, 0x1f, 0xd4, 0x58, 0x58, 0x11, 0x69, 0x49, 0xc1, 0x50, 0x5f,
+	0x85, 0x1c, 0xa1, 0x88, 0xb6, 0x89, 0x31, 0x52, 0xd6, 0x16, 0x0b, 0x2b, 0xa5, 0x6e, 0x8e, 0x60,
+	0x06, 0xd9, 0xe2, 0x28, 0x4b, 0xa2, 0xf5, 0xcb, 0x30, 0x66, 0x37, 0x91, 0xe3, 0x22, 0x8f, 0x1a,
+	0xb9, 0x3e, 0xf6, 0x51, 0x48, 0xfd, 0x5d, 0x18, 0x69, 0x05, 0xbe, 0xbf, 0x63, 0x8c, 0x72, 0xaf,
+	0xcf, 0xa5, 0x31, 0xbb, 0xc1, 0x40, 0xeb, 0x59, 0xa6, 0xab, 0x25, 0x6e, 0x30, 0x86, 0xa8, 0xd5,
+	0x0a, 0xfc, 0x3d, 0x1c, 0x18, 0x63, 0xfd, 0x18, 0x86, 0x48, 0x7d, 0x1e, 0xc6, 0x19, 0xdd, 0x1a,
+	0xde, 0x6f, 0x39, 0xc1, 0x81, 0x91, 0x2f, 0x6b, 0x8b, 0x59, 0x0b, 0xd8, 0xd6, 0x35, 0xbe, 0xa3,
+	0x2f, 0x40, 0x81, 0x4b, 0x57, 0xab, 0x63, 0x54, 0x6f, 0x3a, 0x1e, 0x36, 0x80, 0x63, 0x26, 0xf9,
+	0xee, 0x86, 0xdc, 0xd4, 0xab, 0x70, 0x86, 0xb4, 0xb7, 0x5d, 0x87, 0x10, 0xc7, 0xf7, 0x22, 0xec,
+	0x38, 0xc7, 0xea, 0xd1, 0x91, 0xba, 0xb0, 0x0a, 0xf9, 0x00, 0xef, 0x39, 0xf8, 0x21, 0x0e, 0x88,
+	0x31, 0x51, 0xce, 0xf4, 0x4e, 0x0c, 0x05, 0xe5, 0x09, 0x25, 0x85, 0x27, 0xc6, 0x64, 0xbf, 0x7b,
+	0x0a, 0xaa, 0x7f, 0xa6, 0xc1, 0xe4, 0x0e, 0xc6, 0x35, 0xd4, 0x6c, 0xfa, 0x0f, 0x91, 0x67, 0x63,
+	0xa3, 0xf0, 0xa6, 0x42, 0x69, 0x62, 0x07, 0xe3, 0xb5, 0x90, 0xed, 0x95, 0x09, 0x96, 0x9a, 0x61,
+	0x46, 0x98, 0x17, 0xe0, 0x5f, 0xc7, 0xd2, 0x2a, 0x4c, 0x38, 0xbd, 0x00, 0xc3, 0x4e, 0x9d, 0x67,
+	0x56, 0xd6, 0x1a, 0x76, 0xea, 0xe6, 0xef, 0x19, 0x9e, 0x80, 0x22, 0x39, 0x5f, 0x39, 0x01, 0x05,
+	0xd5, 0xe1, 0x90, 0x6a, 0x94, 0x90, 0x99, 0x1e, 0x09, 0x99, 0xed, 0x95, 0x90, 0x23, 0x6f, 0x2f,
+	0x21, 0x73, 0xaf, 0x9c, 0x90, 0xa3, 0x2f, 0x9f, 0x90, 0x63, 0xaf, 0x95, 0x90, 0xf9, 0x41, 0x13,
+	0x32, 0x11, 0x1e, 0x33, 0x3c, 0x3c, 0x22, 0xa7, 0xab, 0xf7, 0x18, 0xf1, 0x68, 0xd8, 0xc0, 0x4d,
+	0x7c, 0x72, 0xd1, 0xd0, 0x95, 0x77, 0xc4, 0x42, 0xf1, 0xfe, 0x4d, 0x83, 0x09, 0x16, 0xb4, 0xcc,
+	0x46, 0x9c, 0x77, 0xdc, 0xb4, 0xda, 0xc0, 0xa6, 0x4d, 0xc6, 0xe2, 0x23, 0x0d, 0xc0, 0x45, 0xfb,
+	0x35, 0x19, 0x58, 0x99, 0x37, 0x15, 0x58, 0x79, 0x17, 0xed, 0xaf, 0x73, 0x9e, 0x57, 0x26, 0x99,
+	0x01, 0x94, 0x84, 0xe6, 0x59, 0x98, 0x8e, 0xeb, 0xa9, 0x0c, 0xf0, 0xa5, 0xc6, 0xad, 0xbf, 0xc5,
+	0x5e, 0x35, 0x7a, 0x82, 0x16, 0x50, 0xc1, 0x96, 0x79, 0xd9, 0x60, 0x4b, 0x4a, 0xbe, 0xcc, 0x7d,
+	0x17, 0x09, 0xa8, 0x9e, 0x15, 0x03, 0x46, 0x11, 0xa5, 0xd8, 0x6d, 0x51, 0xf9, 0xb6, 0x84, 0x4b,
+	0xf3, 0x0f, 0x0d, 0x0a, 0x9b, 0xa4, 0xb1, 0x26, 0x02, 0x31, 0xd4, 0x4a, 0x45, 0xb0, 0x36, 0x70,
+	0x49, 0xe9, 0xe3, 0xd7, 0xec, 0x5b, 0xf3, 0x6b, 0x28, 0xe1, 0x87, 0xd9, 0xb1, 0xcc, 0x54, 0xd6,
+	0x1a, 0xa5, 0xfb, 0xb5, 0x5d, 0x44, 0x76, 0x4d, 0x03, 0xce, 0x1e, 0x57, 0x5c, 0x39, 0xfa, 0x4f,
+	0xe1, 0x68, 0x0b, 0x7f, 0x8c, 0x6d, 0xe5, 0xe8, 0x80, 0xaf, 0x06, 0x31, 0x49, 0x88, 0xec, 0x30,
+	0xc9, 0x59, 0xc8, 0x05, 0x18, 0x11, 0xd5, 0xec, 0xc8, 0xd5, 0x3f, 0xc7, 0x54, 0xa1, 0xe4, 0xf2,
+	0x11, 0x88, 0x0c, 0xa0, 0x4c, 0xf3, 0x24, 0x34, 0x0d, 0x2b, 0xcc, 0x91, 0x69, 0x44, 0x99, 0x1e,
+	0xc4, 0x34, 0x02, 0xd9, 0x61, 0x9a, 0xf7, 0x61, 0xac, 0x8e, 0x6d, 0x87, 0x84, 0x9d, 0x60, 0x61,
+	0xc5, 0xec, 0x96, 0x06, 0x82, 0xef, 0x86, 0x44, 0x5a, 0xea, 0x0e, 0x0b, 0x70, 0xdb, 0x77, 0x5d,
+	0xec, 0x51, 0x59, 0xb7, 0xc2, 0xa5, 0xd2, 0x4c, 0x30, 0x36, 0x3f, 0x92, 0x9a, 0x85, 0xf2, 0xab,
+	0x14, 0x89, 0x0a, 0x8c, 0xf6, 0x32, 0x05, 0xc6, 0xfc, 0x84, 0xe7, 0xcf, 0x86, 0x43, 0x5a, 0x6d,
+	0x8a, 0x4f, 0xf0, 0x55, 0x48, 0x09, 0x96, 0x64, 0xca, 0x2f, 0xf1, 0x28, 0x8e, 0xb1, 0x57, 0x0a,
+	0x4d, 0x41, 0x86, 0xe0, 0x07, 0x32, 0xdf, 0xd9, 0xa7, 0xf9, 0x78, 0x18, 0x4e, 0x73, 0xe5, 0x89,
+	0xdf, 0xdc, 0xc3, 0xf2, 0x0e, 0x2b, 0x21, 0x28, 0xd8, 0x76, 0x06, 0x09, 0xed, 0x10, 0xd8, 0x21,
+	0xec, 0x35, 0x80, 0x80, 0x51, 0x6d, 0xd3, 0xc8, 0x81, 0x0b, 0xdd, 0x0c, 0x28, 0x99, 0x5a, 0x0a,
+	0x6c, 0xc5, 0x2e, 0xea, 0x17, 0xe0, 0x54, 0xa8, 0x58, 0xed, 0x21, 0x76, 0x1a, 0xbb, 0xc2, 0x9b,
+	0x59, 0xab, 0x10, 0x6e, 0xdf, 0xe6, 0xbb, 0xbc, 0x3d, 0x15, 0xf5, 0x2a, 0xc4, 0x8d, 0xc8, 0xf6,
+	0x54, 0xec, 0x4a, 0x98, 0x0e, 0x59, 0xcf, 0xa7, 0x58, 0x74, 0xe2, 0x16, 0xff, 0x96, 0xd5, 0x4e,
+	0x2a, 0x62, 0x9e, 0x83, 0xd9, 0x0e, 0x8b, 0xa8, 0x60, 0x17, 0xae, 0xbd, 0xe5, 0xd9, 0x27, 0x5c,
+	0xf2, 0x06, 0x74, 0xad, 0x78, 0xa0, 0x62, 0xec, 0x95, 0x60, 0x07, 0x32, 0x09, 0xfd, 0x16, 0xf6,
+	0x4e, 0xac, 0x29, 0x4c, 0x93, 0xaa, 0x5b, 0x7b, 0x10, 0xb1, 0x56, 0x32, 0xdd, 0x93, 0xdd, 0x81,
+	0x4f, 0xfe, 0xae, 0xce, 0x64, 0x45, 0xd6, 0x65, 0xc9, 0x41, 0x05, 0x7a, 0x11, 0xc6, 0x50, 0x60,
+	0xef, 0x3a, 0x7b, 0x58, 0x74, 0xce, 0x63, 0x96, 0x5a, 0xb3, 0x9a, 0x7d, 0x86, 0x95, 0x44, 0xcc,
+	0x9f, 0xb1, 0x35, 0x35, 0x17, 0x9c, 0x84, 0xc1, 0x8e, 0xcd, 0x24, 0x99, 0x81, 0x67, 0x92, 0x84,
+	0x56, 0x73, 0x70, 0xae, 0x8b, 0x80, 0xca, 0xac, 0xdf, 0x8b, 0x9c, 0xdd, 0xc2, 0x5e, 0xfd, 0x35,
+	0xa7, 0xf0, 0x39, 0x00, 0x7b, 0x17, 0x79, 0x1e, 0x6e, 0xd6, 0xa4, 0x1a, 0x79, 0x2b, 0x2f, 0x77,
+	0xae, 0xd7, 0xf5, 0x8b, 0x70, 0x9a, 0x3a, 0x2e, 0xf6, 0xdb, 0xb4, 0xc6, 0x7e, 0x13, 0x8a, 0xdc,
+	0x16, 0x8f, 0x84, 0xac, 0x35, 0x25, 0x0f, 0x6e, 0x86, 0xfb, 0xd1, 0x00, 0x91, 0xed, 0x31, 0x40,
+	0x8c, 0xf4, 0x1a, 0x20, 0x72, 0x6f, 0x78, 0x80, 0x48, 0x58, 0xfd, 0x1d, 0x9e, 0xf7, 0xc7, 0xad,
+	0x1a, 0x0f, 0x28, 0x82, 0x1f, 0xb4, 0x31, 0x1b, 0x17, 0xc5, 0xf3, 0xa9, 0xd6, 0xe6, 0xb7, 0x1a,
+	0x4c, 0x85, 0x37, 0x5f, 0xf3, 0x59, 0x38, 0x49, 0x87, 0xcc, 0xc0, 0x28, 0x1f, 0xe8, 0x9d, 0xba,
+	0x7c, 0x31, 0x73, 0x6c, 0x79, 0xbd, 0x9e, 0x7c, 0x53, 0x56, 0xc1, 0x48, 0x4a, 0x3f, 0x88, 0xda,
+	0x2b, 0xbf, 0x4e, 0x40, 0x66, 0x93, 0x34, 0xf4, 0x7b, 0x30, 0x71, 0xec, 0x0f, 0x5d, 0xff, 0xee,
+	0xf6, 0xc8, 0x27, 0xfe, 0x9a, 0x54, 0xbc, 0x38, 0x00, 0x48, 0x49, 0x71, 0x07, 0x20, 0x16, 0xe8,
+	0xe7, 0x53, 0xae, 0x46, 0x90, 0xe2, 0x7f, 0xfb, 0x42, 0xe2, 0xb4, 0x63, 0x93, 0xf4, 0xf9, 0x9e,
+	0x62, 0xf5, 0xa4, 0xdd, 0x39, 0x9a, 0x31, 0xda, 0xb1, 0xb9, 0x2c, 0x8d, 0x76, 0x04, 0x49, 0xa5,
+	0xdd, 0x39, 0x7a, 0xe9, 0xb7, 0x21, 0x1f, 0x05, 0x5b, 0x39, 0x4d, 0xdf, 0x10, 0x51, 0x5c, 0xec,
+	0x87, 0x88, 0x0b, 0x1d, 0x1b, 0x67, 0xd2, 0x84, 0x8e, 0x20, 0xa9, 0x42, 0x77, 0x99, 0x39, 0xee,
+	0xc2, 0x78, 0x7c, 0xaa, 0x30, 0x53, 0x6e, 0xc6, 0x30, 0xc5, 0xa5, 0xfe, 0x98, 0xb8, 0xe8, 0xb1,
+	0x06, 0x3d, 0x4d, 0xf4, 0x08, 0x92, 0x2a, 0x7a, 0x67, 0x97, 0x2b, 0x68, 0xab, 0x0e, 0x37, 0x9d,
+	0x76, 0x08, 0xe9, 0x41, 0xbb, 0xa3, 0xcf, 0xbc, 0x0b, 0xe3, 0xf1, 0x66, 0x31, 0xcd, 0x2c, 0x31,
+	0x4c, 0xaa, 0x59, 0xba, 0x75, 0x7d, 0x3b, 0x50, 0x48, 0xf4, 0x77, 0x0b, 0xa9, 0xb2, 0xc5, 0x61,
+	0xc5, 0x4b, 0x03, 0xc1, 0xe2, 0x6a, 0xc4, 0x1b, 0xa3, 0x34, 0x35, 0x62, 0x98, 0x54, 0x35, 0xba,
+	0x74, 0x38, 0xc2, 0x03, 0xaa, 0xbd, 0x49, 0xf7, 0x40, 0x08, 0xe9, 0xe1, 0x81, 0x64, 0xa7, 0x22,
+	0xb2, 0x29, 0x6c, 0x53, 0xd2, 0xb3, 0x49, 0x22, 0x7a, 0x64, 0x53, 0xb2, 0x11, 0x69, 0xc2, 0x54,
+	0x47, 0xa3, 0x71, 0x21, 0x2d, 0x61, 0x12, 0xc0, 0x62, 0x75, 0x40, 0x60, 0xdc, 0xd3, 0x89, 0xae,
+	0x60, 0x21, 0x95, 0x44, 0x1c, 0x96, 0xea, 0xe9, 0x94, 0x6a, 0x68, 0xc3, 0xe4, 0xf1, 0x6a, 0xf7,
+	0x9f, 0x5e, 0xf7, 0x95, 0xb7, 0xff, 0x37, 0x08, 0x2a, 0x64, 0x52, 0x1c, 0x79, 0xc4, 0x8a, 0xf5,
+	0xfa, 0xf2, 0xd3, 0xe7, 0x25, 0xed, 0xd9, 0xf3, 0x92, 0xf6, 0xcb, 0xf3, 0x92, 0xf6, 0xf8, 0x45,
+	0x69, 0xe8, 0xd9, 0x8b, 0xd2, 0xd0, 0x8f, 0x2f, 0x4a, 0x43, 0x77, 0x66, 0x3a, 0xff, 0x8f, 0xc1,
+	0x6b, 0xff, 0x76, 0x8e, 0xff, 0x17, 0xe6, 0xff, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x07,
+	0x58, 0xe5, 0x75, 0x1a, 0x00, 0x00,
 }
 
-func (m *MsgUpdateTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Bounty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateTask defines the CreateTask RPC.
+	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
+	// UpdateTask defines the UpdateTask RPC.
+	UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error)
+	// DeleteTask defines the DeleteTask RPC.
+	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
+	// Custom task management messages
+	ClaimTask(ctx context.Context, in *MsgClaimTask, opts ...grpc.CallOption) (*MsgClaimTaskResponse, error)
+	SubmitTask(ctx context.Context, in *MsgSubmitTask, opts ...grpc.CallOption) (*MsgSubmitTaskResponse, error)
+	ApproveTask(ctx context.Context, in *MsgApproveTask, opts ...grpc.CallOption) (*MsgApproveTaskResponse, error)
+	RejectTask(ctx context.Context, in *MsgRejectTask, opts ...grpc.CallOption) (*MsgRejectTaskResponse, error)
+	// ReviewTask endorses or rejects a submission as one of the task's reviewers.
+	ReviewTask(ctx context.Context, in *MsgReviewTask, opts ...grpc.CallOption) (*MsgReviewTaskResponse, error)
+	// DisputeTask contests the rejection of a submission and freezes the escrow.
+	DisputeTask(ctx context.Context, in *MsgDisputeTask, opts ...grpc.CallOption) (*MsgDisputeTaskResponse, error)
+	// ResolveDispute settles a disputed task, only callable by an arbiter.
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	// UnclaimTask releases a claimed task back to OPEN, only callable by the claimant.
+	UnclaimTask(ctx context.Context, in *MsgUnclaimTask, opts ...grpc.CallOption) (*MsgUnclaimTaskResponse, error)
+	// ReopenTask reopens a rejected task for new claims, only callable by the creator.
+	ReopenTask(ctx context.Context, in *MsgReopenTask, opts ...grpc.CallOption) (*MsgReopenTaskResponse, error)
+	// CloseTask cancels an open task with a refund or finalizes an approved one,
+	// only callable by the creator.
+	CloseTask(ctx context.Context, in *MsgCloseTask, opts ...grpc.CallOption) (*MsgCloseTaskResponse, error)
+	// SetTaskApprovers replaces the nominated approvers of an open task, only
+	// callable by the creator.
+	SetTaskApprovers(ctx context.Context, in *MsgSetTaskApprovers, opts ...grpc.CallOption) (*MsgSetTaskApproversResponse, error)
+	// SendCreateTask creates a task on the chain at the other end of a task
+	// channel, escrowing the bounty from the creator's remote account there.
+	SendCreateTask(ctx context.Context, in *MsgSendCreateTask, opts ...grpc.CallOption) (*MsgSendCreateTaskResponse, error)
+	// SendClaimTask claims a task on the chain at the other end of a task
+	// channel for the claimant's remote account there.
+	SendClaimTask(ctx context.Context, in *MsgSendClaimTask, opts ...grpc.CallOption) (*MsgSendClaimTaskResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error) {
+	out := new(MsgCreateTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/CreateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Proof.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error) {
+	out := new(MsgUpdateTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/UpdateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error) {
+	out := new(MsgDeleteTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/DeleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ClaimTask(ctx context.Context, in *MsgClaimTask, opts ...grpc.CallOption) (*MsgClaimTaskResponse, error) {
+	out := new(MsgClaimTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ClaimTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitTask(ctx context.Context, in *MsgSubmitTask, opts ...grpc.CallOption) (*MsgSubmitTaskResponse, error) {
+	out := new(MsgSubmitTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/SubmitTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) ApproveTask(ctx context.Context, in *MsgApproveTask, opts ...grpc.CallOption) (*MsgApproveTaskResponse, error) {
+	out := new(MsgApproveTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ApproveTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDeleteTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RejectTask(ctx context.Context, in *MsgRejectTask, opts ...grpc.CallOption) (*MsgRejectTaskResponse, error) {
+	out := new(MsgRejectTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/RejectTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ReviewTask(ctx context.Context, in *MsgReviewTask, opts ...grpc.CallOption) (*MsgReviewTaskResponse, error) {
+	out := new(MsgReviewTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ReviewTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DisputeTask(ctx context.Context, in *MsgDisputeTask, opts ...grpc.CallOption) (*MsgDisputeTaskResponse, error) {
+	out := new(MsgDisputeTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/DisputeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UnclaimTask(ctx context.Context, in *MsgUnclaimTask, opts ...grpc.CallOption) (*MsgUnclaimTaskResponse, error) {
+	out := new(MsgUnclaimTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/UnclaimTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ReopenTask(ctx context.Context, in *MsgReopenTask, opts ...grpc.CallOption) (*MsgReopenTaskResponse, error) {
+	out := new(MsgReopenTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/ReopenTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Claimant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CloseTask(ctx context.Context, in *MsgCloseTask, opts ...grpc.CallOption) (*MsgCloseTaskResponse, error) {
+	out := new(MsgCloseTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/CloseTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) SetTaskApprovers(ctx context.Context, in *MsgSetTaskApprovers, opts ...grpc.CallOption) (*MsgSetTaskApproversResponse, error) {
+	out := new(MsgSetTaskApproversResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/SetTaskApprovers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Proof.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SendCreateTask(ctx context.Context, in *MsgSendCreateTask, opts ...grpc.CallOption) (*MsgSendCreateTaskResponse, error) {
+	out := new(MsgSendCreateTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/SendCreateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgApproveTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SendClaimTask(ctx context.Context, in *MsgSendClaimTask, opts ...grpc.CallOption) (*MsgSendClaimTaskResponse, error) {
+	out := new(MsgSendClaimTaskResponse)
+	err := c.cc.Invoke(ctx, "/taskbounty.task.v1.Msg/SendClaimTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateTask defines the CreateTask RPC.
+	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
+	// UpdateTask defines the UpdateTask RPC.
+	UpdateTask(context.Context, *MsgUpdateTask) (*MsgUpdateTaskResponse, error)
+	// DeleteTask defines the DeleteTask RPC.
+	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
+	// Custom task management messages
+	ClaimTask(context.Context, *MsgClaimTask) (*MsgClaimTaskResponse, error)
+	SubmitTask(context.Context, *MsgSubmitTask) (*MsgSubmitTaskResponse, error)
+	ApproveTask(context.Context, *MsgApproveTask) (*MsgApproveTaskResponse, error)
+	RejectTask(context.Context, *MsgRejectTask) (*MsgRejectTaskResponse, error)
+	// ReviewTask endorses or rejects a submission as one of the task's reviewers.
+	ReviewTask(context.Context, *MsgReviewTask) (*MsgReviewTaskResponse, error)
+	// DisputeTask contests the rejection of a submission and freezes the escrow.
+	DisputeTask(context.Context, *MsgDisputeTask) (*MsgDisputeTaskResponse, error)
+	// ResolveDispute settles a disputed task, only callable by an arbiter.
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	// UnclaimTask releases a claimed task back to OPEN, only callable by the claimant.
+	UnclaimTask(context.Context, *MsgUnclaimTask) (*MsgUnclaimTaskResponse, error)
+	// ReopenTask reopens a rejected task for new claims, only callable by the creator.
+	ReopenTask(context.Context, *MsgReopenTask) (*MsgReopenTaskResponse, error)
+	// CloseTask cancels an open task with a refund or finalizes an approved one,
+	// only callable by the creator.
+	CloseTask(context.Context, *MsgCloseTask) (*MsgCloseTaskResponse, error)
+	// SetTaskApprovers replaces the nominated approvers of an open task, only
+	// callable by the creator.
+	SetTaskApprovers(context.Context, *MsgSetTaskApprovers) (*MsgSetTaskApproversResponse, error)
+	// SendCreateTask creates a task on the chain at the other end of a task
+	// channel, escrowing the bounty from the creator's remote account there.
+	SendCreateTask(context.Context, *MsgSendCreateTask) (*MsgSendCreateTaskResponse, error)
+	// SendClaimTask claims a task on the chain at the other end of a task
+	// channel for the claimant's remote account there.
+	SendClaimTask(context.Context, *MsgSendClaimTask) (*MsgSendClaimTaskResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTask) (*MsgCreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTask(ctx context.Context, req *MsgUpdateTask) (*MsgUpdateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (*UnimplementedMsgServer) DeleteTask(ctx context.Context, req *MsgDeleteTask) (*MsgDeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTask(ctx context.Context, req *MsgClaimTask) (*MsgClaimTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTask(ctx context.Context, req *MsgSubmitTask) (*MsgSubmitTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTask not implemented")
+}
+func (*UnimplementedMsgServer) ApproveTask(ctx context.Context, req *MsgApproveTask) (*MsgApproveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTask not implemented")
+}
+func (*UnimplementedMsgServer) RejectTask(ctx context.Context, req *MsgRejectTask) (*MsgRejectTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTask not implemented")
+}
+func (*UnimplementedMsgServer) ReviewTask(ctx context.Context, req *MsgReviewTask) (*MsgReviewTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewTask not implemented")
+}
+func (*UnimplementedMsgServer) DisputeTask(ctx context.Context, req *MsgDisputeTask) (*MsgDisputeTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeTask not implemented")
+}
+func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedMsgServer) UnclaimTask(ctx context.Context, req *MsgUnclaimTask) (*MsgUnclaimTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnclaimTask not implemented")
+}
+func (*UnimplementedMsgServer) ReopenTask(ctx context.Context, req *MsgReopenTask) (*MsgReopenTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenTask not implemented")
+}
+func (*UnimplementedMsgServer) CloseTask(ctx context.Context, req *MsgCloseTask) (*MsgCloseTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTask not implemented")
+}
+func (*UnimplementedMsgServer) SetTaskApprovers(ctx context.Context, req *MsgSetTaskApprovers) (*MsgSetTaskApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskApprovers not implemented")
+}
+func (*UnimplementedMsgServer) SendCreateTask(ctx context.Context, req *MsgSendCreateTask) (*MsgSendCreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCreateTask not implemented")
+}
+func (*UnimplementedMsgServer) SendClaimTask(ctx context.Context, req *MsgSendClaimTask) (*MsgSendClaimTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendClaimTask not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgApproveTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/CreateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTask(ctx, req.(*MsgCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectTask) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Rejecter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTask(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/UpdateTask",
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTask(ctx, req.(*MsgUpdateTask))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteTask(ctx, req.(*MsgDeleteTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/ClaimTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTask(ctx, req.(*MsgClaimTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/SubmitTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTask(ctx, req.(*MsgSubmitTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/ApproveTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveTask(ctx, req.(*MsgApproveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/RejectTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectTask(ctx, req.(*MsgRejectTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/ReviewTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewTask(ctx, req.(*MsgReviewTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func _Msg_DisputeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/DisputeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeTask(ctx, req.(*MsgDisputeTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnclaimTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnclaimTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnclaimTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/UnclaimTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnclaimTask(ctx, req.(*MsgUnclaimTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReopenTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReopenTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReopenTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/ReopenTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReopenTask(ctx, req.(*MsgReopenTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/CloseTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseTask(ctx, req.(*MsgCloseTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTaskApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTaskApprovers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTaskApprovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/SetTaskApprovers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTaskApprovers(ctx, req.(*MsgSetTaskApprovers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendCreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendCreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/SendCreateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendCreateTask(ctx, req.(*MsgSendCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendClaimTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendClaimTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendClaimTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taskbounty.task.v1.Msg/SendClaimTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendClaimTask(ctx, req.(*MsgSendClaimTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "taskbounty.task.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateTask",
+			Handler:    _Msg_CreateTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Msg_UpdateTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _Msg_DeleteTask_Handler,
+		},
+		{
+			MethodName: "ClaimTask",
+			Handler:    _Msg_ClaimTask_Handler,
+		},
+		{
+			MethodName: "SubmitTask",
+			Handler:    _Msg_SubmitTask_Handler,
+		},
+		{
+			MethodName: "ApproveTask",
+			Handler:    _Msg_ApproveTask_Handler,
+		},
+		{
+			MethodName: "RejectTask",
+			Handler:    _Msg_RejectTask_Handler,
+		},
+		{
+			MethodName: "ReviewTask",
+			Handler:    _Msg_ReviewTask_Handler,
+		},
+		{
+			MethodName: "DisputeTask",
+			Handler:    _Msg_DisputeTask_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "UnclaimTask",
+			Handler:    _Msg_UnclaimTask_Handler,
+		},
+		{
+			MethodName: "ReopenTask",
+			Handler:    _Msg_ReopenTask_Handler,
+		},
+		{
+			MethodName: "CloseTask",
+			Handler:    _Msg_CloseTask_Handler,
+		},
+		{
+			MethodName: "SetTaskApprovers",
+			Handler:    _Msg_SetTaskApprovers_Handler,
+		},
+		{
+			MethodName: "SendCreateTask",
+			Handler:    _Msg_SendCreateTask_Handler,
+		},
+		{
+			MethodName: "SendClaimTask",
+			Handler:    _Msg_SendClaimTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "taskbounty/task/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeAllowance) > 0 {
+		for iNdEx := len(m.FeeAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Reviewers) > 0 {
+		for iNdEx := len(m.Reviewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reviewers[iNdEx])
+			copy(dAtA[i:], m.Reviewers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Reviewers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SubmissionDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubmissionDeadline))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClaimDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimDeadline))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TaskExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskExpiry))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxBounty) > 0 {
+		for iNdEx := len(m.MaxBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxBounty) > 0 {
+		for iNdEx := len(m.MaxBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxBounty) > 0 {
+		for iNdEx := len(m.MaxBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rejecter) > 0 {
+		i -= len(m.Rejecter)
+		copy(dAtA[i:], m.Rejecter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rejecter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatorWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatorWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ClaimantWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimantWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Resolution != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnclaimTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnclaimTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnclaimTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnclaimTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnclaimTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnclaimTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReopenTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReopenTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReopenTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReopenTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReopenTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReopenTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTaskApprovers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTaskApprovers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTaskApprovers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTaskApproversResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTaskApproversResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTaskApproversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendCreateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendCreateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendCreateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendClaimTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendClaimTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendClaimTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendClaimTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendClaimTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendClaimTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskExpiry != 0 {
+		n += 1 + sovTx(uint64(m.TaskExpiry))
+	}
+	if m.ClaimDeadline != 0 {
+		n += 1 + sovTx(uint64(m.ClaimDeadline))
+	}
+	if m.SubmissionDeadline != 0 {
+		n += 1 + sovTx(uint64(m.SubmissionDeadline))
+	}
+	if len(m.Reviewers) > 0 {
+		for _, s := range m.Reviewers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeAllowance) > 0 {
+		for _, e := range m.FeeAllowance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.MaxBounty) > 0 {
+		for _, e := range m.MaxBounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		n += 1 + sovTx(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *MsgApproveTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.MaxBounty) > 0 {
+		for _, e := range m.MaxBounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgApproveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rejecter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxBounty) > 0 {
+		for _, e := range m.MaxBounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRejectTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReviewTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovTx(uint64(m.Decision))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReviewTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgDisputeTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovTx(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovTx(uint64(m.Resolution))
+	}
+	if m.ClaimantWeight != 0 {
+		n += 1 + sovTx(uint64(m.ClaimantWeight))
+	}
+	if m.CreatorWeight != 0 {
+		n += 1 + sovTx(uint64(m.CreatorWeight))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnclaimTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnclaimTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReopenTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReopenTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCloseTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Archived {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTaskApprovers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTaskApproversResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendCreateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendCreateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgSendClaimTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgSendClaimTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskExpiry", wireType)
+			}
+			m.TaskExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeadline", wireType)
+			}
+			m.ClaimDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionDeadline", wireType)
+			}
+			m.SubmissionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewers = append(m.Reviewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = append(m.FeeAllowance, types.Coin{})
+			if err := m.FeeAllowance[len(m.FeeAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBounty = append(m.MaxBounty, types.Coin{})
+			if err := m.MaxBounty[len(m.MaxBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBounty = append(m.MaxBounty, types.Coin{})
+			if err := m.MaxBounty[len(m.MaxBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2210,15 +6589,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejecter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2246,11 +6625,62 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Rejecter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBounty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2277,7 +6707,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxBounty = append(m.MaxBounty, types.Coin{})
+			if err := m.MaxBounty[len(m.MaxBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2302,7 +6733,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2325,10 +6756,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2352,7 +6783,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
+func (m *MsgReviewTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2375,15 +6806,15 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReviewTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReviewTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2411,13 +6842,13 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2427,29 +6858,16 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
 			}
-			var stringLen uint64
+			m.Decision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2459,29 +6877,16 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decision |= ReviewDecision(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2491,26 +6896,75 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReviewTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReviewTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReviewTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -2529,7 +6983,57 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
@@ -2561,11 +7065,11 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2575,28 +7079,14 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2624,7 +7114,7 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2647,7 +7137,7 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2670,17 +7160,17 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.Id = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2690,7 +7180,7 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2716,7 +7206,7 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,15 +7229,15 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2775,7 +7265,7 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -2797,10 +7287,10 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			var stringLen uint64
+			m.Resolution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2810,29 +7300,16 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Resolution |= DisputeResolution(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimantWeight", wireType)
 			}
-			var stringLen uint64
+			m.ClaimantWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2842,29 +7319,16 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClaimantWeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorWeight", wireType)
 			}
-			var msglen int
+			m.CreatorWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2874,30 +7338,16 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreatorWeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2907,12 +7357,125 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= TaskStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnclaimTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnclaimTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnclaimTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
@@ -2944,11 +7507,11 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2958,28 +7521,14 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3007,7 +7556,7 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3030,7 +7579,7 @@ func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnclaimTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3053,10 +7602,10 @@ func (m *MsgUpdateTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnclaimTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnclaimTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3080,7 +7629,7 @@ func (m *MsgUpdateTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+func (m *MsgReopenTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3103,10 +7652,10 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReopenTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReopenTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3160,6 +7709,38 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3181,7 +7762,7 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReopenTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3204,10 +7785,10 @@ func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReopenTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReopenTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3231,7 +7812,7 @@ func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3254,15 +7835,15 @@ func (m *MsgClaimTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3290,7 +7871,7 @@ func (m *MsgClaimTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimant = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3332,7 +7913,7 @@ func (m *MsgClaimTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,12 +7936,32 @@ func (m *MsgClaimTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3382,7 +7983,7 @@ func (m *MsgClaimTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTaskApprovers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3405,15 +8006,15 @@ func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTaskApprovers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTaskApprovers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3441,7 +8042,7 @@ func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimant = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3464,9 +8065,9 @@ func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3476,24 +8077,23 @@ func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3516,7 +8116,7 @@ func (m *MsgSubmitTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTaskApproversResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3539,10 +8139,10 @@ func (m *MsgSubmitTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTaskApproversResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTaskApproversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3566,7 +8166,7 @@ func (m *MsgSubmitTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSendCreateTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,15 +8189,15 @@ func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendCreateTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3625,13 +8225,13 @@ func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3641,14 +8241,78 @@ func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3676,7 +8340,41 @@ func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3699,7 +8397,7 @@ func (m *MsgApproveTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendCreateTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3722,12 +8420,31 @@ func (m *MsgApproveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendCreateTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3749,7 +8466,7 @@ func (m *MsgApproveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSendClaimTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3772,15 +8489,15 @@ func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendClaimTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendClaimTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rejecter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3808,13 +8525,13 @@ func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rejecter = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3824,16 +8541,29 @@ func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3843,24 +8573,30 @@ func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3882,7 +8618,7 @@ func (m *MsgRejectTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendClaimTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3905,12 +8641,31 @@ func (m *MsgRejectTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendClaimTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendClaimTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])